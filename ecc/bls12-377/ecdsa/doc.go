@@ -10,8 +10,12 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 //
+// The nonces are random by default. PrivateKey.SetDeterministicNonces selects the deterministic
+// nonces of RFC 6979 instead, so that signing the same message twice gives the same signature.
+//
 // Documentation:
 // - Wikipedia: https://en.wikipedia.org/wiki/Elliptic_Curve_Digital_Signature_Algorithm
 // - FIPS 186-4: https://nvlpubs.nist.gov/nistpubs/FIPS/NIST.FIPS.186-4.pdf
 // - SEC 1, v-2: https://www.secg.org/sec1-v2.pdf
+// - RFC 6979: https://www.rfc-editor.org/rfc/rfc6979
 package ecdsa
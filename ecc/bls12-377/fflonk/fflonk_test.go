@@ -79,6 +79,54 @@ func TestFflonk(t *testing.T) {
 
 }
 
+func TestBatchVerifyProofs(t *testing.T) {
+
+	assert := require.New(t)
+
+	const nbProofs = 3
+	proofs := make([]OpeningProof, nbProofs)
+	digests := make([][]kzg.Digest, nbProofs)
+	x := make([][][]fr.Element, nbProofs)
+	hf := sha256.New()
+	for i := 0; i < nbProofs; i++ {
+		nbSets := i + 1
+		p := make([][][]fr.Element, nbSets)
+		x[i] = make([][]fr.Element, nbSets)
+		digests[i] = make([]kzg.Digest, nbSets)
+		for j := 0; j < nbSets; j++ {
+			p[j] = make([][]fr.Element, j+2)
+			for k := range p[j] {
+				p[j][k] = make([]fr.Element, k+5)
+				fr.Vector(p[j][k]).MustSetRandom()
+			}
+			x[i][j] = make([]fr.Element, j+1)
+			fr.Vector(x[i][j]).MustSetRandom()
+			var err error
+			digests[i][j], err = FoldAndCommit(p[j], testSrs.Pk)
+			assert.NoError(err)
+		}
+		var err error
+		proofs[i], err = BatchOpen(p, digests[i], x[i], hf, testSrs.Pk)
+		assert.NoError(err)
+	}
+
+	assert.NoError(BatchVerifyProofs(proofs, digests, x, hf, testSrs.Vk))
+
+	// tampered embedded shplonk proof
+	proofs[1].SOpeningProof.W = proofs[0].SOpeningProof.W
+	assert.Error(BatchVerifyProofs(proofs, digests, x, hf, testSrs.Vk))
+
+	// tampered outer claimed value
+	proofs[1] = proofs[0]
+	digests[1] = digests[0]
+	x[1] = x[0]
+	assert.NoError(BatchVerifyProofs(proofs, digests, x, hf, testSrs.Vk))
+	proofs[2].ClaimedValues[1][0][0].MustSetRandom()
+	assert.ErrorIs(BatchVerifyProofs(proofs, digests, x, hf, testSrs.Vk), ErrInonsistentFolding)
+
+	assert.Error(BatchVerifyProofs(proofs, digests, x[1:], hf, testSrs.Vk))
+}
+
 func TestCommit(t *testing.T) {
 
 	assert := require.New(t)
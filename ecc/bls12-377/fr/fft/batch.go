@@ -0,0 +1,285 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// FFTBatch computes FFT on each of the polynomials, in place.
+// All the polynomials must have domain.Cardinality coefficients; the options apply to all of them.
+//
+// The transforms are interleaved, as in FFTMatrix: the butterflies of a stage are applied by
+// blocks to all the polynomials, so that the twiddles of a block are loaded once for all of them.
+func (domain *Domain) FFTBatch(polynomials [][]fr.Element, decimation Decimation, opts ...Option) {
+	domain.batch(polynomials, decimation, false, opts)
+}
+
+// FFTInverseBatch computes FFTInverse on each of the polynomials, in place (see FFTBatch).
+func (domain *Domain) FFTInverseBatch(polynomials [][]fr.Element, decimation Decimation, opts ...Option) {
+	domain.batch(polynomials, decimation, true, opts)
+}
+
+func (domain *Domain) batch(polynomials [][]fr.Element, decimation Decimation, inverse bool, opts []Option) {
+	for i := range polynomials {
+		if uint64(len(polynomials[i])) != domain.Cardinality {
+			panic("fft: the polynomials must have domain.Cardinality coefficients")
+		}
+	}
+	if decimation != DIF && decimation != DIT {
+		panic("not implemented")
+	}
+	if len(polynomials) == 0 {
+		return
+	}
+	domain.interleaved(columns(polynomials), decimation, inverse, fftOptions(opts))
+}
+
+// FFTMatrix computes FFT on each of the columns of a matrix, in place.
+// The matrix has domain.Cardinality rows and nbColumns columns, and is stored in row-major order:
+// the i-th coefficient of the j-th polynomial is matrix[i·nbColumns + j].
+//
+// The butterflies of a stage are applied on whole rows, so that each twiddle is used once per
+// stage for all the columns, on contiguous coefficients: this is the layout with the best locality
+// for interleaved transforms. With the WithTranspose option, the columns are instead copied by
+// blocks to contiguous vectors and transformed with FFTBatch.
+func (domain *Domain) FFTMatrix(matrix []fr.Element, nbColumns int, decimation Decimation, opts ...Option) {
+	domain.matrix(matrix, nbColumns, decimation, false, opts)
+}
+
+// FFTInverseMatrix computes FFTInverse on each of the columns of a matrix, in place (see FFTMatrix).
+func (domain *Domain) FFTInverseMatrix(matrix []fr.Element, nbColumns int, decimation Decimation, opts ...Option) {
+	domain.matrix(matrix, nbColumns, decimation, true, opts)
+}
+
+func (domain *Domain) matrix(matrix []fr.Element, nbColumns int, decimation Decimation, inverse bool, opts []Option) {
+	if nbColumns < 0 || uint64(len(matrix)) != domain.Cardinality*uint64(nbColumns) {
+		panic("fft: the matrix must have domain.Cardinality rows of nbColumns elements")
+	}
+	if decimation != DIF && decimation != DIT {
+		panic("not implemented")
+	}
+	if nbColumns == 0 {
+		return
+	}
+	opt := fftOptions(opts)
+	if opt.transpose {
+		domain.matrixTransposed(matrix, nbColumns, decimation, inverse, opts, opt)
+		return
+	}
+
+	n := int(domain.Cardinality)
+	rows := make(matrixRows, n)
+	for i := range rows {
+		rows[i] = matrix[i*nbColumns : (i+1)*nbColumns]
+	}
+	domain.interleaved(rows, decimation, inverse, opt)
+}
+
+// polynomialSet is a set of polynomials transformed together, stage by stage
+type polynomialSet interface {
+	// butterflies applies the butterflies start to end-1 of the stage to each polynomial
+	butterflies(s *fftStage, start, end int)
+	// scale sets pᵢ = c ⋅ pᵢ for each polynomial p
+	scale(i int, c *fr.Element)
+}
+
+// fftStage describes the n/2 butterflies of a stage. The b-th one is between the coefficients
+// i = 2(b - k) + k and i + m, where k = b mod m, with the twiddle ω^(k·2^stage).
+type fftStage struct {
+	m, stage   int
+	twiddles   []fr.Element
+	decimation Decimation
+}
+
+// butterfly returns the coefficients of the b-th butterfly, and its twiddle (nil for ω⁰ = 1)
+func (s *fftStage) butterfly(b int) (i, j int, w *fr.Element) {
+	k := b & (s.m - 1)
+	i = (b-k)*2 + k
+	if k != 0 {
+		w = &s.twiddles[k<<s.stage]
+	}
+	return i, i + s.m, w
+}
+
+// matrixRows are the rows of a matrix, whose columns are the polynomials
+type matrixRows []fr.Vector
+
+func (r matrixRows) butterflies(s *fftStage, start, end int) {
+	for b := start; b < end; b++ {
+		i, j, w := s.butterfly(b)
+		if s.decimation == DIT && w != nil {
+			r[j].ScalarMul(r[j], w)
+		}
+		butterflyRows(r[i], r[j])
+		if s.decimation == DIF && w != nil {
+			r[j].ScalarMul(r[j], w)
+		}
+	}
+}
+
+func (r matrixRows) scale(i int, c *fr.Element) {
+	r[i].ScalarMul(r[i], c)
+}
+
+// columns are polynomials stored in separate slices
+type columns [][]fr.Element
+
+func (c columns) butterflies(s *fftStage, start, end int) {
+	for _, p := range c {
+		for b := start; b < end; b++ {
+			i, j, w := s.butterfly(b)
+			if s.decimation == DIT && w != nil {
+				p[j].Mul(&p[j], w)
+			}
+			fr.Butterfly(&p[i], &p[j])
+			if s.decimation == DIF && w != nil {
+				p[j].Mul(&p[j], w)
+			}
+		}
+	}
+}
+
+func (c columns) scale(i int, s *fr.Element) {
+	for _, p := range c {
+		p[i].Mul(&p[i], s)
+	}
+}
+
+// butterflyBlockSize is the number of butterflies of a stage applied to a polynomial before the next one
+const butterflyBlockSize = 256
+
+// interleaved computes FFT or FFTInverse on all the polynomials of the set, stage by stage
+func (domain *Domain) interleaved(polynomials polynomialSet, decimation Decimation, inverse bool, opt fftConfig) {
+	n := int(domain.Cardinality)
+
+	// the coset scaling is done on the natural order coefficients: the input of FFT with DIF and
+	// the output of FFTInverse with DIT; with the other decimation, they are in bit-reversed order.
+	var scaling []fr.Element
+	if opt.coset {
+		scaling = domain.cosetTable
+		if inverse {
+			scaling = domain.cosetTableInv
+		}
+		if !domain.withPrecompute {
+			scaling = make([]fr.Element, n)
+			if inverse {
+				BuildExpTable(domain.FrMultiplicativeGenInv, scaling)
+			} else {
+				BuildExpTable(domain.FrMultiplicativeGen, scaling)
+			}
+		}
+	}
+	bitReversed := (decimation == DIF) == inverse
+	scale := func(extra *fr.Element) {
+		if scaling == nil && extra == nil {
+			return
+		}
+		nn := uint64(64 - bits.TrailingZeros64(uint64(n)))
+		parallel.Execute(n, func(start, end int) {
+			for i := start; i < end; i++ {
+				var s fr.Element
+				s.SetOne()
+				if scaling != nil {
+					j := i
+					if bitReversed {
+						j = int(bits.Reverse64(uint64(i)) >> nn)
+					}
+					s = scaling[j]
+				}
+				if extra != nil {
+					s.Mul(&s, extra)
+				}
+				polynomials.scale(i, &s)
+			}
+		}, opt.nbTasks)
+	}
+
+	// twiddles[k] = ωᵏ, the twiddles of the stage s are twiddles[k·2ˢ]
+	var twiddles []fr.Element
+	if n > 1 {
+		if domain.withPrecompute {
+			twiddles = domain.twiddles[0]
+			if inverse {
+				twiddles = domain.twiddlesInv[0]
+			}
+		} else {
+			twiddles = make([]fr.Element, n/2)
+			if inverse {
+				BuildExpTable(domain.GeneratorInv, twiddles)
+			} else {
+				BuildExpTable(domain.Generator, twiddles)
+			}
+		}
+	}
+
+	if !inverse {
+		scale(nil)
+	}
+
+	logN := bits.TrailingZeros64(uint64(n))
+	for s := 0; s < logN; s++ {
+		// DIF goes from the large butterflies to the small ones, DIT the other way around
+		stage := s
+		if decimation == DIT {
+			stage = logN - 1 - s
+		}
+		st := fftStage{m: n >> (stage + 1), stage: stage, twiddles: twiddles, decimation: decimation}
+		parallel.Execute(n/2, func(start, end int) {
+			// the butterflies are applied by blocks, whose twiddles are reused for all the polynomials
+			for b := start; b < end; b += butterflyBlockSize {
+				polynomials.butterflies(&st, b, min(b+butterflyBlockSize, end))
+			}
+		}, opt.nbTasks)
+	}
+
+	if inverse {
+		scale(&domain.CardinalityInv)
+	}
+}
+
+// matrixTransposed transforms the columns of the matrix by blocks of contiguous copies
+func (domain *Domain) matrixTransposed(matrix []fr.Element, nbColumns int, decimation Decimation, inverse bool, opts []Option, opt fftConfig) {
+	n := int(domain.Cardinality)
+	blockSize := min(nbColumns, max(opt.nbTasks, 1))
+	buf := make([]fr.Element, blockSize*n)
+	block := make([][]fr.Element, blockSize)
+
+	for c0 := 0; c0 < nbColumns; c0 += blockSize {
+		block = block[:min(blockSize, nbColumns-c0)]
+		for j := range block {
+			block[j] = buf[j*n : (j+1)*n]
+		}
+		transpose := func(toBlock bool) {
+			parallel.Execute(n, func(start, end int) {
+				for i := start; i < end; i++ {
+					row := matrix[i*nbColumns+c0 : i*nbColumns+c0+len(block)]
+					for j := range row {
+						if toBlock {
+							block[j][i] = row[j]
+						} else {
+							row[j] = block[j][i]
+						}
+					}
+				}
+			}, opt.nbTasks)
+		}
+		transpose(true)
+		domain.batch(block, decimation, inverse, opts)
+		transpose(false)
+	}
+}
+
+// butterflyRows sets (a, b) = (a + b, a - b) element-wise
+func butterflyRows(a, b fr.Vector) {
+	for j := range a {
+		fr.Butterfly(&a[j], &b[j])
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+var mixedRadixTestSizes = []uint64{1, 2, 3, 5, 6, 9, 12, 20, 24, 27, 45, 48, 75, 96, 100, 135, 192, 225, 360}
+
+func TestMixedRadixCardinality(t *testing.T) {
+	rMinusOne := fr.Modulus()
+	rMinusOne.Sub(rMinusOne, big.NewInt(1))
+
+	isSmooth := func(n uint64) bool {
+		for _, p := range []uint64{2, 3, 5} {
+			for n%p == 0 {
+				n /= p
+			}
+		}
+		return n == 1
+	}
+
+	for m := uint64(0); m <= 1000; m++ {
+		// smallest 5-smooth n ⩾ m dividing r - 1
+		expected := max(m, 1)
+		for !isSmooth(expected) || new(big.Int).Mod(rMinusOne, new(big.Int).SetUint64(expected)).Sign() != 0 {
+			expected++
+		}
+		n, radices, err := mixedRadixCardinality(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n != expected {
+			t.Fatalf("m = %d: expected cardinality %d, got %d", m, expected, n)
+		}
+		prod := uint64(1)
+		for _, r := range radices {
+			prod *= r
+		}
+		if prod != n {
+			t.Fatalf("m = %d: radices %v don't match the cardinality %d", m, radices, n)
+		}
+	}
+}
+
+func TestMixedRadixFFT(t *testing.T) {
+	for _, m := range mixedRadixTestSizes {
+		for domainName, opts := range map[string][]DomainOption{
+			"with precompute":    nil,
+			"without precompute": {WithoutPrecompute()},
+		} {
+			domain := NewMixedRadixDomain(m, opts...)
+			n := int(domain.Cardinality)
+			t.Run(fmt.Sprintf("%d/%v/%s", n, domain.Radices, domainName), func(t *testing.T) {
+				pol := make([]fr.Element, n)
+				for i := range pol {
+					pol[i].MustSetRandom()
+				}
+
+				// expected[i] = pol(Generatorⁱ), expectedCoset[i] = pol(FrMultiplicativeGen·Generatorⁱ)
+				expected := make([]fr.Element, n)
+				expectedCoset := make([]fr.Element, n)
+				var x fr.Element
+				x.SetOne()
+				for i := 0; i < n; i++ {
+					expected[i] = evaluatePolynomial(pol, x)
+					var xc fr.Element
+					xc.Mul(&x, &domain.FrMultiplicativeGen)
+					expectedCoset[i] = evaluatePolynomial(pol, xc)
+					x.Mul(&x, &domain.Generator)
+				}
+
+				checkEqual := func(name string, got, want []fr.Element) {
+					t.Helper()
+					for i := range want {
+						if !got[i].Equal(&want[i]) {
+							t.Fatalf("%s: mismatch at index %d", name, i)
+						}
+					}
+				}
+
+				for _, coset := range []bool{false, true} {
+					var fftOpts []Option
+					want := expected
+					if coset {
+						fftOpts = append(fftOpts, OnCoset())
+						want = expectedCoset
+					}
+					for _, nbTasks := range []int{1, 4} {
+						opts := append(fftOpts, WithNbTasks(nbTasks))
+						name := fmt.Sprintf("coset=%v nbTasks=%d", coset, nbTasks)
+
+						// DIF: natural order -> digit-reversed order
+						v := make([]fr.Element, n)
+						copy(v, pol)
+						domain.FFT(v, DIF, opts...)
+						domain.DigitReverseInverse(v)
+						checkEqual("DIF "+name, v, want)
+
+						// inverse DIT: digit-reversed order -> natural order
+						domain.DigitReverse(v)
+						domain.FFTInverse(v, DIT, opts...)
+						checkEqual("DIT inverse "+name, v, pol)
+
+						// DIT: digit-reversed order -> natural order
+						copy(v, pol)
+						domain.DigitReverse(v)
+						domain.FFT(v, DIT, opts...)
+						checkEqual("DIT "+name, v, want)
+
+						// inverse DIF: natural order -> digit-reversed order
+						domain.FFTInverse(v, DIF, opts...)
+						domain.DigitReverseInverse(v)
+						checkEqual("DIF inverse "+name, v, pol)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestMixedRadixDigitReverse(t *testing.T) {
+	for _, m := range mixedRadixTestSizes {
+		domain := NewMixedRadixDomain(m, WithoutPrecompute())
+		n := domain.Cardinality
+
+		v := make([]fr.Element, n)
+		for i := range v {
+			v[i].SetUint64(uint64(i))
+		}
+		domain.DigitReverse(v)
+
+		// check the permutation against its definition
+		seen := make([]bool, n)
+		for i := uint64(0); i < n; i++ {
+			j, w, rem, size := uint64(0), uint64(1), i, n
+			for _, r := range domain.Radices {
+				size /= r
+				j += (rem / size) * w
+				rem %= size
+				w *= r
+			}
+			var e fr.Element
+			e.SetUint64(j)
+			if !v[i].Equal(&e) {
+				t.Fatalf("n = %d: v[%d] should be the input v[%d]", n, i, j)
+			}
+			seen[j] = true
+		}
+		for j := range seen {
+			if !seen[j] {
+				t.Fatalf("n = %d: DigitReverse is not a permutation", n)
+			}
+		}
+
+		domain.DigitReverseInverse(v)
+		for i := range v {
+			var e fr.Element
+			e.SetUint64(uint64(i))
+			if !v[i].Equal(&e) {
+				t.Fatalf("n = %d: DigitReverseInverse(DigitReverse(v)) != v", n)
+			}
+		}
+	}
+}
+
+func TestMixedRadixTwiddles(t *testing.T) {
+	domain := NewMixedRadixDomain(360)
+	twiddles, err := domain.Twiddles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	twiddlesInv, err := domain.TwiddlesInv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(twiddles) != len(domain.Radices) || len(twiddlesInv) != len(domain.Radices) {
+		t.Fatal("one table of twiddles per stage expected")
+	}
+	cosetTable, err := domain.CosetTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cosetTableInv, err := domain.CosetTableInv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range cosetTable {
+		var one fr.Element
+		one.Mul(&cosetTable[i], &cosetTableInv[i])
+		if !one.IsOne() {
+			t.Fatal("cosetTableInv should be the inverse of cosetTable")
+		}
+	}
+
+	// the odd stages twiddles are the powers of Generator^(n/L)
+	L := domain.Cardinality
+	for k, r := range domain.Radices {
+		if r == 2 {
+			break
+		}
+		var w, e fr.Element
+		w.Exp(domain.Generator, new(big.Int).SetUint64(domain.Cardinality/L))
+		e.SetOne()
+		for j := range twiddles[k] {
+			if !twiddles[k][j].Equal(&e) {
+				t.Fatalf("stage %d: wrong twiddle %d", k, j)
+			}
+			e.Mul(&e, &w)
+		}
+		L /= r
+	}
+
+	domain = NewMixedRadixDomain(360, WithoutPrecompute())
+	if _, err := domain.Twiddles(); err == nil {
+		t.Fatal("twiddles should not be precomputed")
+	}
+	if _, err := domain.CosetTable(); err == nil {
+		t.Fatal("coset table should not be precomputed")
+	}
+}
+
+func BenchmarkMixedRadixFFT(b *testing.B) {
+	const logSize = 18
+	for _, m := range []uint64{1 << logSize, 3 << logSize, 5 << logSize, 9 << logSize} {
+		domain := NewMixedRadixDomain(m)
+		pol := make([]fr.Element, domain.Cardinality)
+		for i := range pol {
+			pol[i].MustSetRandom()
+		}
+		b.Run(fmt.Sprintf("%d/%v", domain.Cardinality, domain.Radices[:len(domain.Radices)-domain.nbRadix2Stages]), func(b *testing.B) {
+			b.ResetTimer()
+			for j := 0; j < b.N; j++ {
+				domain.FFT(pol, DIF)
+			}
+		})
+	}
+}
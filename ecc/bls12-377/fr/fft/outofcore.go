@@ -0,0 +1,370 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+)
+
+// Storage is a vector of field elements that may not fit in memory, for the out-of-core FFTs.
+// The i-th element is stored at offset i·fr.Bytes, in big-endian regular form
+// (see fr.BigEndian).
+//
+// *os.File implements Storage; MemStorage wraps a byte slice, for instance a memory-mapped file.
+type Storage interface {
+	io.ReaderAt
+	io.WriterAt
+}
+
+// MemStorage is a Storage backed by a byte slice, for instance a memory-mapped file (see syscall.Mmap).
+type MemStorage []byte
+
+// ReadAt implements io.ReaderAt
+func (m MemStorage) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 || off > int64(len(m)) {
+		return 0, errors.New("fft: invalid offset")
+	}
+	n := copy(p, m[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// WriteAt implements io.WriterAt
+func (m MemStorage) WriteAt(p []byte, off int64) (int, error) {
+	if off < 0 || off > int64(len(m)) {
+		return 0, errors.New("fft: invalid offset")
+	}
+	n := copy(m[off:], p)
+	if n < len(p) {
+		return n, io.ErrShortWrite
+	}
+	return n, nil
+}
+
+// default memory budget of the out-of-core FFTs, in bytes
+const defaultMemoryBudget = 1 << 28
+
+// ErrPrecomputedDomain is returned by the out-of-core FFTs on a domain built without the
+// WithoutPrecompute option, whose twiddles and coset tables take O(n) memory.
+var ErrPrecomputedDomain = errors.New("fft: the out-of-core FFTs need a domain built with WithoutPrecompute")
+
+// FFTOutOfCore computes FFT on the domain.Cardinality elements of data, in place.
+// The results are the same as FFT with the same decimation and options:
+// if decimation == DIT, the input must be in bit-reversed order;
+// if decimation == DIF, the output will be in bit-reversed order.
+//
+// It implements the Bailey four-step FFT: the vector is seen as a matrix of n₂ rows and n₁ columns,
+// n = n₁·n₂, and is transformed in two passes, one on the columns and one on the rows, reading and
+// writing the storage by blocks of columns or rows. The size of the blocks is set by the
+// WithMemoryBudget option; the budget must hold at least one column, that is about 2·√n elements.
+//
+// The domain must be built with the WithoutPrecompute option, so that it doesn't hold tables of
+// size n, e.g. NewDomain(n, WithoutPrecompute()); ErrPrecomputedDomain is returned otherwise.
+// Only the twiddles of the domains of size n₁ and n₂ are computed.
+func (domain *Domain) FFTOutOfCore(data Storage, decimation Decimation, opts ...Option) error {
+	return domain.fourStep(data, decimation, false, opts)
+}
+
+// FFTInverseOutOfCore computes FFTInverse on the domain.Cardinality elements of data, in place.
+// The results are the same as FFTInverse with the same decimation and options (see FFTOutOfCore).
+func (domain *Domain) FFTInverseOutOfCore(data Storage, decimation Decimation, opts ...Option) error {
+	return domain.fourStep(data, decimation, true, opts)
+}
+
+// fourStep transforms the matrix M of n₂ rows and n₁ columns, M[r][c] = data[r·n₁ + c].
+//
+// With DIF, the columns are transformed with DIF, then M[r][c] is multiplied by ω^(c·rev(r))
+// and the rows are transformed with DIF; the output is in bit-reversed order, since
+// rev(r·n₁ + c) = rev(c)·n₂ + rev(r) (the bit-reversal of r and c being on log(n₂) and log(n₁) bits).
+//
+// With DIT, the rows are transformed with DIT, then M[r][c] is multiplied by ω^(c·rev(r))
+// and the columns are transformed with DIT.
+func (domain *Domain) fourStep(data Storage, decimation Decimation, inverse bool, opts []Option) error {
+	if decimation != DIF && decimation != DIT {
+		panic("not implemented")
+	}
+	if domain.withPrecompute {
+		return ErrPrecomputedDomain
+	}
+	opt := fftOptions(opts)
+
+	n := domain.Cardinality
+	logN := bits.TrailingZeros64(n)
+	p := &fourStepPlan{
+		data:    data,
+		inverse: inverse,
+		nbTasks: opt.nbTasks,
+		logN1:   logN / 2,
+		logN2:   logN - logN/2,
+	}
+	p.n1 = 1 << p.logN1
+	p.n2 = 1 << p.logN2
+
+	// a block element takes fr.Bytes in memory, and as many bytes encoded
+	maxElements := opt.memoryBudget / (2 * fr.Bytes)
+	if maxElements < p.n2 {
+		return fmt.Errorf("fft: the memory budget must be at least %d bytes", 2*fr.Bytes*p.n2)
+	}
+	p.nbColumns = min(p.n1, maxElements/p.n2)
+	p.nbRows = min(p.n2, maxElements/p.n1)
+
+	p.omega = domain.Generator
+	p.shift = domain.FrMultiplicativeGen
+	if inverse {
+		p.omega = domain.GeneratorInv
+		p.shift = domain.FrMultiplicativeGenInv
+	}
+	p.rows = domain.subDomain(uint64(p.n1))
+	p.columns = domain.subDomain(uint64(p.n2))
+
+	// the coset scaling is done on the input for FFT, and on the output for FFTInverse,
+	// that is on the pass on the natural order entries.
+	scaleColumns := opt.coset && ((decimation == DIF) != inverse)
+	scaleRows := opt.coset && ((decimation == DIF) == inverse)
+
+	if decimation == DIF {
+		if err := p.columnsPass(decimation, scaleColumns, true); err != nil {
+			return err
+		}
+		return p.rowsPass(decimation, scaleRows, false)
+	}
+	if err := p.rowsPass(decimation, scaleRows, true); err != nil {
+		return err
+	}
+	return p.columnsPass(decimation, scaleColumns, false)
+}
+
+// subDomain returns the domain of size m of generator Generator^(Cardinality/m)
+func (domain *Domain) subDomain(m uint64) *Domain {
+	sub := &Domain{
+		Cardinality:            m,
+		FrMultiplicativeGen:    domain.FrMultiplicativeGen,
+		FrMultiplicativeGenInv: domain.FrMultiplicativeGenInv,
+		withPrecompute:         true,
+	}
+	sub.Generator.Exp(domain.Generator, new(big.Int).SetUint64(domain.Cardinality/m))
+	sub.GeneratorInv.Inverse(&sub.Generator)
+	sub.CardinalityInv.SetUint64(m).Inverse(&sub.CardinalityInv)
+	sub.preComputeTwiddles()
+	return sub
+}
+
+type fourStepPlan struct {
+	data    Storage
+	inverse bool
+	nbTasks int
+
+	// the matrix has n₂ = 2^logN2 rows and n₁ = 2^logN1 columns, n₁ ⩽ n₂
+	n1, n2       int
+	logN1, logN2 int
+
+	// number of columns or rows per block
+	nbColumns, nbRows int
+
+	// ω (or ω⁻¹) and the coset shift (or its inverse)
+	omega, shift fr.Element
+
+	// the domains of size n₁ and n₂
+	rows, columns *Domain
+}
+
+// transform applies the FFT of the decimation on v, on the given domain
+func (p *fourStepPlan) transform(domain *Domain, v []fr.Element, decimation Decimation) {
+	if p.inverse {
+		domain.FFTInverse(v, decimation, WithNbTasks(1))
+	} else {
+		domain.FFT(v, decimation, WithNbTasks(1))
+	}
+}
+
+// columnsPass transforms the columns by blocks; the column c of a block is stored at block[c·n₂:(c+1)·n₂].
+// If scale is set, M[r][c] is scaled by shift^(r·n₁ + c), before the transform for FFT and after
+// for FFTInverse. If twiddle is set, M[r][c] is multiplied by ω^(c·rev(r)) after the transform.
+func (p *fourStepPlan) columnsPass(decimation Decimation, scale, twiddle bool) error {
+	block := make([]fr.Element, p.nbColumns*p.n2)
+	buf := make([]byte, p.nbColumns*p.n2*fr.Bytes)
+
+	var shiftStep fr.Element
+	shiftStep.Exp(p.shift, big.NewInt(int64(p.n1)))
+
+	for c0 := 0; c0 < p.n1; c0 += p.nbColumns {
+		nbColumns := min(p.nbColumns, p.n1-c0)
+		rowSize := nbColumns * fr.Bytes
+		for r := 0; r < p.n2; r++ {
+			if err := p.read(buf[r*rowSize:(r+1)*rowSize], r*p.n1+c0); err != nil {
+				return err
+			}
+		}
+		errs := make([]error, nbColumns)
+		parallel.Execute(nbColumns, func(start, end int) {
+			for c := start; c < end; c++ {
+				column := block[c*p.n2 : (c+1)*p.n2]
+				for r := range column {
+					column[r], errs[c] = fr.BigEndian.Element((*[fr.Bytes]byte)(buf[r*rowSize+c*fr.Bytes:]))
+					if errs[c] != nil {
+						return
+					}
+				}
+
+				var s fr.Element
+				if scale {
+					s.Exp(p.shift, big.NewInt(int64(c0+c)))
+				}
+				if scale && !p.inverse {
+					scaleGeometric(column, s, shiftStep)
+				}
+				p.transform(p.columns, column, decimation)
+				if scale && p.inverse {
+					scaleGeometric(column, s, shiftStep)
+				}
+				if twiddle {
+					// M[r][c] *= ω^(c·rev(r))
+					var w, t fr.Element
+					w.Exp(p.omega, big.NewInt(int64(c0+c)))
+					t.SetOne()
+					for k := 0; k < p.n2; k++ {
+						r := reverseBits(k, p.logN2)
+						column[r].Mul(&column[r], &t)
+						t.Mul(&t, &w)
+					}
+				}
+
+				for r := range column {
+					fr.BigEndian.PutElement((*[fr.Bytes]byte)(buf[r*rowSize+c*fr.Bytes:]), column[r])
+				}
+			}
+		}, p.nbTasks)
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+
+		for r := 0; r < p.n2; r++ {
+			if err := p.write(buf[r*rowSize:(r+1)*rowSize], r*p.n1+c0); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rowsPass transforms the rows by blocks.
+// If scale is set, M[r][c] is scaled by shift^rev(r·n₁ + c), before the transform for FFT and after
+// for FFTInverse. If twiddle is set, M[r][c] is multiplied by ω^(c·rev(r)) after the transform.
+func (p *fourStepPlan) rowsPass(decimation Decimation, scale, twiddle bool) error {
+	block := make([]fr.Element, p.nbRows*p.n1)
+	buf := make([]byte, p.nbRows*p.n1*fr.Bytes)
+
+	// shift^rev(r·n₁ + c) = shift^rev(r) · (shift^n₂)^rev(c)
+	var shiftTable []fr.Element
+	if scale {
+		var shiftStep fr.Element
+		shiftStep.Exp(p.shift, big.NewInt(int64(p.n2)))
+		shiftTable = make([]fr.Element, p.n1)
+		BuildExpTable(shiftStep, shiftTable)
+		BitReverse(shiftTable)
+	}
+
+	for r0 := 0; r0 < p.n2; r0 += p.nbRows {
+		nbRows := min(p.nbRows, p.n2-r0)
+		buf := buf[:nbRows*p.n1*fr.Bytes]
+		if err := p.read(buf, r0*p.n1); err != nil {
+			return err
+		}
+		errs := make([]error, nbRows)
+		parallel.Execute(nbRows, func(start, end int) {
+			for i := start; i < end; i++ {
+				r := r0 + i
+				row := block[i*p.n1 : (i+1)*p.n1]
+				for c := range row {
+					row[c], errs[i] = fr.BigEndian.Element((*[fr.Bytes]byte)(buf[(i*p.n1+c)*fr.Bytes:]))
+					if errs[i] != nil {
+						return
+					}
+				}
+
+				var s fr.Element
+				if scale {
+					s.Exp(p.shift, big.NewInt(int64(reverseBits(r, p.logN2))))
+				}
+				if scale && !p.inverse {
+					scaleTable(row, s, shiftTable)
+				}
+				p.transform(p.rows, row, decimation)
+				if scale && p.inverse {
+					scaleTable(row, s, shiftTable)
+				}
+				if twiddle {
+					// M[r][c] *= ω^(c·rev(r))
+					var w fr.Element
+					w.Exp(p.omega, big.NewInt(int64(reverseBits(r, p.logN2))))
+					scaleGeometric(row, fr.One(), w)
+				}
+
+				for c := range row {
+					fr.BigEndian.PutElement((*[fr.Bytes]byte)(buf[(i*p.n1+c)*fr.Bytes:]), row[c])
+				}
+			}
+		}, p.nbTasks)
+		if err := errors.Join(errs...); err != nil {
+			return err
+		}
+
+		if err := p.write(buf, r0*p.n1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// read reads len(buf) bytes of the storage, from the i-th element
+func (p *fourStepPlan) read(buf []byte, i int) error {
+	n, err := p.data.ReadAt(buf, int64(i)*fr.Bytes)
+	if n == len(buf) && (err == nil || err == io.EOF) {
+		return nil
+	}
+	if err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return fmt.Errorf("fft: reading the storage: %w", err)
+}
+
+// write writes buf to the storage, from the i-th element
+func (p *fourStepPlan) write(buf []byte, i int) error {
+	if _, err := p.data.WriteAt(buf, int64(i)*fr.Bytes); err != nil {
+		return fmt.Errorf("fft: writing the storage: %w", err)
+	}
+	return nil
+}
+
+// scaleGeometric sets v[i] *= s·wⁱ
+func scaleGeometric(v []fr.Element, s, w fr.Element) {
+	for i := range v {
+		v[i].Mul(&v[i], &s)
+		s.Mul(&s, &w)
+	}
+}
+
+// scaleTable sets v[i] *= s·table[i]
+func scaleTable(v []fr.Element, s fr.Element, table []fr.Element) {
+	for i := range v {
+		v[i].Mul(&v[i], &s).Mul(&v[i], &table[i])
+	}
+}
+
+// reverseBits returns the bit-reversal of i on logN bits
+func reverseBits(i, logN int) int {
+	return int(bits.Reverse64(uint64(i)) >> (64 - logN))
+}
@@ -0,0 +1,124 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidPoint             = errors.New("invalid point")
+	ErrPairingConsistency       = errors.New("the G₁ powers are not consistent with [α]G₂")
+	ErrInconsistentVerifyingKey = errors.New("the verifying key is not consistent with the proving key")
+)
+
+// Check verifies that the SRS is well formed, that is
+//   - all its points are in the correct subgroup, and the generators are not the point at infinity,
+//   - Pk.G1 = [G₁, [α]G₁, [α²]G₁, ...] where Vk.G2 = [G₂, [α]G₂] and Vk.G1 = G₁,
+//   - the precomputed lines of Vk correspond to Vk.G2.
+//
+// It is meant for SRS obtained from an untrusted source, or read with UnsafeReadFrom or ReadDump,
+// and costs a subgroup check per point, two multi-exponentiations of size len(Pk.G1)-1 and two
+// pairings. On an invalid point, the returned error wraps ErrInvalidPoint and mentions the
+// smallest offending index.
+func (srs *SRS) Check() error {
+	if len(srs.Pk.G1) < 2 {
+		return ErrMinSRSSize
+	}
+
+	if err := checkSubGroup(srs.Pk.G1); err != nil {
+		return err
+	}
+	for i := range srs.Vk.G2 {
+		if srs.Vk.G2[i].IsInfinity() || !srs.Vk.G2[i].IsInSubGroup() {
+			return fmt.Errorf("%w: G2 point %d", ErrInvalidPoint, i)
+		}
+	}
+	if srs.Pk.G1[0].IsInfinity() {
+		return fmt.Errorf("%w: G1 point 0", ErrInvalidPoint)
+	}
+
+	if !srs.Vk.G1.Equal(&srs.Pk.G1[0]) {
+		return fmt.Errorf("%w: G1 generator", ErrInconsistentVerifyingKey)
+	}
+	for i := range srs.Vk.Lines {
+		if srs.Vk.Lines[i] != bls12377.PrecomputeLines(srs.Vk.G2[i]) {
+			return fmt.Errorf("%w: lines of G2 point %d", ErrInconsistentVerifyingKey, i)
+		}
+	}
+
+	return checkPowers(srs.Pk.G1, srs.Vk.G2)
+}
+
+// checkSubGroup checks in parallel that the points are in the correct subgroup
+func checkSubGroup(points []bls12377.G1Affine) error {
+	var errs firstError
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			if !points[i].IsInSubGroup() {
+				errs.set(i, fmt.Errorf("%w: G1 point %d", ErrInvalidPoint, i))
+				return
+			}
+		}
+	})
+	return errs.err
+}
+
+// checkPowers checks that g1 = [G, [α]G, [α²]G, ...] where g2 = [G₂, [α]G₂], with
+// A = ∑ᵢrᵢg1[i] and B = ∑ᵢrᵢg1[i+1] for random rᵢ: e(B, G₂) = e(A, [α]G₂).
+func checkPowers(g1 []bls12377.G1Affine, g2 [2]bls12377.G2Affine) error {
+	n := len(g1) - 1
+	r := make([]fr.Element, n)
+	for i := range r {
+		if _, err := r[i].SetRandom(); err != nil {
+			return err
+		}
+	}
+
+	var a, b bls12377.G1Affine
+	if _, err := a.MultiExp(g1[:n], r, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	if _, err := b.MultiExp(g1[1:], r, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	a.Neg(&a)
+
+	check, err := bls12377.PairingCheck(
+		[]bls12377.G1Affine{b, a},
+		[]bls12377.G2Affine{g2[0], g2[1]},
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrPairingConsistency
+	}
+	return nil
+}
+
+// firstError keeps the error with the smallest index among concurrent tasks
+type firstError struct {
+	lock  sync.Mutex
+	index int
+	err   error
+}
+
+func (e *firstError) set(index int, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.err == nil || index < e.index {
+		e.index = index
+		e.err = err
+	}
+}
@@ -0,0 +1,377 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr/fft"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidFK20Parameters = errors.New("invalid FK20 parameters: domain and cell sizes must be powers of 2, with cellSize ≤ domainSize and polySize ≤ domainSize, len(SRS)")
+	ErrFK20CellSize          = errors.New("single point openings require a cell size of 1")
+	ErrVerifyCellProof       = errors.New("can't verify cell opening proof")
+)
+
+// FK20 computes the opening proofs of a polynomial on all the cosets of size cellSize
+// of the domain of cardinality domainSize, with the Feist–Khovratovich algorithm
+// ("Fast amortized KZG proofs", https://eprint.iacr.org/2023/033).
+//
+// Let ω be the generator of the domain and ζ = ω^(domainSize/cellSize) the generator of
+// the subgroup of order cellSize. The k-th cell is the coset {ωᵏζʲ, j < cellSize}, and
+// its proof is the commitment to the quotient of the polynomial by X^cellSize - ω^(k*cellSize).
+// With a cell size of 1, the proofs are the KZG opening proofs at each ωᵏ, as computed by Open.
+//
+// Computing the proofs of all the cells costs O(n log n) group operations instead of O(n²)
+// for n calls to Open. The precomputation depends only on the SRS and the sizes.
+type FK20 struct {
+	polySize   uint64
+	domainSize uint64
+	cellSize   uint64
+
+	// the quotient commitments are computed as a Toeplitz matrix - vector product,
+	// embedded in circulant matrices of size 2*toeplitzSize.
+	toeplitzSize uint64
+
+	// srsFFT[i][r] is the i-th entry of the FFT of the SRS points [τᵖˡ⁺ʳ]G₁ in reversed order
+	// (ℓ = cellSize), for the circulant matrices embedding of each r < cellSize
+	srsFFT [][]bls12377.G1Affine
+
+	// domainExt domain of size 2*toeplitzSize
+	domainExt *fft.Domain
+
+	// twiddles for the G₁ FFTs
+	twiddlesExtInv []*big.Int
+	twiddlesCells  []*big.Int
+}
+
+// NewFK20 precomputes the data to compute the proofs of polynomials of at most polySize
+// coefficients, on the cells of size cellSize of the domain of cardinality domainSize.
+//
+// domainSize and cellSize must be powers of 2 with cellSize ≤ domainSize, and polySize
+// must be smaller than domainSize and len(pk.G1).
+func NewFK20(pk ProvingKey, polySize, domainSize, cellSize uint64) (*FK20, error) {
+	if polySize == 0 || polySize > domainSize || polySize > uint64(len(pk.G1)) ||
+		bits.OnesCount64(domainSize) != 1 || bits.OnesCount64(cellSize) != 1 || cellSize > domainSize {
+		return nil, ErrInvalidFK20Parameters
+	}
+
+	fk := &FK20{
+		polySize:   polySize,
+		domainSize: domainSize,
+		cellSize:   cellSize,
+	}
+	fk.toeplitzSize = ecc.NextPowerOfTwo((polySize + cellSize - 1) / cellSize)
+	extSize := 2 * fk.toeplitzSize
+	fk.domainExt = fft.NewDomain(extSize)
+
+	var err error
+	if fk.twiddlesExtInv, err = computeTwiddles(int(extSize), true); err != nil {
+		return nil, err
+	}
+	if fk.twiddlesCells, err = computeTwiddles(int(domainSize/cellSize), false); err != nil {
+		return nil, err
+	}
+	twiddlesExt, err := computeTwiddles(int(extSize), false)
+	if err != nil {
+		return nil, err
+	}
+
+	// for each r < ℓ, a⁽ʳ⁾ⱼ = [τ⁽ᵗ⁻¹⁻ʲ⁾ˡ⁺ʳ]G₁ for j < t (t = toeplitzSize), padded with zeroes.
+	// Only the points [τⁱ]G₁ with i < polySize - ℓ are used.
+	n := int(extSize)
+	l := int(cellSize)
+	t := int(fk.toeplitzSize)
+	srsFFT := make([][]bls12377.G1Jac, l)
+	parallel.Execute(l, func(start, end int) {
+		for r := start; r < end; r++ {
+			srsFFT[r] = make([]bls12377.G1Jac, n)
+			for j := 0; j < t; j++ {
+				if i := (t-1-j)*l + r; i+l < int(polySize) {
+					srsFFT[r][j].FromAffine(&pk.G1[i])
+				}
+			}
+			fftG1(srsFFT[r], twiddlesExt)
+		}
+	})
+
+	// transpose, so that the multi exponentiations read contiguous points
+	fk.srsFFT = make([][]bls12377.G1Affine, n)
+	parallel.Execute(n, func(start, end int) {
+		column := make([]bls12377.G1Jac, l)
+		for i := start; i < end; i++ {
+			for r := 0; r < l; r++ {
+				column[r] = srsFFT[r][i]
+			}
+			fk.srsFFT[i] = bls12377.BatchJacobianToAffineG1(column)
+		}
+	})
+
+	return fk, nil
+}
+
+// ComputeProofs returns the commitments to the quotients of p by X^ℓ - ω^(kℓ) (ℓ = cellSize),
+// for each cell k < domainSize/cellSize, in natural order.
+//
+// p is in canonical form and has at most polySize coefficients.
+func (fk *FK20) ComputeProofs(p []fr.Element) ([]bls12377.G1Affine, error) {
+	if len(p) == 0 || uint64(len(p)) > fk.polySize {
+		return nil, ErrInvalidPolynomialSize
+	}
+
+	n := int(2 * fk.toeplitzSize)
+	l := int(fk.cellSize)
+	t := int(fk.toeplitzSize)
+
+	// The quotient of p by X^ℓ - a is committed to ∑ₘhₘaᵐ where
+	// hₘ = ∑ᵢpᵢ₊₍ₘ₊₁₎ₗ[τⁱ]G₁ = ∑ᵣ∑ₛp⁽ʳ⁾ₛ₊ₘ₊₁[τˢˡ⁺ʳ]G₁ with p⁽ʳ⁾ₛ = pₛₗ₊ᵣ.
+	// For each r, hₘ⁽ʳ⁾ is a Toeplitz matrix - vector product computed as the
+	// circular convolution of the reversed SRS points with p⁽ʳ⁾, of size 2t.
+
+	// FFT of the p⁽ʳ⁾, scaled by 1/2t for the inverse FFT over G₁
+	pFFT := make([][]fr.Element, l)
+	parallel.Execute(l, func(start, end int) {
+		for r := start; r < end; r++ {
+			pFFT[r] = make([]fr.Element, n)
+			for j := 0; j < t; j++ {
+				if i := j*l + r; i < len(p) {
+					pFFT[r][j].Mul(&p[i], &fk.domainExt.CardinalityInv)
+				}
+			}
+			fk.domainExt.FFT(pFFT[r], fft.DIF)
+			fft.BitReverse(pFFT[r])
+		}
+	})
+
+	// pointwise products, summed over r
+	hExt := make([]bls12377.G1Jac, n)
+	var err error
+	var lock sync.Mutex
+	parallel.Execute(n, func(start, end int) {
+		scalars := make([]fr.Element, l)
+		var s big.Int
+		var tmp bls12377.G1Jac
+		for i := start; i < end; i++ {
+			for r := 0; r < l; r++ {
+				scalars[r] = pFFT[r][i]
+			}
+			// for small cells, the multi exponentiation overhead dominates
+			if l < 8 {
+				for r := 0; r < l; r++ {
+					scalars[r].BigInt(&s)
+					tmp.FromAffine(&fk.srsFFT[i][r])
+					tmp.ScalarMultiplication(&tmp, &s)
+					hExt[i].AddAssign(&tmp)
+				}
+				continue
+			}
+			if _, _err := hExt[i].MultiExp(fk.srsFFT[i], scalars, ecc.MultiExpConfig{NbTasks: 1}); _err != nil {
+				lock.Lock()
+				err = _err
+				lock.Unlock()
+				return
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// back to the coefficients of the convolution, hₘ is at index m+t
+	fftG1(hExt, fk.twiddlesExtInv)
+
+	// evaluate ∑ₘhₘXᵐ on the (domainSize/cellSize)-th roots of unity ω^(kℓ)
+	h := make([]bls12377.G1Jac, fk.domainSize/fk.cellSize)
+	copy(h, hExt[t:])
+	fftG1(h, fk.twiddlesCells)
+
+	return bls12377.BatchJacobianToAffineG1(h), nil
+}
+
+// Open returns the opening proofs of p at each point ωᵏ of the domain, in natural order.
+// The FK20 cell size must be 1.
+//
+// The proofs are identical to the ones computed by Open(p, ωᵏ, pk) and are verified with Verify.
+func (fk *FK20) Open(p []fr.Element) ([]OpeningProof, error) {
+	if fk.cellSize != 1 {
+		return nil, ErrFK20CellSize
+	}
+	h, err := fk.ComputeProofs(p)
+	if err != nil {
+		return nil, err
+	}
+	evaluations := fk.evaluate(p)
+
+	proofs := make([]OpeningProof, len(h))
+	for i := range proofs {
+		proofs[i].H = h[i]
+		proofs[i].ClaimedValue = evaluations[i]
+	}
+	return proofs, nil
+}
+
+// CellOpeningProof opening proof of a polynomial on a coset x⋅<ζ> of the subgroup of order ℓ.
+//
+// implements io.ReaderFrom and io.WriterTo
+type CellOpeningProof struct {
+	// H commitment to the quotient of the polynomial by X^ℓ - x^ℓ
+	H bls12377.G1Affine
+
+	// ClaimedValues purported values at xζʲ, for j < ℓ
+	ClaimedValues []fr.Element
+}
+
+// OpenCells returns the opening proofs of p on each cell of the domain, in natural order.
+// The k-th cell is the coset ωᵏ⋅<ζ>, and the proofs are verified with VerifyCell.
+func (fk *FK20) OpenCells(p []fr.Element) ([]CellOpeningProof, error) {
+	h, err := fk.ComputeProofs(p)
+	if err != nil {
+		return nil, err
+	}
+	evaluations := fk.evaluate(p)
+
+	// ωᵏζʲ = ω^(k + j*nbCells)
+	nbCells := len(h)
+	proofs := make([]CellOpeningProof, nbCells)
+	for k := range proofs {
+		proofs[k].H = h[k]
+		proofs[k].ClaimedValues = make([]fr.Element, fk.cellSize)
+		for j := range proofs[k].ClaimedValues {
+			proofs[k].ClaimedValues[j] = evaluations[k+j*nbCells]
+		}
+	}
+	return proofs, nil
+}
+
+// CellShift returns ωᵏ, the shift of the k-th cell.
+func (fk *FK20) CellShift(k int) fr.Element {
+	generator, _ := fr.Generator(fk.domainSize)
+	var res fr.Element
+	res.Exp(generator, big.NewInt(int64(k)))
+	return res
+}
+
+// evaluate returns the evaluations of p on the domain, in natural order
+func (fk *FK20) evaluate(p []fr.Element) []fr.Element {
+	evaluations := make([]fr.Element, fk.domainSize)
+	copy(evaluations, p)
+	domain := fft.NewDomain(fk.domainSize, fft.WithoutPrecompute())
+	domain.FFT(evaluations, fft.DIF)
+	fft.BitReverse(evaluations)
+	return evaluations
+}
+
+// CellVerifyingKey used to verify cell opening proofs for cells of size ℓ = len(G1)
+type CellVerifyingKey struct {
+	G1 []bls12377.G1Affine  // [G₁, [τ]G₁, ..., [τˡ⁻¹]G₁]
+	G2 [2]bls12377.G2Affine // [G₂, [τˡ]G₂]
+}
+
+// NewCellVerifyingKey returns the key to verify the proofs of cells of size cellSize, from
+// the G₁ powers of the proving key and the G₂ powers [G₂, [τ]G₂, ...] published by the
+// ceremony, for instance read with G2PowersFromPtau.
+//
+// cellSize must be a power of 2 smaller than len(pk.G1) and len(g2Powers). The two sets
+// of powers are checked to use the same τ with e([τˡ]G₁, G₂) = e(G₁, [τˡ]G₂).
+func NewCellVerifyingKey(pk ProvingKey, g2Powers []bls12377.G2Affine, cellSize uint64) (CellVerifyingKey, error) {
+	if bits.OnesCount64(cellSize) != 1 || cellSize >= uint64(len(pk.G1)) || cellSize >= uint64(len(g2Powers)) {
+		return CellVerifyingKey{}, ErrInvalidFK20Parameters
+	}
+	vk := CellVerifyingKey{
+		G1: pk.G1[:cellSize:cellSize],
+		G2: [2]bls12377.G2Affine{g2Powers[0], g2Powers[cellSize]},
+	}
+
+	// e([τˡ]G₁, G₂)⋅e(-G₁, [τˡ]G₂) == 1
+	var g1Neg bls12377.G1Affine
+	g1Neg.Neg(&pk.G1[0])
+	check, err := bls12377.PairingCheck(
+		[]bls12377.G1Affine{pk.G1[cellSize], g1Neg},
+		[]bls12377.G2Affine{vk.G2[0], vk.G2[1]},
+	)
+	if err != nil {
+		return CellVerifyingKey{}, err
+	}
+	if !check {
+		return CellVerifyingKey{}, ErrInconsistentVerifyingKey
+	}
+	return vk, nil
+}
+
+// VerifyCell verifies an opening proof on the coset x⋅<ζ> of the subgroup of order ℓ = len(vk.G1),
+// for instance computed by FK20.OpenCells, where the k-th cell has shift x = ωᵏ.
+//
+// It checks e(C - [I(τ)]G₁, G₂) = e(H, [τˡ - xˡ]G₂) where I is the interpolation
+// polynomial of the claimed values on the coset.
+func VerifyCell(commitment *Digest, proof *CellOpeningProof, x fr.Element, vk CellVerifyingKey) error {
+	l := len(vk.G1)
+	if l == 0 || bits.OnesCount(uint(l)) != 1 || len(proof.ClaimedValues) != l {
+		return ErrInvalidNbEvaluations
+	}
+
+	// coefficients of I: I(xζʲ) = ∑ₜ(cₜxᵗ)ζʲᵗ so cₜ = x⁻ᵗ(IFFT(v))ₜ
+	coefficients := make([]fr.Element, l)
+	copy(coefficients, proof.ClaimedValues)
+	if l > 1 {
+		domain := fft.NewDomain(uint64(l), fft.WithoutPrecompute())
+		domain.FFTInverse(coefficients, fft.DIF)
+		fft.BitReverse(coefficients)
+	}
+	var xInv, acc fr.Element
+	xInv.Inverse(&x)
+	acc.SetOne()
+	for i := range coefficients {
+		coefficients[i].Mul(&coefficients[i], &acc)
+		acc.Mul(&acc, &xInv)
+	}
+
+	// C - [I(τ)]G₁
+	var interpolation bls12377.G1Jac
+	if _, err := interpolation.MultiExp(vk.G1, coefficients, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	var lhs bls12377.G1Jac
+	lhs.FromAffine(commitment)
+	lhs.SubAssign(&interpolation)
+	var lhsAff bls12377.G1Affine
+	lhsAff.FromJacobian(&lhs)
+
+	// [τˡ - xˡ]G₂
+	var xl fr.Element
+	var xlBigInt big.Int
+	xl.Exp(x, big.NewInt(int64(l)))
+	xl.BigInt(&xlBigInt)
+	var rhs, xlG2 bls12377.G2Jac
+	rhs.FromAffine(&vk.G2[1])
+	xlG2.FromAffine(&vk.G2[0])
+	xlG2.ScalarMultiplication(&xlG2, &xlBigInt)
+	rhs.SubAssign(&xlG2)
+	var rhsAff bls12377.G2Affine
+	rhsAff.FromJacobian(&rhs)
+
+	// e(C - [I(τ)]G₁, G₂)⋅e(-H, [τˡ - xˡ]G₂) == 1
+	var hNeg bls12377.G1Affine
+	hNeg.Neg(&proof.H)
+	check, err := bls12377.PairingCheck(
+		[]bls12377.G1Affine{lhsAff, hNeg},
+		[]bls12377.G2Affine{vk.G2[0], rhsAff},
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyCellProof
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+
+	"github.com/consensys/gnark-crypto/utils/testutils"
+)
+
+func TestNewHidingSRS(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := NewHidingSRS(testSrs, big.NewInt(0))
+	assert.ErrorIs(err, ErrInvalidHidingGenerator)
+	_, err = NewHidingSRS(testSrs, fr.Modulus())
+	assert.ErrorIs(err, ErrInvalidHidingGenerator)
+
+	srs, err := NewHidingSRS(testSrs, big.NewInt(7))
+	assert.NoError(err)
+	assert.Equal(len(testSrs.Pk.G1), len(srs.Pk.H))
+	assert.Equal(testSrs.Vk, srs.Vk.VerifyingKey)
+	assert.True(srs.Pk.H[0].Equal(&srs.Vk.H))
+
+	// [αⁱ]H = [7αⁱ]G₁
+	var expected Digest
+	expected.ScalarMultiplication(&testSrs.Pk.G1[5], big.NewInt(7))
+	assert.True(expected.Equal(&srs.Pk.H[5]))
+}
+
+func TestOpenHiding(t *testing.T) {
+	const (
+		polySize    = 60
+		hidingBound = 2
+	)
+
+	test := func(srs *SRS) func(*testing.T) {
+		return func(t *testing.T) {
+			assert := require.New(t)
+
+			var gamma fr.Element
+			gamma.MustSetRandom()
+			var bGamma big.Int
+			gamma.BigInt(&bGamma)
+			hidingSrs, err := NewHidingSRS(srs, &bGamma)
+			assert.NoError(err)
+
+			f := randomPolynomial(polySize)
+			digest, blinding, err := CommitHiding(f, hidingSrs.Pk, hidingBound)
+			assert.NoError(err)
+			assert.Equal(hidingBound+1, len(blinding))
+
+			// the commitment is not the plain KZG commitment, and is randomized
+			plain, err := Commit(f, srs.Pk)
+			assert.NoError(err)
+			assert.False(plain.Equal(&digest), "commitment is not hiding")
+			other, _, err := CommitHiding(f, hidingSrs.Pk, hidingBound)
+			assert.NoError(err)
+			assert.False(other.Equal(&digest), "commitment is not randomized")
+
+			// the commitment is [f(α)]G₁ + [r(α)]H
+			blindingCommitment, err := commitHiding(nil, blinding, hidingSrs.Pk)
+			assert.NoError(err)
+			plain.Add(&plain, &blindingCommitment)
+			assert.True(plain.Equal(&digest), "inconsistent hiding commitment")
+
+			for i := 0; i < hidingBound; i++ {
+				var point fr.Element
+				point.MustSetRandom()
+
+				proof, err := OpenHiding(f, blinding, point, hidingSrs.Pk)
+				assert.NoError(err)
+				expected := eval(f, point)
+				assert.True(expected.Equal(&proof.ClaimedValue), "inconsistent claimed value")
+
+				assert.NoError(VerifyHiding(&digest, &proof, point, hidingSrs.Vk))
+
+				// wrong claimed value
+				wrongProof := proof
+				wrongProof.ClaimedValue.Double(&wrongProof.ClaimedValue)
+				assert.ErrorIs(VerifyHiding(&digest, &wrongProof, point, hidingSrs.Vk), ErrVerifyOpeningProof)
+
+				// wrong blinding value
+				wrongProof = proof
+				wrongProof.BlindingValue.Double(&wrongProof.BlindingValue)
+				assert.ErrorIs(VerifyHiding(&digest, &wrongProof, point, hidingSrs.Vk), ErrVerifyOpeningProof)
+
+				// wrong point
+				var wrongPoint fr.Element
+				wrongPoint.Double(&point)
+				assert.ErrorIs(VerifyHiding(&digest, &proof, wrongPoint, hidingSrs.Vk), ErrVerifyOpeningProof)
+			}
+
+			// constant polynomial
+			digest, blinding, err = CommitHiding(f[:1], hidingSrs.Pk, hidingBound)
+			assert.NoError(err)
+			var point fr.Element
+			point.MustSetRandom()
+			proof, err := OpenHiding(f[:1], blinding, point, hidingSrs.Pk)
+			assert.NoError(err)
+			assert.NoError(VerifyHiding(&digest, &proof, point, hidingSrs.Vk))
+
+			// invalid sizes
+			_, _, err = CommitHiding(f, hidingSrs.Pk, 0)
+			assert.ErrorIs(err, ErrInvalidHidingBound)
+			_, _, err = CommitHiding(f, hidingSrs.Pk, len(hidingSrs.Pk.H))
+			assert.ErrorIs(err, ErrInvalidHidingBound)
+			_, _, err = CommitHiding(nil, hidingSrs.Pk, hidingBound)
+			assert.ErrorIs(err, ErrInvalidPolynomialSize)
+			_, err = OpenHiding(f, blinding[:1], point, hidingSrs.Pk)
+			assert.ErrorIs(err, ErrInvalidHidingBound)
+		}
+	}
+	t.Run("unsafe", test(testSrs))
+	t.Run("mpcsetup", test(mpcGetSrs(t)))
+}
+
+func TestBatchOpenSinglePointHiding(t *testing.T) {
+	assert := require.New(t)
+
+	srs, err := NewHidingSRS(testSrs, big.NewInt(-3))
+	assert.NoError(err)
+
+	const nbPolys = 5
+	polynomials := make([][]fr.Element, nbPolys)
+	blindings := make([][]fr.Element, nbPolys)
+	digests := make([]Digest, nbPolys)
+	for i := range polynomials {
+		polynomials[i] = randomPolynomial(20 + 3*i)
+		digests[i], blindings[i], err = CommitHiding(polynomials[i], srs.Pk, 1+i%2)
+		assert.NoError(err)
+	}
+
+	var point fr.Element
+	point.MustSetRandom()
+
+	proof, err := BatchOpenSinglePointHiding(polynomials, blindings, digests, point, sha256.New(), srs.Pk, []byte("test"))
+	assert.NoError(err)
+	for i := range polynomials {
+		expected := eval(polynomials[i], point)
+		assert.True(expected.Equal(&proof.ClaimedValues[i]), "inconsistent claimed value")
+	}
+
+	assert.NoError(BatchVerifySinglePointHiding(digests, &proof, point, sha256.New(), srs.Vk, []byte("test")))
+
+	// wrong transcript
+	assert.Error(BatchVerifySinglePointHiding(digests, &proof, point, sha256.New(), srs.Vk))
+
+	// wrong claimed value
+	proof.ClaimedValues[3].Double(&proof.ClaimedValues[3])
+	assert.ErrorIs(BatchVerifySinglePointHiding(digests, &proof, point, sha256.New(), srs.Vk, []byte("test")), ErrVerifyOpeningProof)
+
+	// invalid numbers of blindings and digests
+	_, err = BatchOpenSinglePointHiding(polynomials, blindings[1:], digests, point, sha256.New(), srs.Pk)
+	assert.ErrorIs(err, ErrInvalidNbBlindings)
+	_, err = BatchOpenSinglePointHiding(polynomials, blindings, digests[1:], point, sha256.New(), srs.Pk)
+	assert.ErrorIs(err, ErrInvalidNbDigests)
+}
+
+func TestSerializationHiding(t *testing.T) {
+	assert := require.New(t)
+
+	srs, err := NewHidingSRS(testSrs, big.NewInt(11))
+	assert.NoError(err)
+	t.Run("proving key round-trip", testutils.SerializationRoundTrip(&srs.Pk))
+	t.Run("proving key raw round-trip", testutils.SerializationRoundTripRaw(&srs.Pk))
+	t.Run("verifying key round-trip", testutils.SerializationRoundTrip(&srs.Vk))
+	t.Run("verifying key raw round-trip", testutils.SerializationRoundTripRaw(&srs.Vk))
+	t.Run("whole SRS round-trip", testutils.SerializationRoundTrip(srs))
+	t.Run("whole SRS raw round-trip", testutils.SerializationRoundTripRaw(srs))
+
+	var proof HidingOpeningProof
+	proof.H = srs.Pk.H[3]
+	proof.ClaimedValue.MustSetRandom()
+	proof.BlindingValue.MustSetRandom()
+	t.Run("opening proof round-trip", testutils.SerializationRoundTrip(&proof))
+
+	var batchProof HidingBatchOpeningProof
+	batchProof.H = srs.Pk.H[4]
+	batchProof.ClaimedValues = randomPolynomial(7)
+	batchProof.BlindingValue.MustSetRandom()
+	t.Run("batch opening proof round-trip", testutils.SerializationRoundTrip(&batchProof))
+}
+
+func BenchmarkOpenHiding(b *testing.B) {
+	const size = 1 << 14
+	srs, err := NewSRS(size, big.NewInt(-1))
+	require.NoError(b, err)
+	hidingSrs, err := NewHidingSRS(srs, big.NewInt(3))
+	require.NoError(b, err)
+	f := randomPolynomial(size)
+	_, blinding, err := CommitHiding(f, hidingSrs.Pk, 1)
+	require.NoError(b, err)
+	var point fr.Element
+	point.MustSetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = OpenHiding(f, blinding, point, hidingSrs.Pk)
+	}
+}
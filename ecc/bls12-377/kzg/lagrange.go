@@ -0,0 +1,185 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377"
+	"github.com/consensys/gnark-crypto/ecc/bls12-377/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidLagrangeSRSSize = errors.New("lagrange SRS size must be a power of 2, larger than 1 and smaller than the SRS size")
+	ErrInvalidNbEvaluations   = errors.New("number of evaluations is not the same as the Lagrange SRS size")
+)
+
+// ProvingKeyLagrange used to create or open commitments of polynomials given by their
+// evaluations on the subgroup of roots of unity of order len(G1) (the fft.Domain of that cardinality).
+type ProvingKeyLagrange struct {
+	G1 []bls12377.G1Affine // [L₀(α)]G₁, [L₁(α)]G₁, ..., [Lₙ₋₁(α)]G₁
+}
+
+// SRSLagrange is a SRS with the ProvingKey in Lagrange basis.
+//
+// Commitments computed with CommitLagrange are equal to the commitments of the same
+// polynomials in canonical form computed with Commit, so the VerifyingKey is shared
+// between both forms and proofs are verified with Verify.
+//
+// implements io.ReaderFrom and io.WriterTo
+type SRSLagrange struct {
+	Pk ProvingKeyLagrange
+	Vk VerifyingKey
+}
+
+// NewSRSLagrange returns the SRS in Lagrange basis over the domain of cardinality size,
+// computed from srs with an FFT over G₁ (see ToLagrangeG1).
+//
+// size must be a power of 2 smaller than len(srs.Pk.G1).
+func NewSRSLagrange(srs *SRS, size uint64) (*SRSLagrange, error) {
+	if size < 2 || bits.OnesCount64(size) != 1 || size > uint64(len(srs.Pk.G1)) {
+		return nil, ErrInvalidLagrangeSRSSize
+	}
+	g1, err := ToLagrangeG1(srs.Pk.G1[:size])
+	if err != nil {
+		return nil, err
+	}
+	return &SRSLagrange{
+		Pk: ProvingKeyLagrange{G1: g1},
+		Vk: srs.Vk,
+	}, nil
+}
+
+// CommitLagrange commits to a polynomial given by its evaluations on the domain,
+// in natural order and in Montgomery form, using a multi exponentiation with the Lagrange SRS.
+//
+// The number of evaluations must be equal to len(pk.G1).
+func CommitLagrange(evaluations []fr.Element, pk ProvingKeyLagrange, nbTasks ...int) (Digest, error) {
+	if len(evaluations) == 0 || len(evaluations) != len(pk.G1) {
+		return Digest{}, ErrInvalidNbEvaluations
+	}
+
+	var res bls12377.G1Affine
+
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+	if _, err := res.MultiExp(pk.G1, evaluations, config); err != nil {
+		return Digest{}, err
+	}
+
+	return res, nil
+}
+
+// OpenLagrange computes an opening proof at point of the polynomial given by its evaluations
+// on the domain, in natural order. The proof is verified with Verify.
+//
+// The quotient (f-f(point))/(X-point) is computed in evaluation form, point can be in the domain.
+func OpenLagrange(evaluations []fr.Element, point fr.Element, pk ProvingKeyLagrange) (OpeningProof, error) {
+	n := len(evaluations)
+	if n == 0 || n != len(pk.G1) {
+		return OpeningProof{}, ErrInvalidNbEvaluations
+	}
+
+	domain, err := lagrangeDomain(n)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	// position of point in the domain, -1 if it is not in the domain
+	index := -1
+	for i := range domain {
+		if domain[i].Equal(&point) {
+			index = i
+			break
+		}
+	}
+
+	// 1/(ωⁱ - point), or 0 if ωⁱ = point
+	denominators := make([]fr.Element, n)
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			denominators[i].Sub(&domain[i], &point)
+		}
+	})
+	denominators = fr.BatchInvert(denominators)
+
+	var res OpeningProof
+	if index >= 0 {
+		res.ClaimedValue = evaluations[index]
+	} else {
+		res.ClaimedValue = evalLagrange(evaluations, point, domain, denominators)
+	}
+
+	// qᵢ = (fᵢ - f(point))/(ωⁱ - point)
+	quotient := make([]fr.Element, n)
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			quotient[i].Sub(&evaluations[i], &res.ClaimedValue).
+				Mul(&quotient[i], &denominators[i])
+		}
+	})
+
+	// point = ωᵐ is in the domain, q(ωᵐ) = f'(ωᵐ) = ∑_{i≠m}(fᵢ - f(ωᵐ))Lᵢ'(ωᵐ)
+	// where Lᵢ'(ωᵐ) = ωⁱ / (ωᵐ(ωᵐ - ωⁱ)) = -ω⁽ⁱ⁻ᵐ⁾ / (ωⁱ - ωᵐ)
+	if index >= 0 {
+		var qm, t fr.Element
+		for i := range evaluations {
+			if i == index {
+				continue
+			}
+			t.Mul(&quotient[i], &domain[(i-index+n)%n])
+			qm.Sub(&qm, &t)
+		}
+		quotient[index] = qm
+	}
+
+	res.H, err = CommitLagrange(quotient, pk)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	return res, nil
+}
+
+// evalLagrange returns f(point) = (pointⁿ-1)/n ∑ᵢfᵢωⁱ/(point-ωⁱ) where f is given
+// by its evaluations on the domain, point is not in the domain and denominators[i] = 1/(ωⁱ-point).
+func evalLagrange(evaluations []fr.Element, point fr.Element, domain, denominators []fr.Element) fr.Element {
+	n := len(evaluations)
+
+	var res, t fr.Element
+	for i := range evaluations {
+		t.Mul(&evaluations[i], &domain[i]).Mul(&t, &denominators[i])
+		res.Sub(&res, &t)
+	}
+
+	var zn, one, nInv fr.Element
+	one.SetOne()
+	zn.Exp(point, big.NewInt(int64(n))).Sub(&zn, &one)
+	nInv.SetUint64(uint64(n)).Inverse(&nInv)
+	res.Mul(&res, &zn).Mul(&res, &nInv)
+
+	return res
+}
+
+// lagrangeDomain returns [1, ω, ω², ..., ωⁿ⁻¹] where ω is the generator used by ToLagrangeG1
+func lagrangeDomain(n int) ([]fr.Element, error) {
+	generator, err := fr.Generator(uint64(n))
+	if err != nil {
+		return nil, err
+	}
+	domain := make([]fr.Element, n)
+	domain[0].SetOne()
+	for i := 1; i < n; i++ {
+		domain[i].Mul(&domain[i-1], &generator)
+	}
+	return domain, nil
+}
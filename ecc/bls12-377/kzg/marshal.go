@@ -278,3 +278,382 @@ func (proof *BatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
 
 	return dec.BytesRead(), nil
 }
+
+// WriteTo writes binary encoding of the ProvingKeyLagrange
+func (pk *ProvingKeyLagrange) WriteTo(w io.Writer) (int64, error) {
+	return pk.writeTo(w)
+}
+
+// WriteRawTo writes binary encoding of ProvingKeyLagrange to w without point compression
+func (pk *ProvingKeyLagrange) WriteRawTo(w io.Writer) (int64, error) {
+	return pk.writeTo(w, bls12377.RawEncoding())
+}
+
+func (pk *ProvingKeyLagrange) writeTo(w io.Writer, options ...func(*bls12377.Encoder)) (int64, error) {
+	// encode the ProvingKeyLagrange
+	enc := bls12377.NewEncoder(w, options...)
+	if err := enc.Encode(pk.G1); err != nil {
+		return enc.BytesWritten(), err
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes ProvingKeyLagrange data from reader.
+func (pk *ProvingKeyLagrange) ReadFrom(r io.Reader) (int64, error) {
+	// decode the ProvingKeyLagrange
+	dec := bls12377.NewDecoder(r)
+	if err := dec.Decode(&pk.G1); err != nil {
+		return dec.BytesRead(), err
+	}
+	return dec.BytesRead(), nil
+}
+
+// UnsafeReadFrom decodes ProvingKeyLagrange data from reader without checking
+// that point are in the correct subgroup.
+func (pk *ProvingKeyLagrange) UnsafeReadFrom(r io.Reader) (int64, error) {
+	// decode the ProvingKeyLagrange
+	dec := bls12377.NewDecoder(r, bls12377.NoSubgroupChecks())
+	if err := dec.Decode(&pk.G1); err != nil {
+		return dec.BytesRead(), err
+	}
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of the entire SRSLagrange
+func (srs *SRSLagrange) WriteTo(w io.Writer) (int64, error) {
+	// encode the SRSLagrange
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.WriteTo(w); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.WriteTo(w)
+	return pn + vn, err
+}
+
+// WriteRawTo writes binary encoding of the entire SRSLagrange without point compression
+func (srs *SRSLagrange) WriteRawTo(w io.Writer) (int64, error) {
+	// encode the SRSLagrange
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.WriteRawTo(w); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.WriteRawTo(w)
+	return pn + vn, err
+}
+
+// ReadFrom decodes SRSLagrange data from reader.
+func (srs *SRSLagrange) ReadFrom(r io.Reader) (int64, error) {
+	// decode the SRSLagrange
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.ReadFrom(r); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.ReadFrom(r)
+	return pn + vn, err
+}
+
+// UnsafeReadFrom decodes SRSLagrange data from reader without sub group checks
+func (srs *SRSLagrange) UnsafeReadFrom(r io.Reader) (int64, error) {
+	// decode the SRSLagrange
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.UnsafeReadFrom(r); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.ReadFrom(r)
+	return pn + vn, err
+}
+
+// WriteDump writes the binary encoding of the entire SRSLagrange memory representation
+// It is meant to be use to achieve fast serialization/deserialization and
+// is not compatible with WriteTo / ReadFrom. It does not do any validation
+// and doesn't encode points in a canonical form.
+// @unsafe: this is platform dependent and may not be compatible with other platforms
+// @unstable: the format may change in the future
+//
+// maxPkPoints is accepted for compatibility with SRS.WriteDump, but unlike the canonical
+// basis the Lagrange basis can't be truncated; a value smaller than len(srs.Pk.G1) returns an error.
+func (srs *SRSLagrange) WriteDump(w io.Writer, maxPkPoints ...int) error {
+	if len(maxPkPoints) > 0 && maxPkPoints[0] < len(srs.Pk.G1) && maxPkPoints[0] > 0 {
+		return ErrInvalidLagrangeSRSSize
+	}
+	// first we write the VerifyingKey; it is small so we re-use WriteTo
+	if _, err := srs.Vk.writeTo(w, bls12377.RawEncoding()); err != nil {
+		return err
+	}
+
+	// write the marker
+	if err := unsafe.WriteMarker(w); err != nil {
+		return err
+	}
+
+	// write the slice
+	return unsafe.WriteSlice(w, srs.Pk.G1)
+}
+
+// ReadDump deserializes the SRSLagrange from a reader, as written by WriteDump
+//
+// maxPkPoints is accepted for compatibility with SRS.ReadDump, a value smaller
+// than the number of points in the dump returns an error.
+func (srs *SRSLagrange) ReadDump(r io.Reader, maxPkPoints ...int) error {
+	// first we read the VerifyingKey; it is small so we re-use ReadFrom
+	_, err := srs.Vk.ReadFrom(r)
+	if err != nil {
+		return err
+	}
+
+	// read the marker
+	if err := unsafe.ReadMarker(r); err != nil {
+		return err
+	}
+
+	// read the slice
+	srs.Pk.G1, _, err = unsafe.ReadSlice[[]bls12377.G1Affine](r)
+	if err != nil {
+		return err
+	}
+	if len(maxPkPoints) > 0 && maxPkPoints[0] < len(srs.Pk.G1) && maxPkPoints[0] > 0 {
+		return ErrInvalidLagrangeSRSSize
+	}
+	return nil
+}
+
+// WriteTo writes binary encoding of a CellOpeningProof
+func (proof *CellOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		&proof.H,
+		proof.ClaimedValues,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes CellOpeningProof data from reader.
+func (proof *CellOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls12377.NewDecoder(r)
+	toDecode := []interface{}{
+		&proof.H,
+		&proof.ClaimedValues,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of the HidingProvingKey
+func (pk *HidingProvingKey) WriteTo(w io.Writer) (int64, error) {
+	return pk.writeTo(w)
+}
+
+// WriteRawTo writes binary encoding of HidingProvingKey to w without point compression
+func (pk *HidingProvingKey) WriteRawTo(w io.Writer) (int64, error) {
+	return pk.writeTo(w, bls12377.RawEncoding())
+}
+
+func (pk *HidingProvingKey) writeTo(w io.Writer, options ...func(*bls12377.Encoder)) (int64, error) {
+	// encode the HidingProvingKey
+	enc := bls12377.NewEncoder(w, options...)
+	if err := enc.Encode(pk.G1); err != nil {
+		return enc.BytesWritten(), err
+	}
+	if err := enc.Encode(pk.H); err != nil {
+		return enc.BytesWritten(), err
+	}
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes HidingProvingKey data from reader.
+func (pk *HidingProvingKey) ReadFrom(r io.Reader) (int64, error) {
+	return pk.readFrom(r)
+}
+
+// UnsafeReadFrom decodes HidingProvingKey data from reader without checking
+// that point are in the correct subgroup.
+func (pk *HidingProvingKey) UnsafeReadFrom(r io.Reader) (int64, error) {
+	return pk.readFrom(r, bls12377.NoSubgroupChecks())
+}
+
+func (pk *HidingProvingKey) readFrom(r io.Reader, options ...func(*bls12377.Decoder)) (int64, error) {
+	// decode the HidingProvingKey
+	dec := bls12377.NewDecoder(r, options...)
+	if err := dec.Decode(&pk.G1); err != nil {
+		return dec.BytesRead(), err
+	}
+	if err := dec.Decode(&pk.H); err != nil {
+		return dec.BytesRead(), err
+	}
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of the HidingVerifyingKey
+func (vk *HidingVerifyingKey) WriteTo(w io.Writer) (int64, error) {
+	return vk.writeTo(w)
+}
+
+// WriteRawTo writes binary encoding of HidingVerifyingKey to w without point compression
+func (vk *HidingVerifyingKey) WriteRawTo(w io.Writer) (int64, error) {
+	return vk.writeTo(w, bls12377.RawEncoding())
+}
+
+func (vk *HidingVerifyingKey) writeTo(w io.Writer, options ...func(*bls12377.Encoder)) (int64, error) {
+	// encode the VerifyingKey, then H
+	n, err := vk.VerifyingKey.writeTo(w, options...)
+	if err != nil {
+		return n, err
+	}
+	enc := bls12377.NewEncoder(w, options...)
+	err = enc.Encode(&vk.H)
+	return n + enc.BytesWritten(), err
+}
+
+// ReadFrom decodes HidingVerifyingKey data from reader.
+func (vk *HidingVerifyingKey) ReadFrom(r io.Reader) (int64, error) {
+	// decode the VerifyingKey, then H
+	n, err := vk.VerifyingKey.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+	dec := bls12377.NewDecoder(r)
+	err = dec.Decode(&vk.H)
+	return n + dec.BytesRead(), err
+}
+
+// WriteTo writes binary encoding of the entire HidingSRS
+func (srs *HidingSRS) WriteTo(w io.Writer) (int64, error) {
+	// encode the HidingSRS
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.WriteTo(w); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.WriteTo(w)
+	return pn + vn, err
+}
+
+// WriteRawTo writes binary encoding of the entire HidingSRS without point compression
+func (srs *HidingSRS) WriteRawTo(w io.Writer) (int64, error) {
+	// encode the HidingSRS
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.WriteRawTo(w); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.WriteRawTo(w)
+	return pn + vn, err
+}
+
+// ReadFrom decodes HidingSRS data from reader.
+func (srs *HidingSRS) ReadFrom(r io.Reader) (int64, error) {
+	// decode the HidingSRS
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.ReadFrom(r); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.ReadFrom(r)
+	return pn + vn, err
+}
+
+// UnsafeReadFrom decodes HidingSRS data from reader without sub group checks
+func (srs *HidingSRS) UnsafeReadFrom(r io.Reader) (int64, error) {
+	// decode the HidingSRS
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.UnsafeReadFrom(r); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.ReadFrom(r)
+	return pn + vn, err
+}
+
+// WriteTo writes binary encoding of a HidingOpeningProof
+func (proof *HidingOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		&proof.H,
+		&proof.ClaimedValue,
+		&proof.BlindingValue,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes HidingOpeningProof data from reader.
+func (proof *HidingOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls12377.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&proof.H,
+		&proof.ClaimedValue,
+		&proof.BlindingValue,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of a HidingBatchOpeningProof
+func (proof *HidingBatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bls12377.NewEncoder(w)
+
+	toEncode := []interface{}{
+		&proof.H,
+		proof.ClaimedValues,
+		&proof.BlindingValue,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes HidingBatchOpeningProof data from reader.
+func (proof *HidingBatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls12377.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&proof.H,
+		&proof.ClaimedValues,
+		&proof.BlindingValue,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
@@ -40,7 +40,8 @@ type PublicKey struct {
 // PrivateKey represents an ECDSA private key
 type PrivateKey struct {
 	PublicKey PublicKey
-	scalar    [sizeFr]byte // secret scalar, in big Endian
+	scalar    [sizeFr]byte     // secret scalar, in big Endian
+	nonceHash func() hash.Hash // if not nil, the nonces are deterministic (RFC 6979)
 }
 
 // Signature represents an ECDSA signature
@@ -154,6 +155,38 @@ func nonce(privateKey *PrivateKey, hash []byte) (csprng *cipher.StreamReader, er
 	return csprng, err
 }
 
+// nonces returns the generator of the nonces k for the signature of hash. The nonces are
+// derived with the HMAC-DRBG of RFC 6979 if it was selected with SetDeterministicNonces, and
+// are random otherwise.
+func (privKey *PrivateKey) nonces(hash []byte) func() (*big.Int, error) {
+	if privKey.nonceHash != nil {
+		x := new(big.Int).SetBytes(privKey.scalar[:sizeFr])
+		drbg := newHMACDRBG(privKey.nonceHash, order, x, hash)
+		return func() (*big.Int, error) {
+			return drbg.next(), nil
+		}
+	}
+	return func() (*big.Int, error) {
+		csprng, err := nonce(privKey, hash)
+		if err != nil {
+			return nil, err
+		}
+		return randFieldElement(csprng)
+	}
+}
+
+// hashMessage returns the hash of the message with hFunc, or the message if hFunc is nil
+func hashMessage(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc == nil {
+		return message, nil
+	}
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, err
+	}
+	return hFunc.Sum(nil), nil
+}
+
 // Equal compares 2 public keys
 func (pub *PublicKey) Equal(x signature.PublicKey) bool {
 	xx, ok := x.(*PublicKey)
@@ -174,7 +207,7 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 
 // Sign performs the ECDSA signature
 //
-// k ← 𝔽r (random)
+// k ← 𝔽r (random, or deterministic with SetDeterministicNonces)
 // P = k ⋅ g1Gen
 // r = x_P (mod order)
 // s = k⁻¹ . (m + sk ⋅ r)
@@ -182,15 +215,19 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 //
 // SEC 1, Version 2.0, Section 4.1.3
 func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	// compute the hash of the message as an integer
+	hramBin, err := hashMessage(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	m := HashToInt(hramBin)
+	nextNonce := privKey.nonces(hramBin)
+
 	scalar, r, s, kInv := new(big.Int), new(big.Int), new(big.Int), new(big.Int)
 	scalar.SetBytes(privKey.scalar[:sizeFr])
 	for {
 		for {
-			csprng, err := nonce(privKey, message)
-			if err != nil {
-				return nil, err
-			}
-			k, err := randFieldElement(csprng)
+			k, err := nextNonce()
 			if err != nil {
 				return nil, err
 			}
@@ -208,22 +245,6 @@ func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error)
 		}
 		s.Mul(r, scalar)
 
-		var m *big.Int
-		if hFunc != nil {
-			// compute the hash of the message as an integer
-			dataToHash := make([]byte, len(message))
-			copy(dataToHash[:], message[:])
-			hFunc.Reset()
-			_, err := hFunc.Write(dataToHash[:])
-			if err != nil {
-				return nil, err
-			}
-			hramBin := hFunc.Sum(nil)
-			m = HashToInt(hramBin)
-		} else {
-			m = HashToInt(message)
-		}
-
 		s.Add(m, s).
 			Mul(kInv, s).
 			Mod(s, order) // order != 0
@@ -0,0 +1,17 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package eip4844 implements the KZG polynomial commitment functions of the
+// Ethereum consensus specification ([polynomial-commitments.md], Deneb) used
+// by EIP-4844 blob transactions.
+//
+// Blobs are vectors of FieldElementsPerBlob scalars of BLS12-381, encoded big-endian
+// on 32 bytes each, and interpreted as the evaluations of a polynomial on the
+// subgroup of roots of unity of order FieldElementsPerBlob, in bit-reversed order.
+// Commitments and proofs are compressed G1 points.
+//
+// A Context must be built from the Ethereum KZG ceremony output (trusted_setup.txt),
+// see [TrustedSetup].
+//
+// [polynomial-commitments.md]: https://github.com/ethereum/consensus-specs/blob/dev/specs/deneb/polynomial-commitments.md
+package eip4844
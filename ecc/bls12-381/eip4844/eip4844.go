@@ -0,0 +1,511 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package eip4844
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+const (
+	// BytesPerFieldElement size of a serialized scalar
+	BytesPerFieldElement = fr.Bytes
+	// FieldElementsPerBlob number of scalars in a blob
+	FieldElementsPerBlob = 4096
+	// BytesPerBlob size of a serialized blob
+	BytesPerBlob = BytesPerFieldElement * FieldElementsPerBlob
+	// BytesPerCommitment size of a serialized commitment
+	BytesPerCommitment = bls12381.SizeOfG1AffineCompressed
+	// BytesPerProof size of a serialized proof
+	BytesPerProof = bls12381.SizeOfG1AffineCompressed
+
+	// fiatShamirProtocolDomain domain separator of the evaluation challenge
+	fiatShamirProtocolDomain = "FSBLOBVERIFY_V1_"
+	// randomChallengeKZGBatchDomain domain separator of the batch verification challenge
+	randomChallengeKZGBatchDomain = "RCKZGBATCH___V1_"
+	// primitiveRootOfUnity generator of 𝔽ᵣˣ used to derive the roots of unity
+	primitiveRootOfUnity = 7
+)
+
+var (
+	ErrInvalidBlob          = errors.New("invalid blob: scalar is not canonical")
+	ErrInvalidFieldElement  = errors.New("invalid field element: not canonical")
+	ErrInvalidCommitment    = errors.New("invalid commitment")
+	ErrInvalidProof         = errors.New("invalid proof")
+	ErrInvalidNbInputs      = errors.New("blobs, commitments and proofs must have the same length")
+	ErrVerifyOpeningProof   = kzg.ErrVerifyOpeningProof
+	ErrInvalidSetupNbPoints = errors.New("trusted setup must contain FieldElementsPerBlob G1 points and at least 2 G2 points")
+)
+
+// Blob is a serialized vector of FieldElementsPerBlob scalars in evaluation form,
+// in bit-reversed order.
+type Blob [BytesPerBlob]byte
+
+// Bytes32 is a serialized scalar (big-endian).
+type Bytes32 [BytesPerFieldElement]byte
+
+// KZGCommitment is a compressed G1 point.
+type KZGCommitment [BytesPerCommitment]byte
+
+// KZGProof is a compressed G1 point.
+type KZGProof [BytesPerProof]byte
+
+// Context holds the precomputed data derived from the trusted setup.
+//
+// It is safe for concurrent use.
+type Context struct {
+	// lagrangeG1 [Lᵢ(τ)]G₁ in bit-reversed order
+	lagrangeG1 []bls12381.G1Affine
+
+	// roots of unity of order FieldElementsPerBlob in bit-reversed order
+	roots []fr.Element
+
+	// rootsIndex maps a root of unity to its position in roots
+	rootsIndex map[fr.Element]int
+
+	// cardinalityInv 1/FieldElementsPerBlob
+	cardinalityInv fr.Element
+
+	// vk contains G₁, G₂, [τ]G₂ and the precomputed pairing lines
+	vk kzg.VerifyingKey
+}
+
+// NewContext returns a new Context from the given trusted setup.
+func NewContext(ts *TrustedSetup) (*Context, error) {
+	if len(ts.G1Lagrange) != FieldElementsPerBlob || len(ts.G2Monomial) < 2 {
+		return nil, ErrInvalidSetupNbPoints
+	}
+
+	ctx := &Context{
+		lagrangeG1: make([]bls12381.G1Affine, FieldElementsPerBlob),
+		roots:      make([]fr.Element, FieldElementsPerBlob),
+		rootsIndex: make(map[fr.Element]int, FieldElementsPerBlob),
+	}
+
+	// ω = 7^((r-1)/n)
+	var omega fr.Element
+	var exp big.Int
+	exp.Sub(fr.Modulus(), big.NewInt(1))
+	exp.Div(&exp, big.NewInt(FieldElementsPerBlob))
+	omega.SetUint64(primitiveRootOfUnity)
+	omega.Exp(omega, &exp)
+
+	ctx.roots[0].SetOne()
+	for i := 1; i < FieldElementsPerBlob; i++ {
+		ctx.roots[i].Mul(&ctx.roots[i-1], &omega)
+	}
+	fft.BitReverse(ctx.roots)
+	for i := range ctx.roots {
+		ctx.rootsIndex[ctx.roots[i]] = i
+	}
+
+	copy(ctx.lagrangeG1, ts.G1Lagrange)
+	bitReverseG1(ctx.lagrangeG1)
+
+	ctx.cardinalityInv.SetUint64(FieldElementsPerBlob)
+	ctx.cardinalityInv.Inverse(&ctx.cardinalityInv)
+
+	_, _, g1, g2 := bls12381.Generators()
+	ctx.vk.G1 = g1
+	ctx.vk.G2[0] = g2
+	ctx.vk.G2[1] = ts.G2Monomial[1]
+	ctx.vk.Lines[0] = bls12381.PrecomputeLines(ctx.vk.G2[0])
+	ctx.vk.Lines[1] = bls12381.PrecomputeLines(ctx.vk.G2[1])
+
+	return ctx, nil
+}
+
+// BlobToKZGCommitment returns the commitment to the polynomial whose
+// evaluations are stored in blob (blob_to_kzg_commitment).
+func (ctx *Context) BlobToKZGCommitment(blob *Blob) (KZGCommitment, error) {
+	polynomial, err := blobToPolynomial(blob)
+	if err != nil {
+		return KZGCommitment{}, err
+	}
+	commitment, err := ctx.commit(polynomial)
+	if err != nil {
+		return KZGCommitment{}, err
+	}
+	return KZGCommitment(commitment.Bytes()), nil
+}
+
+// ComputeKZGProof computes the opening proof of the polynomial in blob at z,
+// and returns the proof together with the claimed evaluation y (compute_kzg_proof).
+func (ctx *Context) ComputeKZGProof(blob *Blob, z Bytes32) (KZGProof, Bytes32, error) {
+	polynomial, err := blobToPolynomial(blob)
+	if err != nil {
+		return KZGProof{}, Bytes32{}, err
+	}
+	var zElement fr.Element
+	if err := zElement.SetBytesCanonical(z[:]); err != nil {
+		return KZGProof{}, Bytes32{}, ErrInvalidFieldElement
+	}
+	proof, y, err := ctx.computeKZGProof(polynomial, zElement)
+	if err != nil {
+		return KZGProof{}, Bytes32{}, err
+	}
+	return KZGProof(proof.Bytes()), Bytes32(y.Bytes()), nil
+}
+
+// ComputeBlobKZGProof computes the opening proof of the polynomial in blob at the
+// Fiat-Shamir challenge derived from blob and commitment (compute_blob_kzg_proof).
+//
+// The commitment is not checked against the blob.
+func (ctx *Context) ComputeBlobKZGProof(blob *Blob, commitment KZGCommitment) (KZGProof, error) {
+	if _, err := bytesToG1(commitment[:]); err != nil {
+		return KZGProof{}, ErrInvalidCommitment
+	}
+	polynomial, err := blobToPolynomial(blob)
+	if err != nil {
+		return KZGProof{}, err
+	}
+	z := computeChallenge(blob, &commitment)
+	proof, _, err := ctx.computeKZGProof(polynomial, z)
+	if err != nil {
+		return KZGProof{}, err
+	}
+	return KZGProof(proof.Bytes()), nil
+}
+
+// VerifyKZGProof verifies that proof attests that the polynomial committed to
+// in commitment evaluates to y at z (verify_kzg_proof).
+//
+// It returns nil if and only if the proof is valid.
+func (ctx *Context) VerifyKZGProof(commitment KZGCommitment, z, y Bytes32, proof KZGProof) error {
+	c, err := bytesToG1(commitment[:])
+	if err != nil {
+		return ErrInvalidCommitment
+	}
+	var zElement, yElement fr.Element
+	if err := zElement.SetBytesCanonical(z[:]); err != nil {
+		return ErrInvalidFieldElement
+	}
+	if err := yElement.SetBytesCanonical(y[:]); err != nil {
+		return ErrInvalidFieldElement
+	}
+	p, err := bytesToG1(proof[:])
+	if err != nil {
+		return ErrInvalidProof
+	}
+	return kzg.Verify(&c, &kzg.OpeningProof{H: p, ClaimedValue: yElement}, zElement, ctx.vk)
+}
+
+// VerifyBlobKZGProof verifies the proof computed by ComputeBlobKZGProof (verify_blob_kzg_proof).
+//
+// It returns nil if and only if the proof is valid.
+func (ctx *Context) VerifyBlobKZGProof(blob *Blob, commitment KZGCommitment, proof KZGProof) error {
+	c, err := bytesToG1(commitment[:])
+	if err != nil {
+		return ErrInvalidCommitment
+	}
+	polynomial, err := blobToPolynomial(blob)
+	if err != nil {
+		return err
+	}
+	z := computeChallenge(blob, &commitment)
+	y := ctx.evaluate(polynomial, z)
+	p, err := bytesToG1(proof[:])
+	if err != nil {
+		return ErrInvalidProof
+	}
+	return kzg.Verify(&c, &kzg.OpeningProof{H: p, ClaimedValue: y}, z, ctx.vk)
+}
+
+// VerifyBlobKZGProofBatch verifies a list of proofs computed by ComputeBlobKZGProof
+// with a single pairing check (verify_blob_kzg_proof_batch).
+//
+// It returns nil if and only if all the proofs are valid; an empty batch is valid.
+func (ctx *Context) VerifyBlobKZGProofBatch(blobs []Blob, commitments []KZGCommitment, proofs []KZGProof) error {
+	n := len(blobs)
+	if len(commitments) != n || len(proofs) != n {
+		return ErrInvalidNbInputs
+	}
+
+	cs := make([]bls12381.G1Affine, n)
+	ps := make([]bls12381.G1Affine, n)
+	zs := make([]fr.Element, n)
+	ys := make([]fr.Element, n)
+
+	var firstErr error
+	var lock sync.Mutex
+	setErr := func(err error) {
+		lock.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		lock.Unlock()
+	}
+
+	parallel.Execute(n, func(start, end int) {
+		for i := start; i < end; i++ {
+			var err error
+			if cs[i], err = bytesToG1(commitments[i][:]); err != nil {
+				setErr(ErrInvalidCommitment)
+				return
+			}
+			polynomial, err := blobToPolynomial(&blobs[i])
+			if err != nil {
+				setErr(err)
+				return
+			}
+			zs[i] = computeChallenge(&blobs[i], &commitments[i])
+			ys[i] = ctx.evaluate(polynomial, zs[i])
+			if ps[i], err = bytesToG1(proofs[i][:]); err != nil {
+				setErr(ErrInvalidProof)
+				return
+			}
+		}
+	})
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.verifyKZGProofBatch(cs, zs, ys, ps)
+}
+
+// verifyKZGProofBatch checks e(∑ᵢrⁱ(Cᵢ - [yᵢ]G₁ + [zᵢ]πᵢ), G₂)⋅e(-∑ᵢrⁱπᵢ, [τ]G₂) == 1
+// where r is derived from the inputs as in verify_kzg_proof_batch.
+func (ctx *Context) verifyKZGProofBatch(commitments []bls12381.G1Affine, zs, ys []fr.Element, proofs []bls12381.G1Affine) error {
+	n := len(commitments)
+	if n == 0 {
+		return nil
+	}
+
+	// derive r
+	h := sha256.New()
+	h.Write([]byte(randomChallengeKZGBatchDomain))
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], FieldElementsPerBlob)
+	h.Write(buf[:])
+	binary.BigEndian.PutUint64(buf[:], uint64(n))
+	h.Write(buf[:])
+	for i := 0; i < n; i++ {
+		c := commitments[i].Bytes()
+		z := zs[i].Bytes()
+		y := ys[i].Bytes()
+		p := proofs[i].Bytes()
+		h.Write(c[:])
+		h.Write(z[:])
+		h.Write(y[:])
+		h.Write(p[:])
+	}
+	var r fr.Element
+	r.SetBytes(h.Sum(nil))
+
+	// rⁱ and rⁱzᵢ
+	rPowers := make([]fr.Element, n)
+	rPowersZ := make([]fr.Element, n)
+	rPowers[0].SetOne()
+	for i := 1; i < n; i++ {
+		rPowers[i].Mul(&rPowers[i-1], &r)
+	}
+	var foldedYs, tmp fr.Element
+	for i := 0; i < n; i++ {
+		rPowersZ[i].Mul(&rPowers[i], &zs[i])
+		tmp.Mul(&rPowers[i], &ys[i])
+		foldedYs.Add(&foldedYs, &tmp)
+	}
+
+	config := ecc.MultiExpConfig{}
+
+	// ∑ᵢrⁱπᵢ
+	var foldedProofs bls12381.G1Affine
+	if _, err := foldedProofs.MultiExp(proofs, rPowers, config); err != nil {
+		return err
+	}
+
+	// ∑ᵢrⁱzᵢπᵢ
+	var foldedProofsZ bls12381.G1Jac
+	if _, err := foldedProofsZ.MultiExp(proofs, rPowersZ, config); err != nil {
+		return err
+	}
+
+	// ∑ᵢrⁱCᵢ
+	var foldedCommitments bls12381.G1Jac
+	if _, err := foldedCommitments.MultiExp(commitments, rPowers, config); err != nil {
+		return err
+	}
+
+	// [∑ᵢrⁱyᵢ]G₁
+	var foldedYsG1 bls12381.G1Jac
+	var foldedYsBigInt big.Int
+	foldedYs.BigInt(&foldedYsBigInt)
+	foldedYsG1.ScalarMultiplicationBase(&foldedYsBigInt)
+
+	// ∑ᵢrⁱ(Cᵢ - [yᵢ]G₁ + [zᵢ]πᵢ)
+	foldedCommitments.SubAssign(&foldedYsG1)
+	foldedCommitments.AddAssign(&foldedProofsZ)
+
+	var lhs bls12381.G1Affine
+	lhs.FromJacobian(&foldedCommitments)
+	foldedProofs.Neg(&foldedProofs)
+
+	// the Miller loop overwrites the lines, we work on a copy
+	lines := ctx.vk.Lines
+	check, err := bls12381.PairingCheckFixedQ(
+		[]bls12381.G1Affine{lhs, foldedProofs},
+		lines[:],
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// commit returns ∑ᵢpᵢ[Lᵢ(τ)]G₁
+func (ctx *Context) commit(polynomial []fr.Element) (bls12381.G1Affine, error) {
+	var res bls12381.G1Affine
+	if _, err := res.MultiExp(ctx.lagrangeG1, polynomial, ecc.MultiExpConfig{}); err != nil {
+		return bls12381.G1Affine{}, err
+	}
+	return res, nil
+}
+
+// computeKZGProof returns the commitment to (p(X) - y) / (X - z) and y = p(z)
+// (compute_kzg_proof_impl).
+func (ctx *Context) computeKZGProof(polynomial []fr.Element, z fr.Element) (bls12381.G1Affine, fr.Element, error) {
+	y := ctx.evaluate(polynomial, z)
+
+	// qᵢ = (pᵢ - y) / (ωᵢ - z)
+	quotient := make([]fr.Element, FieldElementsPerBlob)
+	denominators := make([]fr.Element, FieldElementsPerBlob)
+	for i := range denominators {
+		denominators[i].Sub(&ctx.roots[i], &z)
+	}
+	denominators = fr.BatchInvert(denominators)
+	for i := range quotient {
+		quotient[i].Sub(&polynomial[i], &y).Mul(&quotient[i], &denominators[i])
+	}
+
+	// if z is in the domain, the quotient at z is computed separately
+	if i, ok := ctx.rootsIndex[z]; ok {
+		quotient[i] = ctx.quotientEvalWithinDomain(polynomial, z, y)
+	}
+
+	proof, err := ctx.commit(quotient)
+	if err != nil {
+		return bls12381.G1Affine{}, fr.Element{}, err
+	}
+	return proof, y, nil
+}
+
+// quotientEvalWithinDomain returns q(z) = ∑_{ωᵢ≠z} (pᵢ - y)ωᵢ / (z(z - ωᵢ)) when z is
+// a root of unity (compute_quotient_eval_within_domain).
+func (ctx *Context) quotientEvalWithinDomain(polynomial []fr.Element, z, y fr.Element) fr.Element {
+	denominators := make([]fr.Element, FieldElementsPerBlob)
+	for i := range denominators {
+		// z - ωᵢ is zero when ωᵢ == z, and BatchInvert leaves it at zero
+		denominators[i].Sub(&z, &ctx.roots[i]).Mul(&denominators[i], &z)
+	}
+	denominators = fr.BatchInvert(denominators)
+
+	var res, tmp fr.Element
+	for i := range polynomial {
+		tmp.Sub(&polynomial[i], &y).
+			Mul(&tmp, &ctx.roots[i]).
+			Mul(&tmp, &denominators[i])
+		res.Add(&res, &tmp)
+	}
+	return res
+}
+
+// evaluate returns p(z) where p is given by its evaluations on the bit-reversed
+// roots of unity (evaluate_polynomial_in_evaluation_form), using the barycentric formula
+//
+// p(z) = (zⁿ - 1)/n ∑ᵢ pᵢωᵢ/(z - ωᵢ)
+func (ctx *Context) evaluate(polynomial []fr.Element, z fr.Element) fr.Element {
+	if i, ok := ctx.rootsIndex[z]; ok {
+		return polynomial[i]
+	}
+
+	denominators := make([]fr.Element, FieldElementsPerBlob)
+	for i := range denominators {
+		denominators[i].Sub(&z, &ctx.roots[i])
+	}
+	denominators = fr.BatchInvert(denominators)
+
+	var res, tmp fr.Element
+	for i := range polynomial {
+		tmp.Mul(&polynomial[i], &ctx.roots[i]).Mul(&tmp, &denominators[i])
+		res.Add(&res, &tmp)
+	}
+
+	// (zⁿ - 1)/n
+	var zn fr.Element
+	zn.Set(&z)
+	for i := 0; i < bits.TrailingZeros(FieldElementsPerBlob); i++ {
+		zn.Square(&zn)
+	}
+	var one fr.Element
+	one.SetOne()
+	zn.Sub(&zn, &one)
+	res.Mul(&res, &zn).Mul(&res, &ctx.cardinalityInv)
+
+	return res
+}
+
+// computeChallenge returns the Fiat-Shamir evaluation challenge (compute_challenge)
+//
+// z = sha256(FSBLOBVERIFY_V1_ ‖ u128(n) ‖ blob ‖ commitment) mod r
+func computeChallenge(blob *Blob, commitment *KZGCommitment) fr.Element {
+	h := sha256.New()
+	h.Write([]byte(fiatShamirProtocolDomain))
+	var degree [16]byte
+	binary.BigEndian.PutUint64(degree[8:], FieldElementsPerBlob)
+	h.Write(degree[:])
+	h.Write(blob[:])
+	h.Write(commitment[:])
+
+	var z fr.Element
+	z.SetBytes(h.Sum(nil))
+	return z
+}
+
+// blobToPolynomial deserializes the scalars of a blob, which must be canonical
+func blobToPolynomial(blob *Blob) ([]fr.Element, error) {
+	polynomial := make([]fr.Element, FieldElementsPerBlob)
+	for i := range polynomial {
+		if err := polynomial[i].SetBytesCanonical(blob[i*BytesPerFieldElement : (i+1)*BytesPerFieldElement]); err != nil {
+			return nil, ErrInvalidBlob
+		}
+	}
+	return polynomial, nil
+}
+
+// bytesToG1 deserializes a compressed G1 point, checking it is in the prime order
+// subgroup (validate_kzg_g1). The point at infinity is accepted.
+func bytesToG1(b []byte) (bls12381.G1Affine, error) {
+	var p bls12381.G1Affine
+	if _, err := p.SetBytes(b); err != nil {
+		return bls12381.G1Affine{}, err
+	}
+	return p, nil
+}
+
+// bitReverseG1 applies the bit-reversal permutation to a, whose length is a power of 2
+func bitReverseG1(a []bls12381.G1Affine) {
+	n := uint64(len(a))
+	nn := uint64(64 - bits.TrailingZeros64(n))
+
+	for i := uint64(0); i < n; i++ {
+		iRev := bits.Reverse64(i) >> nn
+		if iRev > i {
+			a[i], a[iRev] = a[iRev], a[i]
+		}
+	}
+}
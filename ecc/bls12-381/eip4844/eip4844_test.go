@@ -0,0 +1,503 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package eip4844
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	testContext     *Context
+	testSetup       *TrustedSetup
+	testTau         fr.Element
+	testContextOnce sync.Once
+)
+
+// newInsecureTrustedSetup computes a trusted setup from a known τ
+func newInsecureTrustedSetup(tau fr.Element) *TrustedSetup {
+	const nbG2 = 65
+	n := FieldElementsPerBlob
+
+	// roots of unity in natural order
+	var omega fr.Element
+	var exp big.Int
+	exp.Sub(fr.Modulus(), big.NewInt(1))
+	exp.Div(&exp, big.NewInt(int64(n)))
+	omega.SetUint64(primitiveRootOfUnity)
+	omega.Exp(omega, &exp)
+
+	// Lᵢ(τ) = ωⁱ(τⁿ - 1) / (n(τ - ωⁱ))
+	var tauN, nInv, one fr.Element
+	one.SetOne()
+	tauN.Exp(tau, big.NewInt(int64(n))).Sub(&tauN, &one)
+	nInv.SetUint64(uint64(n)).Inverse(&nInv)
+	tauN.Mul(&tauN, &nInv)
+
+	roots := make([]fr.Element, n)
+	denominators := make([]fr.Element, n)
+	roots[0].SetOne()
+	for i := 1; i < n; i++ {
+		roots[i].Mul(&roots[i-1], &omega)
+	}
+	for i := 0; i < n; i++ {
+		denominators[i].Sub(&tau, &roots[i])
+	}
+	denominators = fr.BatchInvert(denominators)
+	lagrange := make([]fr.Element, n)
+	for i := 0; i < n; i++ {
+		lagrange[i].Mul(&roots[i], &denominators[i]).Mul(&lagrange[i], &tauN)
+	}
+
+	monomial := make([]fr.Element, n)
+	monomial[0].SetOne()
+	for i := 1; i < n; i++ {
+		monomial[i].Mul(&monomial[i-1], &tau)
+	}
+
+	_, _, g1, g2 := bls12381.Generators()
+	ts := &TrustedSetup{
+		G1Lagrange: bls12381.BatchScalarMultiplicationG1(&g1, lagrange),
+		G1Monomial: bls12381.BatchScalarMultiplicationG1(&g1, monomial),
+		G2Monomial: bls12381.BatchScalarMultiplicationG2(&g2, monomial[:nbG2]),
+	}
+	return ts
+}
+
+func getTestContext() *Context {
+	testContextOnce.Do(func() {
+		testTau.SetUint64(42)
+		testSetup = newInsecureTrustedSetup(testTau)
+		var err error
+		testContext, err = NewContext(testSetup)
+		if err != nil {
+			panic(err)
+		}
+	})
+	return testContext
+}
+
+func randomBlob(t *testing.T) *Blob {
+	var blob Blob
+	var e fr.Element
+	for i := 0; i < FieldElementsPerBlob; i++ {
+		_, err := e.SetRandom()
+		require.NoError(t, err)
+		b := e.Bytes()
+		copy(blob[i*BytesPerFieldElement:], b[:])
+	}
+	return &blob
+}
+
+func TestTrustedSetupSerialization(t *testing.T) {
+	assert := require.New(t)
+	getTestContext()
+
+	var buf bytes.Buffer
+	written, err := testSetup.WriteTo(&buf)
+	assert.NoError(err)
+
+	var ts TrustedSetup
+	read, err := ts.ReadFrom(bytes.NewReader(buf.Bytes()))
+	assert.NoError(err)
+	assert.Equal(written, read)
+	assert.Equal(testSetup.G1Lagrange, ts.G1Lagrange)
+	assert.Equal(testSetup.G2Monomial, ts.G2Monomial)
+	assert.Equal(testSetup.G1Monomial, ts.G1Monomial)
+
+	// the monomial G1 points are optional
+	withoutMonomial := *testSetup
+	withoutMonomial.G1Monomial = nil
+	buf.Reset()
+	_, err = withoutMonomial.WriteTo(&buf)
+	assert.NoError(err)
+	_, err = ts.ReadFrom(bytes.NewReader(buf.Bytes()))
+	assert.NoError(err)
+	assert.Nil(ts.G1Monomial)
+
+	// truncated file
+	_, err = ts.ReadFrom(bytes.NewReader(buf.Bytes()[:buf.Len()/2]))
+	assert.ErrorIs(err, ErrInvalidTrustedSetup)
+}
+
+func TestBlobToKZGCommitment(t *testing.T) {
+	assert := require.New(t)
+	ctx := getTestContext()
+
+	// the commitment to the zero blob is the point at infinity
+	var blob Blob
+	c, err := ctx.BlobToKZGCommitment(&blob)
+	assert.NoError(err)
+	expected := KZGCommitment{0xc0}
+	assert.Equal(expected, c)
+
+	// p(X) = a + bX evaluated on the bit-reversed roots of unity commits to [a + bτ]G₁
+	var a, b, e fr.Element
+	a.SetUint64(3)
+	b.SetUint64(5)
+	for i := range ctx.roots {
+		e.Mul(&ctx.roots[i], &b).Add(&e, &a)
+		eb := e.Bytes()
+		copy(blob[i*BytesPerFieldElement:], eb[:])
+	}
+	c, err = ctx.BlobToKZGCommitment(&blob)
+	assert.NoError(err)
+
+	var pTau big.Int
+	e.Mul(&testTau, &b).Add(&e, &a).BigInt(&pTau)
+	var expectedPoint bls12381.G1Affine
+	expectedPoint.ScalarMultiplicationBase(&pTau)
+	assert.Equal(KZGCommitment(expectedPoint.Bytes()), c)
+
+	// non canonical scalar
+	for i := 0; i < BytesPerFieldElement; i++ {
+		blob[i] = 0xff
+	}
+	_, err = ctx.BlobToKZGCommitment(&blob)
+	assert.ErrorIs(err, ErrInvalidBlob)
+}
+
+func TestComputeKZGProof(t *testing.T) {
+	assert := require.New(t)
+	ctx := getTestContext()
+
+	blob := randomBlob(t)
+	commitment, err := ctx.BlobToKZGCommitment(blob)
+	assert.NoError(err)
+
+	// z outside of the domain
+	var z fr.Element
+	z.SetRandom()
+	zBytes := Bytes32(z.Bytes())
+	proof, y, err := ctx.ComputeKZGProof(blob, zBytes)
+	assert.NoError(err)
+	assert.NoError(ctx.VerifyKZGProof(commitment, zBytes, y, proof))
+
+	// wrong claimed value
+	var yElement fr.Element
+	yElement.SetBytes(y[:])
+	yElement.Add(&yElement, &ctx.roots[1])
+	assert.ErrorIs(ctx.VerifyKZGProof(commitment, zBytes, Bytes32(yElement.Bytes()), proof), ErrVerifyOpeningProof)
+
+	// z in the domain, the evaluation is read from the blob
+	for _, i := range []int{0, 1, FieldElementsPerBlob - 1} {
+		zBytes = Bytes32(ctx.roots[i].Bytes())
+		proof, y, err = ctx.ComputeKZGProof(blob, zBytes)
+		assert.NoError(err)
+		assert.Equal(blob[i*BytesPerFieldElement:(i+1)*BytesPerFieldElement], y[:])
+		assert.NoError(ctx.VerifyKZGProof(commitment, zBytes, y, proof))
+	}
+
+	// non canonical z
+	for i := range zBytes {
+		zBytes[i] = 0xff
+	}
+	_, _, err = ctx.ComputeKZGProof(blob, zBytes)
+	assert.ErrorIs(err, ErrInvalidFieldElement)
+	assert.ErrorIs(ctx.VerifyKZGProof(commitment, zBytes, y, proof), ErrInvalidFieldElement)
+
+	// invalid point encoding
+	badPoint := KZGProof{0xff}
+	assert.ErrorIs(ctx.VerifyKZGProof(commitment, Bytes32{}, Bytes32{}, badPoint), ErrInvalidProof)
+	assert.ErrorIs(ctx.VerifyKZGProof(KZGCommitment(badPoint), Bytes32{}, Bytes32{}, proof), ErrInvalidCommitment)
+}
+
+// TestPointAtInfinity runs the cases of the consensus specification reference tests for the zero
+// polynomial, whose outputs don't depend on the trusted setup.
+func TestPointAtInfinity(t *testing.T) {
+	assert := require.New(t)
+	ctx := getTestContext()
+
+	var blob Blob
+	infinity := KZGProof{0xc0}
+
+	// compute_kzg_proof_case_valid_blob with the zero blob: the proof is the point at infinity
+	var z Bytes32
+	z[31] = 2
+	proof, y, err := ctx.ComputeKZGProof(&blob, z)
+	assert.NoError(err)
+	assert.Equal(infinity, proof)
+	assert.Equal(Bytes32{}, y)
+
+	// verify_kzg_proof_case_correct_proof_point_at_infinity_for_zero_poly
+	assert.NoError(ctx.VerifyKZGProof(KZGCommitment(infinity), z, y, infinity))
+
+	// verify_kzg_proof_case_incorrect_proof_point_at_infinity: y ≠ 0
+	y[31] = 1
+	assert.ErrorIs(ctx.VerifyKZGProof(KZGCommitment(infinity), z, y, infinity), ErrVerifyOpeningProof)
+
+	// compute_blob_kzg_proof / verify_blob_kzg_proof for the zero blob
+	proof, err = ctx.ComputeBlobKZGProof(&blob, KZGCommitment(infinity))
+	assert.NoError(err)
+	assert.Equal(infinity, proof)
+	assert.NoError(ctx.VerifyBlobKZGProof(&blob, KZGCommitment(infinity), infinity))
+	assert.NoError(ctx.VerifyBlobKZGProofBatch([]Blob{blob}, []KZGCommitment{KZGCommitment(infinity)}, []KZGProof{infinity}))
+
+	// verify_blob_kzg_proof_case_proof_not_in_G1 / invalid_commitment: the inputs are rejected
+	notOnCurve := KZGProof{0x97, 0xf1, 0xd3}
+	err = ctx.VerifyBlobKZGProof(&blob, KZGCommitment(infinity), notOnCurve)
+	assert.Error(err)
+	assert.NotErrorIs(err, ErrVerifyOpeningProof)
+}
+
+func TestBlobKZGProof(t *testing.T) {
+	assert := require.New(t)
+	ctx := getTestContext()
+
+	const nbBlobs = 4
+	blobs := make([]Blob, nbBlobs)
+	commitments := make([]KZGCommitment, nbBlobs)
+	proofs := make([]KZGProof, nbBlobs)
+	for i := range blobs {
+		blobs[i] = *randomBlob(t)
+		var err error
+		commitments[i], err = ctx.BlobToKZGCommitment(&blobs[i])
+		assert.NoError(err)
+		proofs[i], err = ctx.ComputeBlobKZGProof(&blobs[i], commitments[i])
+		assert.NoError(err)
+		assert.NoError(ctx.VerifyBlobKZGProof(&blobs[i], commitments[i], proofs[i]))
+	}
+
+	assert.NoError(ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs))
+	assert.NoError(ctx.VerifyBlobKZGProofBatch(nil, nil, nil))
+	assert.NoError(ctx.VerifyBlobKZGProofBatch(blobs[:1], commitments[:1], proofs[:1]))
+	assert.ErrorIs(ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs[1:]), ErrInvalidNbInputs)
+
+	// swapping two proofs invalidates both single and batch verification
+	proofs[0], proofs[1] = proofs[1], proofs[0]
+	assert.ErrorIs(ctx.VerifyBlobKZGProof(&blobs[0], commitments[0], proofs[0]), ErrVerifyOpeningProof)
+	assert.ErrorIs(ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs), ErrVerifyOpeningProof)
+}
+
+// TestConsensusSpecVectors runs the reference tests of the consensus specification
+// (tests/general/deneb/kzg) against the Ethereum KZG ceremony output.
+//
+// They are read from testdata/trusted_setup.txt and testdata/kzg-mainnet/<handler>/<case>/data.yaml,
+// which are vendored from the outputs of "go run testdata/fetch.go".
+func TestConsensusSpecVectors(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "trusted_setup.txt"))
+	require.NoError(t, err, "the trusted setup is fetched with go run testdata/fetch.go")
+	defer f.Close()
+
+	var ts TrustedSetup
+	_, err = ts.ReadFrom(f)
+	require.NoError(t, err)
+	ctx, err := NewContext(&ts)
+	require.NoError(t, err)
+
+	type testCase struct {
+		Input  map[string]interface{} `yaml:"input"`
+		Output interface{}            `yaml:"output"`
+	}
+
+	run := func(handler string, check func(t *testing.T, tc *testCase)) {
+		t.Run(handler, func(t *testing.T) {
+			files, err := filepath.Glob(filepath.Join("testdata", "kzg-mainnet", handler, "*", "data.yaml"))
+			require.NoError(t, err)
+			require.NotEmpty(t, files, "the test vectors are fetched with go run testdata/fetch.go")
+			for _, file := range files {
+				t.Run(filepath.Base(filepath.Dir(file)), func(t *testing.T) {
+					data, err := os.ReadFile(file)
+					require.NoError(t, err)
+					var tc testCase
+					require.NoError(t, yaml.Unmarshal(data, &tc))
+					check(t, &tc)
+				})
+			}
+		})
+	}
+
+	// decode returns the hex decoded input, and false if its length is not the expected one
+	decode := func(t *testing.T, v interface{}, dst []byte) bool {
+		s, ok := v.(string)
+		require.True(t, ok)
+		b, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+		require.NoError(t, err)
+		if len(b) != len(dst) {
+			return false
+		}
+		copy(dst, b)
+		return true
+	}
+	decodeList := func(t *testing.T, v interface{}, size int) ([][]byte, bool) {
+		l, ok := v.([]interface{})
+		require.True(t, ok)
+		res := make([][]byte, len(l))
+		for i := range l {
+			res[i] = make([]byte, size)
+			if !decode(t, l[i], res[i]) {
+				return nil, false
+			}
+		}
+		return res, true
+	}
+	output := func(t *testing.T, v interface{}) []byte {
+		b := make([]byte, len(strings.TrimPrefix(v.(string), "0x"))/2)
+		require.True(t, decode(t, v, b))
+		return b
+	}
+
+	run("blob_to_kzg_commitment", func(t *testing.T, tc *testCase) {
+		var blob Blob
+		if !decode(t, tc.Input["blob"], blob[:]) {
+			require.Nil(t, tc.Output)
+			return
+		}
+		c, err := ctx.BlobToKZGCommitment(&blob)
+		if tc.Output == nil {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, output(t, tc.Output), c[:])
+	})
+
+	run("compute_kzg_proof", func(t *testing.T, tc *testCase) {
+		var blob Blob
+		var z Bytes32
+		if !decode(t, tc.Input["blob"], blob[:]) || !decode(t, tc.Input["z"], z[:]) {
+			require.Nil(t, tc.Output)
+			return
+		}
+		proof, y, err := ctx.ComputeKZGProof(&blob, z)
+		if tc.Output == nil {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		out := tc.Output.([]interface{})
+		require.Equal(t, output(t, out[0]), proof[:])
+		require.Equal(t, output(t, out[1]), y[:])
+	})
+
+	run("compute_blob_kzg_proof", func(t *testing.T, tc *testCase) {
+		var blob Blob
+		var commitment KZGCommitment
+		if !decode(t, tc.Input["blob"], blob[:]) || !decode(t, tc.Input["commitment"], commitment[:]) {
+			require.Nil(t, tc.Output)
+			return
+		}
+		proof, err := ctx.ComputeBlobKZGProof(&blob, commitment)
+		if tc.Output == nil {
+			require.Error(t, err)
+			return
+		}
+		require.NoError(t, err)
+		require.Equal(t, output(t, tc.Output), proof[:])
+	})
+
+	run("verify_kzg_proof", func(t *testing.T, tc *testCase) {
+		var commitment KZGCommitment
+		var z, y Bytes32
+		var proof KZGProof
+		if !decode(t, tc.Input["commitment"], commitment[:]) || !decode(t, tc.Input["z"], z[:]) ||
+			!decode(t, tc.Input["y"], y[:]) || !decode(t, tc.Input["proof"], proof[:]) {
+			require.Nil(t, tc.Output)
+			return
+		}
+		err := ctx.VerifyKZGProof(commitment, z, y, proof)
+		checkVerifyOutput(t, tc.Output, err)
+	})
+
+	run("verify_blob_kzg_proof", func(t *testing.T, tc *testCase) {
+		var blob Blob
+		var commitment KZGCommitment
+		var proof KZGProof
+		if !decode(t, tc.Input["blob"], blob[:]) || !decode(t, tc.Input["commitment"], commitment[:]) ||
+			!decode(t, tc.Input["proof"], proof[:]) {
+			require.Nil(t, tc.Output)
+			return
+		}
+		err := ctx.VerifyBlobKZGProof(&blob, commitment, proof)
+		checkVerifyOutput(t, tc.Output, err)
+	})
+
+	run("verify_blob_kzg_proof_batch", func(t *testing.T, tc *testCase) {
+		rawBlobs, ok1 := decodeList(t, tc.Input["blobs"], BytesPerBlob)
+		rawCommitments, ok2 := decodeList(t, tc.Input["commitments"], BytesPerCommitment)
+		rawProofs, ok3 := decodeList(t, tc.Input["proofs"], BytesPerProof)
+		if !ok1 || !ok2 || !ok3 {
+			require.Nil(t, tc.Output)
+			return
+		}
+		blobs := make([]Blob, len(rawBlobs))
+		commitments := make([]KZGCommitment, len(rawCommitments))
+		proofs := make([]KZGProof, len(rawProofs))
+		for i := range rawBlobs {
+			copy(blobs[i][:], rawBlobs[i])
+		}
+		for i := range rawCommitments {
+			copy(commitments[i][:], rawCommitments[i])
+		}
+		for i := range rawProofs {
+			copy(proofs[i][:], rawProofs[i])
+		}
+		err := ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs)
+		checkVerifyOutput(t, tc.Output, err)
+	})
+}
+
+// checkVerifyOutput checks a verification result against the expected output:
+// true, false (the proof is rejected) or null (the inputs are rejected).
+func checkVerifyOutput(t *testing.T, expected interface{}, err error) {
+	switch expected {
+	case nil:
+		require.Error(t, err)
+		require.NotErrorIs(t, err, ErrVerifyOpeningProof)
+	case true:
+		require.NoError(t, err)
+	case false:
+		require.ErrorIs(t, err, ErrVerifyOpeningProof)
+	default:
+		t.Fatalf("unexpected output %v", expected)
+	}
+}
+
+func BenchmarkBlobToKZGCommitment(b *testing.B) {
+	ctx := getTestContext()
+	var blob Blob
+	var e fr.Element
+	for i := 0; i < FieldElementsPerBlob; i++ {
+		e.MustSetRandom()
+		eb := e.Bytes()
+		copy(blob[i*BytesPerFieldElement:], eb[:])
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ctx.BlobToKZGCommitment(&blob)
+	}
+}
+
+func BenchmarkVerifyBlobKZGProofBatch(b *testing.B) {
+	ctx := getTestContext()
+	const nbBlobs = 16
+	blobs := make([]Blob, nbBlobs)
+	commitments := make([]KZGCommitment, nbBlobs)
+	proofs := make([]KZGProof, nbBlobs)
+	var e fr.Element
+	for i := range blobs {
+		for j := 0; j < FieldElementsPerBlob; j++ {
+			e.MustSetRandom()
+			eb := e.Bytes()
+			copy(blobs[i][j*BytesPerFieldElement:], eb[:])
+		}
+		commitments[i], _ = ctx.BlobToKZGCommitment(&blobs[i])
+		proofs[i], _ = ctx.ComputeBlobKZGProof(&blobs[i], commitments[i])
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = ctx.VerifyBlobKZGProofBatch(blobs, commitments, proofs)
+	}
+}
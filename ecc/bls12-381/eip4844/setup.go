@@ -0,0 +1,203 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package eip4844
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidTrustedSetup = errors.New("invalid trusted setup")
+)
+
+// TrustedSetup is the output of the Ethereum KZG ceremony.
+//
+// It is (de)serialized in the text format of the trusted_setup.txt file
+// distributed with the consensus specification and c-kzg-4844:
+// the number of G1 points, the number of G2 points, then one hex encoded
+// compressed point per line: the G1 points in Lagrange form, the G2 points in
+// monomial form and, optionally, the G1 points in monomial form.
+//
+// implements io.ReaderFrom and io.WriterTo
+type TrustedSetup struct {
+	G1Lagrange []bls12381.G1Affine // [Lᵢ(τ)]G₁, in natural order of the roots of unity
+	G2Monomial []bls12381.G2Affine // [G₂, [τ]G₂, [τ²]G₂, ...]
+	G1Monomial []bls12381.G1Affine // [G₁, [τ]G₁, [τ²]G₁, ...], may be empty
+}
+
+// WriteTo writes the trusted setup in the trusted_setup.txt format.
+func (ts *TrustedSetup) WriteTo(w io.Writer) (int64, error) {
+	bw := bufio.NewWriter(w)
+	var n int64
+
+	writeLine := func(s string) error {
+		m, err := bw.WriteString(s + "\n")
+		n += int64(m)
+		return err
+	}
+
+	if err := writeLine(strconv.Itoa(len(ts.G1Lagrange))); err != nil {
+		return n, err
+	}
+	if err := writeLine(strconv.Itoa(len(ts.G2Monomial))); err != nil {
+		return n, err
+	}
+	for i := range ts.G1Lagrange {
+		b := ts.G1Lagrange[i].Bytes()
+		if err := writeLine(hex.EncodeToString(b[:])); err != nil {
+			return n, err
+		}
+	}
+	for i := range ts.G2Monomial {
+		b := ts.G2Monomial[i].Bytes()
+		if err := writeLine(hex.EncodeToString(b[:])); err != nil {
+			return n, err
+		}
+	}
+	for i := range ts.G1Monomial {
+		b := ts.G1Monomial[i].Bytes()
+		if err := writeLine(hex.EncodeToString(b[:])); err != nil {
+			return n, err
+		}
+	}
+
+	return n, bw.Flush()
+}
+
+// ReadFrom reads a trusted setup in the trusted_setup.txt format.
+//
+// Every point is checked to be on the curve and in the prime order subgroup.
+// The trailing G1 points in monomial form are optional.
+func (ts *TrustedSetup) ReadFrom(r io.Reader) (int64, error) {
+	cr := &countingReader{r: r}
+	scanner := bufio.NewScanner(cr)
+
+	var lines []string
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		lines = append(lines, strings.TrimPrefix(line, "0x"))
+	}
+	if err := scanner.Err(); err != nil {
+		return cr.n, err
+	}
+
+	if len(lines) < 2 {
+		return cr.n, fmt.Errorf("%w: missing header", ErrInvalidTrustedSetup)
+	}
+	nbG1, err := strconv.Atoi(lines[0])
+	if err != nil {
+		return cr.n, fmt.Errorf("%w: %v", ErrInvalidTrustedSetup, err)
+	}
+	nbG2, err := strconv.Atoi(lines[1])
+	if err != nil {
+		return cr.n, fmt.Errorf("%w: %v", ErrInvalidTrustedSetup, err)
+	}
+	lines = lines[2:]
+
+	if nbG1 <= 0 || nbG2 < 2 {
+		return cr.n, fmt.Errorf("%w: invalid number of points", ErrInvalidTrustedSetup)
+	}
+	switch len(lines) {
+	case nbG1 + nbG2, 2*nbG1 + nbG2:
+	default:
+		return cr.n, fmt.Errorf("%w: expected %d or %d points, got %d", ErrInvalidTrustedSetup, nbG1+nbG2, 2*nbG1+nbG2, len(lines))
+	}
+
+	ts.G1Lagrange = make([]bls12381.G1Affine, nbG1)
+	ts.G2Monomial = make([]bls12381.G2Affine, nbG2)
+	ts.G1Monomial = nil
+	if len(lines) == 2*nbG1+nbG2 {
+		ts.G1Monomial = make([]bls12381.G1Affine, nbG1)
+	}
+
+	if err := decodeG1(ts.G1Lagrange, lines[:nbG1]); err != nil {
+		return cr.n, err
+	}
+	if err := decodeG2(ts.G2Monomial, lines[nbG1:nbG1+nbG2]); err != nil {
+		return cr.n, err
+	}
+	if ts.G1Monomial != nil {
+		if err := decodeG1(ts.G1Monomial, lines[nbG1+nbG2:]); err != nil {
+			return cr.n, err
+		}
+	}
+
+	return cr.n, nil
+}
+
+// decodeG1 decodes the hex encoded compressed points in parallel
+func decodeG1(points []bls12381.G1Affine, lines []string) error {
+	var firstErr error
+	var lock sync.Mutex
+	parallel.Execute(len(lines), func(start, end int) {
+		for i := start; i < end; i++ {
+			if err := decodePoint(&points[i], lines[i], bls12381.SizeOfG1AffineCompressed); err != nil {
+				lock.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				lock.Unlock()
+				return
+			}
+		}
+	})
+	return firstErr
+}
+
+// decodeG2 decodes the hex encoded compressed points in parallel
+func decodeG2(points []bls12381.G2Affine, lines []string) error {
+	var firstErr error
+	var lock sync.Mutex
+	parallel.Execute(len(lines), func(start, end int) {
+		for i := start; i < end; i++ {
+			if err := decodePoint(&points[i], lines[i], bls12381.SizeOfG2AffineCompressed); err != nil {
+				lock.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				lock.Unlock()
+				return
+			}
+		}
+	})
+	return firstErr
+}
+
+func decodePoint(p interface{ SetBytes([]byte) (int, error) }, line string, size int) error {
+	b, err := hex.DecodeString(line)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidTrustedSetup, err)
+	}
+	if len(b) != size {
+		return fmt.Errorf("%w: invalid point encoding size %d", ErrInvalidTrustedSetup, len(b))
+	}
+	if _, err := p.SetBytes(b); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidTrustedSetup, err)
+	}
+	return nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,123 @@
+//go:build ignore
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// fetch downloads the Ethereum KZG ceremony output and the KZG reference tests of the consensus
+// specification, for TestConsensusSpecVectors:
+//
+//	go run testdata/fetch.go
+//
+// The trusted setup is written to testdata/trusted_setup.txt, and the test cases of each handler to
+// testdata/kzg-mainnet/<handler>/<case>/data.yaml.
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	trustedSetupURL = "https://raw.githubusercontent.com/ethereum/c-kzg-4844/v1.0.0/src/trusted_setup.txt"
+	specTestsURL    = "https://github.com/ethereum/consensus-spec-tests/releases/download/v1.4.0/general.tar.gz"
+	specTestsPrefix = "tests/general/deneb/kzg/"
+)
+
+// handlers are the KZG handlers checked by TestConsensusSpecVectors
+var handlers = map[string]bool{
+	"blob_to_kzg_commitment":      true,
+	"compute_kzg_proof":           true,
+	"compute_blob_kzg_proof":      true,
+	"verify_kzg_proof":            true,
+	"verify_blob_kzg_proof":       true,
+	"verify_blob_kzg_proof_batch": true,
+}
+
+func main() {
+	dir := "testdata"
+	if _, err := os.Stat(filepath.Join(dir, "fetch.go")); err != nil {
+		log.Fatal("fetch must be run from the eip4844 package directory")
+	}
+
+	if err := download(trustedSetupURL, func(r io.Reader) error {
+		return writeFile(filepath.Join(dir, "trusted_setup.txt"), r)
+	}); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := download(specTestsURL, func(r io.Reader) error {
+		return extractSpecTests(r, filepath.Join(dir, "kzg-mainnet"))
+	}); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func download(url string, process func(io.Reader) error) error {
+	resp, err := http.Get(url)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return process(resp.Body)
+}
+
+// extractSpecTests extracts tests/general/deneb/kzg/<handler>/kzg-mainnet/<case>/data.yaml
+// to <dst>/<handler>/<case>/data.yaml
+func extractSpecTests(r io.Reader, dst string) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gz)
+	nbCases := 0
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		name := strings.TrimPrefix(h.Name, "./")
+		if h.Typeflag != tar.TypeReg || !strings.HasPrefix(name, specTestsPrefix) {
+			continue
+		}
+		parts := strings.Split(strings.TrimPrefix(name, specTestsPrefix), "/")
+		if len(parts) != 4 || parts[1] != "kzg-mainnet" || parts[3] != "data.yaml" || !handlers[parts[0]] {
+			continue
+		}
+		if err := writeFile(filepath.Join(dst, parts[0], parts[2], "data.yaml"), tr); err != nil {
+			return err
+		}
+		nbCases++
+	}
+	if nbCases == 0 {
+		return fmt.Errorf("no KZG test case found in %s", specTestsURL)
+	}
+	return nil
+}
+
+func writeFile(path string, r io.Reader) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,301 @@
+//go:build !limbs64 && (386 || arm || mips || mipsle || wasm || (purego && limbs32))
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+import "math/bits"
+
+// number of 32 bits limbs of a Element, least significant first
+const nbLimbs32 = 12
+
+// qInvNeg32 = - q⁻¹ mod 2³²
+const qInvNeg32 = qInvNeg & 0xFFFFFFFF
+
+// q on 32 bits limbs
+var qLimbs32 = [nbLimbs32]uint32{
+	q0 & 0xFFFFFFFF, q0 >> 32,
+	q1 & 0xFFFFFFFF, q1 >> 32,
+	q2 & 0xFFFFFFFF, q2 >> 32,
+	q3 & 0xFFFFFFFF, q3 >> 32,
+	q4 & 0xFFFFFFFF, q4 >> 32,
+	q5 & 0xFFFFFFFF, q5 >> 32,
+}
+
+// MulBy3 x *= 3 (mod q)
+func MulBy3(x *Element) {
+	_x := *x
+	x.Double(x).Add(x, &_x)
+}
+
+// MulBy5 x *= 5 (mod q)
+func MulBy5(x *Element) {
+	_x := *x
+	x.Double(x).Double(x).Add(x, &_x)
+}
+
+// MulBy13 x *= 13 (mod q)
+func MulBy13(x *Element) {
+	var y = Element{
+		13438459813099623723,
+		14459933216667336738,
+		14900020990258308116,
+		2941282712809091851,
+		13639094935183769893,
+		1835248516986607988,
+	}
+	x.Mul(x, &y)
+}
+
+func fromMont(z *Element) {
+	// z·R⁻¹ is the Montgomery product of z by 1
+	var x, one [nbLimbs32]uint32
+	toLimbs32(&x, z)
+	one[0] = 1
+	mulLimbs32(z, &x, &one)
+}
+
+func reduce(z *Element) {
+	_reduceGeneric(z)
+}
+
+// Mul z = x * y (mod q)
+//
+// x and y must be less than q
+func (z *Element) Mul(x, y *Element) *Element {
+	var a, b [nbLimbs32]uint32
+	toLimbs32(&a, x)
+	toLimbs32(&b, y)
+	mulLimbs32(z, &a, &b)
+	return z
+}
+
+// Square z = x * x (mod q)
+//
+// x must be less than q
+func (z *Element) Square(x *Element) *Element {
+	var a [nbLimbs32]uint32
+	toLimbs32(&a, x)
+	squareLimbs32(z, &a)
+	return z
+}
+
+// Butterfly sets
+//
+//	a = a + b (mod q)
+//	b = a - b (mod q)
+func Butterfly(a, b *Element) {
+	_butterflyGeneric(a, b)
+}
+
+// toLimbs32 splits the words of x in 32 bits limbs
+func toLimbs32(res *[nbLimbs32]uint32, x *Element) {
+	for i := 0; i < Limbs; i++ {
+		res[2*i] = uint32(x[i])
+		res[2*i+1] = uint32(x[i] >> 32)
+	}
+}
+
+// fromLimbs32 is the inverse of toLimbs32
+func fromLimbs32(z *Element, x *[nbLimbs32]uint32) {
+	for i := 0; i < Limbs; i++ {
+		z[i] = uint64(x[2*i]) | uint64(x[2*i+1])<<32
+	}
+}
+
+// mulLimbs32 sets z = x·y·R⁻¹ (mod q), for x·y < q·R
+//
+// This is the textbook CIOS Montgomery multiplication (see Mul in element_purego.go), with 32 bits words.
+// The products and their carries are accumulated in a uint64; it can't overflow
+// since (2³²-1)² + 2·(2³²-1) = 2⁶⁴-1.
+func mulLimbs32(z *Element, x, y *[nbLimbs32]uint32) {
+	var t [nbLimbs32 + 2]uint32
+	for i := 0; i < nbLimbs32; i++ {
+		// t += x·y[i]
+		var c uint64
+		yi := uint64(y[i])
+		for j := 0; j < nbLimbs32; j++ {
+			c += uint64(t[j]) + uint64(x[j])*yi
+			t[j] = uint32(c)
+			c >>= 32
+		}
+		c += uint64(t[nbLimbs32])
+		t[nbLimbs32] = uint32(c)
+		t[nbLimbs32+1] = uint32(c >> 32)
+
+		// t = (t + m·q) / 2³², with m = t[0]·qInvNeg32 mod 2³² such that the division is exact
+		m := uint64(t[0] * qInvNeg32)
+		c = (uint64(t[0]) + m*uint64(qLimbs32[0])) >> 32
+		for j := 1; j < nbLimbs32; j++ {
+			c += uint64(t[j]) + m*uint64(qLimbs32[j])
+			t[j-1] = uint32(c)
+			c >>= 32
+		}
+		c += uint64(t[nbLimbs32])
+		t[nbLimbs32-1] = uint32(c)
+		t[nbLimbs32] = t[nbLimbs32+1] + uint32(c>>32)
+	}
+	reduceLimbs32(z, (*[nbLimbs32 + 1]uint32)(t[:nbLimbs32+1]))
+}
+
+// squareLimbs32 sets z = x²·R⁻¹ (mod q), for x² < q·R
+//
+// The square is computed first, each product x[i]·x[j] with i < j once and doubled, then it is
+// reduced with the SOS Montgomery reduction, with 32 bits words.
+func squareLimbs32(z *Element, x *[nbLimbs32]uint32) {
+	var t [2*nbLimbs32 + 1]uint32
+
+	// t = ∑_{i<j} x[i]·x[j]·2^(32(i+j))
+	for i := 0; i < nbLimbs32-1; i++ {
+		var c uint64
+		xi := uint64(x[i])
+		for j := i + 1; j < nbLimbs32; j++ {
+			c += uint64(t[i+j]) + xi*uint64(x[j])
+			t[i+j] = uint32(c)
+			c >>= 32
+		}
+		t[i+nbLimbs32] = uint32(c)
+	}
+
+	// t = 2t + ∑ x[i]²·2^(64i); x² < 2^(64·Limbs) so there is no carry out
+	var c uint64
+	var msb uint32
+	for i := 0; i < nbLimbs32; i++ {
+		sq := uint64(x[i]) * uint64(x[i])
+		lo, hi := t[2*i], t[2*i+1]
+		c += uint64(lo<<1|msb) + sq&0xFFFFFFFF
+		t[2*i] = uint32(c)
+		c >>= 32
+		c += uint64(hi<<1|lo>>31) + sq>>32
+		t[2*i+1] = uint32(c)
+		c >>= 32
+		msb = hi >> 31
+	}
+
+	// t = t / 2^(32·nbLimbs32) (mod q), adding m·q·2^(32i) such that t[i] = 0 at each step
+	var top uint64
+	for i := 0; i < nbLimbs32; i++ {
+		m := uint64(t[i] * qInvNeg32)
+		c = (uint64(t[i]) + m*uint64(qLimbs32[0])) >> 32
+		for j := 1; j < nbLimbs32; j++ {
+			c += uint64(t[i+j]) + m*uint64(qLimbs32[j])
+			t[i+j] = uint32(c)
+			c >>= 32
+		}
+		top += uint64(t[i+nbLimbs32]) + c
+		t[i+nbLimbs32] = uint32(top)
+		top >>= 32
+	}
+	t[2*nbLimbs32] = uint32(top)
+	reduceLimbs32(z, (*[nbLimbs32 + 1]uint32)(t[nbLimbs32:]))
+}
+
+// mulWNonModular multiplies by one word in non-montgomery, without reducing
+//
+// This is the 32 bits limbs version of mulWNonModular in element_limbs64.go; |y| is split in two 32 bits limbs.
+func (z *Element) mulWNonModular(x *Element, y int64) uint64 {
+	// w := abs(y)
+	m := y >> 63
+	w := uint64((y ^ m) - m)
+	w0, w1 := w&0xFFFFFFFF, w>>32
+
+	var a [nbLimbs32]uint32
+	toLimbs32(&a, x)
+
+	// t = a·w0 + a·w1·2³²
+	var t [nbLimbs32 + 2]uint32
+	var c uint64
+	for j := 0; j < nbLimbs32; j++ {
+		c += uint64(a[j]) * w0
+		t[j] = uint32(c)
+		c >>= 32
+	}
+	t[nbLimbs32] = uint32(c)
+	c = 0
+	for j := 0; j < nbLimbs32; j++ {
+		c += uint64(t[j+1]) + uint64(a[j])*w1
+		t[j+1] = uint32(c)
+		c >>= 32
+	}
+	t[nbLimbs32+1] = uint32(c)
+
+	fromLimbs32(z, (*[nbLimbs32]uint32)(t[:nbLimbs32]))
+	hi := uint64(t[nbLimbs32]) | uint64(t[nbLimbs32+1])<<32
+
+	if y < 0 {
+		hi = negL(z, hi)
+	}
+
+	return hi
+}
+
+// montReduceSigned z = (xHi * r + x) * r⁻¹ using the SOS algorithm
+// Requires |xHi| < 2⁶³. Most significant bit of xHi is the sign bit.
+//
+// This is the 32 bits limbs version of montReduceSigned in element_limbs64.go.
+func (z *Element) montReduceSigned(x *Element, xHi uint64) {
+	const signBitRemover = ^signBitSelector
+	mustNeg := xHi&signBitSelector != 0
+	// a negative X = xHi*r + x is represented as 2⁶³ r + X, see the 64 bits version
+	xHi &= signBitRemover
+
+	// t = xHi*r + x, on nbLimbs32 + 2 limbs
+	var t [nbLimbs32 + 2]uint32
+	toLimbs32((*[nbLimbs32]uint32)(t[:nbLimbs32]), x)
+	t[nbLimbs32] = uint32(xHi)
+	t[nbLimbs32+1] = uint32(xHi >> 32)
+
+	// t = (t + m·q) / 2³², nbLimbs32 times, with m such that the divisions are exact.
+	// t < 2⁶³ r + r, so t < 2⁶³ + 1 + q < 2q at the end.
+	for i := 0; i < nbLimbs32; i++ {
+		m := uint64(t[0] * qInvNeg32)
+		c := (uint64(t[0]) + m*uint64(qLimbs32[0])) >> 32
+		for j := 1; j < nbLimbs32; j++ {
+			c += uint64(t[j]) + m*uint64(qLimbs32[j])
+			t[j-1] = uint32(c)
+			c >>= 32
+		}
+		c += uint64(t[nbLimbs32])
+		t[nbLimbs32-1] = uint32(c)
+		c = c>>32 + uint64(t[nbLimbs32+1])
+		t[nbLimbs32] = uint32(c)
+		t[nbLimbs32+1] = uint32(c >> 32)
+	}
+	reduceLimbs32(z, (*[nbLimbs32 + 1]uint32)(t[:nbLimbs32+1]))
+
+	if mustNeg {
+		// We have computed ( 2⁶³ r + X ) r⁻¹ = 2⁶³ + X r⁻¹ instead
+		var b uint64
+		z[0], b = bits.Sub64(z[0], signBitSelector, 0)
+		for i := 1; i < Limbs; i++ {
+			z[i], b = bits.Sub64(z[i], 0, b)
+		}
+
+		// Occurs iff x == 0 && xHi < 0, i.e. X = rX' for -2⁶³ ≤ X' < 0
+		if b != 0 {
+			// negative: add q
+			var c uint64
+			for i := 0; i < Limbs; i++ {
+				z[i], c = bits.Add64(z[i], qElement[i], c)
+			}
+		}
+	}
+}
+
+// reduceLimbs32 sets z = t (mod q), for t < 2q
+func reduceLimbs32(z *Element, t *[nbLimbs32 + 1]uint32) {
+	var s [nbLimbs32]uint32
+	var b uint32
+	for j := 0; j < nbLimbs32; j++ {
+		s[j], b = bits.Sub32(t[j], qLimbs32[j], b)
+	}
+	if t[nbLimbs32] != 0 || b == 0 {
+		// t ⩾ q
+		fromLimbs32(z, &s)
+	} else {
+		fromLimbs32(z, (*[nbLimbs32]uint32)(t[:nbLimbs32]))
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+)
+
+func TestFFTOutOfCore(t *testing.T) {
+	for logN := 0; logN <= 9; logN++ {
+		n := 1 << logN
+		domain := NewDomain(uint64(n), WithoutPrecompute())
+
+		// at least one column, at most two columns or rows per block
+		n2 := 1 << (logN - logN/2)
+		budget := 2 * fr.Bytes * 2 * n2
+
+		for _, decimation := range []Decimation{DIF, DIT} {
+			for _, inverse := range []bool{false, true} {
+				for _, coset := range []bool{false, true} {
+					name := fmt.Sprintf("n=%d/DIF=%v/inverse=%v/coset=%v", n, decimation == DIF, inverse, coset)
+					t.Run(name, func(t *testing.T) {
+						var opts []Option
+						if coset {
+							opts = append(opts, OnCoset())
+						}
+
+						pol := make([]fr.Element, n)
+						for i := range pol {
+							pol[i].MustSetRandom()
+						}
+						storage := make(MemStorage, n*fr.Bytes)
+						encode(storage, pol)
+
+						var err error
+						if inverse {
+							domain.FFTInverse(pol, decimation, opts...)
+							err = domain.FFTInverseOutOfCore(storage, decimation, append(opts, WithMemoryBudget(budget))...)
+						} else {
+							domain.FFT(pol, decimation, opts...)
+							err = domain.FFTOutOfCore(storage, decimation, append(opts, WithMemoryBudget(budget))...)
+						}
+						if err != nil {
+							t.Fatal(err)
+						}
+						checkStorage(t, storage, pol)
+					})
+				}
+			}
+		}
+	}
+}
+
+func TestFFTOutOfCoreFile(t *testing.T) {
+	const n = 1 << 10
+	domain := NewDomain(n, WithoutPrecompute())
+
+	pol := make([]fr.Element, n)
+	for i := range pol {
+		pol[i].MustSetRandom()
+	}
+	encoded := make([]byte, n*fr.Bytes)
+	encode(encoded, pol)
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "fft"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.Write(encoded); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := domain.FFTOutOfCore(f, DIF, OnCoset(), WithMemoryBudget(1<<12)); err != nil {
+		t.Fatal(err)
+	}
+	if err := domain.FFTInverseOutOfCore(f, DIT, OnCoset(), WithMemoryBudget(1<<12)); err != nil {
+		t.Fatal(err)
+	}
+	// DIF then DIT inverse gives back the input in natural order
+	if _, err := f.ReadAt(encoded, 0); err != nil {
+		t.Fatal(err)
+	}
+	checkStorage(t, MemStorage(encoded), pol)
+}
+
+func TestFFTOutOfCoreErrors(t *testing.T) {
+	const n = 1 << 8
+	domain := NewDomain(n, WithoutPrecompute())
+	storage := make(MemStorage, n*fr.Bytes)
+
+	// the domain holds no table of size n
+	if _, err := domain.Twiddles(); err == nil {
+		t.Fatal("the domain shouldn't hold precomputed twiddles")
+	}
+	if _, err := domain.CosetTable(); err == nil {
+		t.Fatal("the domain shouldn't hold a precomputed coset table")
+	}
+
+	// a domain with precomputed tables is rejected
+	if err := NewDomain(n).FFTOutOfCore(storage, DIF); err != ErrPrecomputedDomain {
+		t.Fatal("expected ErrPrecomputedDomain for a precomputed domain")
+	}
+
+	// a column doesn't fit in the memory budget
+	if err := domain.FFTOutOfCore(storage, DIF, WithMemoryBudget(fr.Bytes)); err == nil {
+		t.Fatal("expected an error for a too small memory budget")
+	}
+
+	// the storage is too short
+	if err := domain.FFTOutOfCore(storage[:len(storage)-1], DIF); err == nil {
+		t.Fatal("expected an error for a too short storage")
+	}
+
+	// the storage contains a non canonical encoding
+	for i := range storage {
+		storage[i] = 0xff
+	}
+	if err := domain.FFTOutOfCore(storage, DIT); err == nil {
+		t.Fatal("expected an error for an invalid encoding")
+	}
+}
+
+func encode(buf []byte, v []fr.Element) {
+	for i := range v {
+		fr.BigEndian.PutElement((*[fr.Bytes]byte)(buf[i*fr.Bytes:]), v[i])
+	}
+}
+
+func checkStorage(t *testing.T, storage MemStorage, expected []fr.Element) {
+	t.Helper()
+	for i := range expected {
+		e, err := fr.BigEndian.Element((*[fr.Bytes]byte)(storage[i*fr.Bytes:]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !e.Equal(&expected[i]) {
+			t.Fatalf("mismatch at index %d", i)
+		}
+	}
+}
+
+func BenchmarkFFTOutOfCore(b *testing.B) {
+	const n = 1 << 18
+	domain := NewDomain(n, WithoutPrecompute())
+	pol := make([]fr.Element, n)
+	for i := range pol {
+		pol[i].MustSetRandom()
+	}
+	storage := make(MemStorage, n*fr.Bytes)
+	encode(storage, pol)
+
+	for _, budget := range []int{1 << 16, 1 << 20, 1 << 28} {
+		b.Run(fmt.Sprintf("budget=%d", budget), func(b *testing.B) {
+			for j := 0; j < b.N; j++ {
+				if err := domain.FFTOutOfCore(storage, DIF, WithMemoryBudget(budget)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,526 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/accumulator/merkletree"
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/fft"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// QueryProof openings of the committed layers at one of the positions queried by the verifier.
+type QueryProof struct {
+
+	// ProofSets[i] is the Merkle proof of the leaf of the i-th layer containing the
+	// query. The leaf ProofSets[i][0] is the concatenation of the evaluations of the
+	// i-th folded polynomial on the fiber of the query.
+	ProofSets [][][]byte
+}
+
+// configurableFri multiplicative FRI on a power of 2 subgroup of Fᵣ^{*}, folding
+// cfg.Arity evaluations at each step using the map x -> x^{arity}.
+//
+// The i-th layer is the evaluation of the i-th folded polynomial on gᵢʲ, j < Lᵢ, where
+// gᵢ = g^{arityⁱ} and Lᵢ = size_code_word/arityⁱ. Its Merkle tree has Lᵢ/arity leaves,
+// the j-th leaf is the concatenation of the evaluations on the fiber of gᵢ₊₁ʲ, that is
+// on gᵢ^{j + t*Lᵢ/arity} for t < arity. The polynomial is folded until its size is less
+// than the arity, and the fully folded polynomial is sent in the clear.
+type configurableFri struct {
+
+	// hash function that is used for Fiat Shamir and for committing to
+	// the oracles.
+	h hash.Hash
+
+	cfg Config
+
+	// nbSteps number of folding steps, i.e. number of committed layers
+	nbSteps int
+
+	// finalSize size of the fully folded polynomial
+	finalSize int
+
+	// domain used to build the Reed Solomon code from the given polynomial.
+	// The size of the domain is ρ⁻¹*size_polynomial.
+	domain *fft.Domain
+
+	// finalDomain domain on which the fully folded polynomial is evaluated
+	finalDomain *fft.Domain
+
+	// omegaInv powers of ω⁻¹, where ω is a primitive arity-th root of 1
+	omegaInv []fr.Element
+
+	// arityInv arity⁻¹
+	arityInv fr.Element
+}
+
+func newConfigurableFri(size uint64, h hash.Hash, cfg Config) (configurableFri, error) {
+
+	var res configurableFri
+	res.h = h
+	res.cfg = cfg
+
+	// computing the number of steps
+	n := ecc.NextPowerOfTwo(size)
+	logN := bits.TrailingZeros64(n)
+	logArity := bits.TrailingZeros(uint(cfg.Arity))
+	res.nbSteps = logN / logArity
+	if res.nbSteps == 0 {
+		return res, fmt.Errorf("%w: the polynomial size %d is smaller than the arity", ErrInvalidConfig, size)
+	}
+	res.finalSize = 1 << (logN % logArity)
+
+	// building the domains
+	res.domain = fft.NewDomain(n * uint64(cfg.BlowupFactor))
+	res.finalDomain = fft.NewDomain(uint64(res.finalSize * cfg.BlowupFactor))
+
+	// ω = g^{size_code_word/arity}
+	res.omegaInv = make([]fr.Element, cfg.Arity)
+	res.omegaInv[0].SetOne()
+	res.omegaInv[1].Exp(res.domain.GeneratorInv, new(big.Int).SetUint64(res.domain.Cardinality/uint64(cfg.Arity)))
+	for i := 2; i < cfg.Arity; i++ {
+		res.omegaInv[i].Mul(&res.omegaInv[i-1], &res.omegaInv[1])
+	}
+	res.arityInv.SetUint64(uint64(cfg.Arity)).Inverse(&res.arityInv)
+
+	return res, nil
+}
+
+// transcriptNames returns the names of the Fiat Shamir challenges: the folding
+// challenges, the seed of the proof of work, and the queries.
+func (s configurableFri) transcriptNames() []string {
+	res := make([]string, 0, s.nbSteps+1+s.cfg.NbQueries)
+	for i := 0; i < s.nbSteps; i++ {
+		res = append(res, fmt.Sprintf("x%d", i))
+	}
+	res = append(res, "final")
+	for i := 0; i < s.cfg.NbQueries; i++ {
+		res = append(res, fmt.Sprintf("q%d", i))
+	}
+	return res
+}
+
+// evaluate returns the evaluation of p on the domain, in natural order
+func (s configurableFri) evaluate(p []fr.Element) []fr.Element {
+	res := make([]fr.Element, s.domain.Cardinality)
+	copy(res, p)
+	s.domain.FFT(res, fft.DIF)
+	fft.BitReverse(res)
+	return res
+}
+
+// leaves returns the leaves of the Merkle tree of a layer
+func (s configurableFri) leaves(layer []fr.Element) [][]byte {
+	nbLeaves := len(layer) / s.cfg.Arity
+	res := make([][]byte, nbLeaves)
+	for j := range res {
+		res[j] = make([]byte, 0, s.cfg.Arity*fr.Bytes)
+		for t := 0; t < s.cfg.Arity; t++ {
+			res[j] = append(res[j], layer[j+t*nbLeaves].Marshal()...)
+		}
+	}
+	return res
+}
+
+// merkleTree builds the Merkle tree of the leaves, proving the leaf at index
+func (s configurableFri) merkleTree(leaves [][]byte, index uint64) (*merkletree.Tree, error) {
+	t := merkletree.New(s.h)
+	if err := t.SetIndex(index); err != nil {
+		return nil, err
+	}
+	for i := range leaves {
+		t.Push(leaves[i])
+	}
+	return t, nil
+}
+
+// layerTree is the Merkle tree of a layer, with all its nodes stored so that the leaves
+// of all the queries are proven without rebuilding it. The number of leaves is a power of 2,
+// and the nodes are hashed as in merkletree: nodes[0][j] = H(leaves[j]) and
+// nodes[k+1][j] = H(nodes[k][2j] || nodes[k][2j+1]).
+type layerTree struct {
+	leaves [][]byte
+	nodes  [][][]byte
+}
+
+// buildLayerTree builds the Merkle tree of the leaves
+func (s configurableFri) buildLayerTree(leaves [][]byte) layerTree {
+	sum := func(data ...[]byte) []byte {
+		s.h.Reset()
+		for _, d := range data {
+			s.h.Write(d)
+		}
+		return s.h.Sum(nil)
+	}
+	t := layerTree{leaves: leaves}
+	level := make([][]byte, len(leaves))
+	for j := range leaves {
+		level[j] = sum(leaves[j])
+	}
+	t.nodes = append(t.nodes, level)
+	for len(level) > 1 {
+		next := make([][]byte, len(level)/2)
+		for j := range next {
+			next[j] = sum(level[2*j], level[2*j+1])
+		}
+		t.nodes = append(t.nodes, next)
+		level = next
+	}
+	return t
+}
+
+// root returns the Merkle root of the layer
+func (t layerTree) root() []byte {
+	return t.nodes[len(t.nodes)-1][0]
+}
+
+// proofSet returns the Merkle proof of the leaf at index, as merkletree.Tree.Prove: the leaf
+// followed by the siblings of its path, from the bottom.
+func (t layerTree) proofSet(index uint64) [][]byte {
+	res := make([][]byte, 0, len(t.nodes))
+	res = append(res, t.leaves[index])
+	for k := 0; k < len(t.nodes)-1; k++ {
+		res = append(res, t.nodes[k][index^1])
+		index >>= 1
+	}
+	return res
+}
+
+// decodeLeaf returns the evaluations stored in a leaf
+func (s configurableFri) decodeLeaf(leaf []byte) ([]fr.Element, error) {
+	if len(leaf) != s.cfg.Arity*fr.Bytes {
+		return nil, ErrProofShape
+	}
+	res := make([]fr.Element, s.cfg.Arity)
+	for t := range res {
+		if err := res[t].SetBytesCanonical(leaf[t*fr.Bytes : (t+1)*fr.Bytes]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// fold returns the evaluation at y = x^{arity} of the folded polynomial ∑ᵣβʳpᵣ(Y), where
+// p(X) = ∑ᵣXʳpᵣ(X^{arity}) and v are the evaluations of p on the fiber {xωᵗ} of y.
+func (s configurableFri) fold(v []fr.Element, xInv, beta fr.Element) fr.Element {
+
+	// cᵣ = arity⁻¹∑ₜvₜω⁻ᵗʳ = xʳpᵣ(y), so the folded value is ∑ᵣcᵣ(β/x)ʳ
+	var z, res, c, tmp fr.Element
+	z.Mul(&beta, &xInv)
+	k := len(v)
+	for r := k - 1; r >= 0; r-- {
+		c.SetZero()
+		for t := 0; t < k; t++ {
+			tmp.Mul(&v[t], &s.omegaInv[(t*r)%k])
+			c.Add(&c, &tmp)
+		}
+		res.Mul(&res, &z).Add(&res, &c)
+	}
+	res.Mul(&res, &s.arityInv)
+	return res
+}
+
+// foldLayer folds a layer evaluated on gʲ, where gInv = g⁻¹
+func (s configurableFri) foldLayer(layer []fr.Element, gInv, beta fr.Element) []fr.Element {
+	nbLeaves := len(layer) / s.cfg.Arity
+	res := make([]fr.Element, nbLeaves)
+	parallel.Execute(nbLeaves, func(start, end int) {
+		v := make([]fr.Element, s.cfg.Arity)
+		var xInv fr.Element
+		xInv.Exp(gInv, big.NewInt(int64(start)))
+		for j := start; j < end; j++ {
+			for t := range v {
+				v[t] = layer[j+t*nbLeaves]
+			}
+			res[j] = s.fold(v, xInv, beta)
+			xInv.Mul(&xInv, &gInv)
+		}
+	})
+	return res
+}
+
+// deriveQueries derives the positions queried by the verifier, once the nonce is
+// bound to the transcript.
+func (s configurableFri) deriveQueries(fs *fiatshamir.Transcript) ([]uint64, error) {
+	res := make([]uint64, s.cfg.NbQueries)
+	var bPos, bCardinality big.Int
+	bCardinality.SetUint64(s.domain.Cardinality)
+	for i := range res {
+		binSeed, err := fs.ComputeChallenge(fmt.Sprintf("q%d", i))
+		if err != nil {
+			return nil, err
+		}
+		bPos.SetBytes(binSeed)
+		bPos.Mod(&bPos, &bCardinality)
+		res[i] = bPos.Uint64()
+	}
+	return res, nil
+}
+
+// bindNonce binds the proof of work nonce to the first query
+func bindNonce(fs *fiatshamir.Transcript, nonce uint64) error {
+	var bNonce [8]byte
+	binary.BigEndian.PutUint64(bNonce[:], nonce)
+	return fs.Bind("q0", bNonce[:])
+}
+
+// BuildProofOfProximity generates a proof that a function, given as an oracle from
+// the verifier point of view, is in fact δ-close to a polynomial.
+func (s configurableFri) BuildProofOfProximity(p []fr.Element) (ProofOfProximity, error) {
+
+	var proof ProofOfProximity
+	proof.Config = s.cfg
+
+	names := s.transcriptNames()
+	fs := fiatshamir.NewTranscript(s.h, names...)
+	if err := fs.Bind(names[0], s.cfg.marshal()); err != nil {
+		return proof, err
+	}
+
+	// step 1: commit to the layers and fold them using the challenges xᵢ
+	layer := s.evaluate(p)
+	trees := make([]layerTree, s.nbSteps)
+	proof.Roots = make([][]byte, s.nbSteps)
+	var gInv fr.Element
+	gInv.Set(&s.domain.GeneratorInv)
+	for i := 0; i < s.nbSteps; i++ {
+
+		trees[i] = s.buildLayerTree(s.leaves(layer))
+		proof.Roots[i] = trees[i].root()
+		if err := fs.Bind(names[i], proof.Roots[i]); err != nil {
+			return proof, err
+		}
+		bxi, err := fs.ComputeChallenge(names[i])
+		if err != nil {
+			return proof, err
+		}
+		var xi fr.Element
+		xi.SetBytes(bxi)
+
+		layer = s.foldLayer(layer, gInv, xi)
+
+		// g <- g^{arity}
+		for j := 1; j < s.cfg.Arity; j <<= 1 {
+			gInv.Square(&gInv)
+		}
+	}
+
+	// the fully folded polynomial is sent in coefficient form
+	s.finalDomain.FFTInverse(layer, fft.DIF)
+	fft.BitReverse(layer)
+	proof.FinalPolynomial = layer[:s.finalSize]
+	for i := range proof.FinalPolynomial {
+		if err := fs.Bind("final", proof.FinalPolynomial[i].Marshal()); err != nil {
+			return proof, err
+		}
+	}
+	seed, err := fs.ComputeChallenge("final")
+	if err != nil {
+		return proof, err
+	}
+
+	// step 2: proof of work
+	proof.Nonce = grind(s.h, seed, s.cfg.GrindingBits)
+	if err := bindNonce(fs, proof.Nonce); err != nil {
+		return proof, err
+	}
+
+	// step 3: provide the Merkle proofs of the queries
+	positions, err := s.deriveQueries(fs)
+	if err != nil {
+		return proof, err
+	}
+	proof.Queries = make([]QueryProof, len(positions))
+	for q, pos := range positions {
+		proof.Queries[q].ProofSets = make([][][]byte, s.nbSteps)
+		for i := 0; i < s.nbSteps; i++ {
+			// the next position is the index of the leaf in the current layer
+			pos %= uint64(len(trees[i].leaves))
+			proof.Queries[q].ProofSets[i] = trees[i].proofSet(pos)
+		}
+	}
+
+	return proof, nil
+}
+
+// VerifyProofOfProximity verifies the proof, by checking the consistency of the
+// folding at each of the queried positions.
+func (s configurableFri) VerifyProofOfProximity(proof ProofOfProximity) error {
+
+	if proof.Config != s.cfg {
+		return ErrConfigMismatch
+	}
+	if len(proof.Roots) != s.nbSteps || len(proof.FinalPolynomial) != s.finalSize || len(proof.Queries) != s.cfg.NbQueries {
+		return ErrProofShape
+	}
+
+	// replay the Fiat Shamir transcript
+	names := s.transcriptNames()
+	fs := fiatshamir.NewTranscript(s.h, names...)
+	if err := fs.Bind(names[0], s.cfg.marshal()); err != nil {
+		return err
+	}
+	xi := make([]fr.Element, s.nbSteps)
+	for i := 0; i < s.nbSteps; i++ {
+		if err := fs.Bind(names[i], proof.Roots[i]); err != nil {
+			return err
+		}
+		bxi, err := fs.ComputeChallenge(names[i])
+		if err != nil {
+			return err
+		}
+		xi[i].SetBytes(bxi)
+	}
+	for i := range proof.FinalPolynomial {
+		if err := fs.Bind("final", proof.FinalPolynomial[i].Marshal()); err != nil {
+			return err
+		}
+	}
+	seed, err := fs.ComputeChallenge("final")
+	if err != nil {
+		return err
+	}
+
+	// proof of work
+	if s.cfg.GrindingBits > 0 && !checkGrinding(s.h, seed, proof.Nonce, s.cfg.GrindingBits) {
+		return ErrGrinding
+	}
+	if err := bindNonce(fs, proof.Nonce); err != nil {
+		return err
+	}
+	positions, err := s.deriveQueries(fs)
+	if err != nil {
+		return err
+	}
+
+	// inverses of the generators of the layers
+	gInv := make([]fr.Element, s.nbSteps)
+	gInv[0].Set(&s.domain.GeneratorInv)
+	for i := 1; i < s.nbSteps; i++ {
+		gInv[i].Exp(gInv[i-1], big.NewInt(int64(s.cfg.Arity)))
+	}
+
+	for q, pos := range positions {
+
+		proofSets := proof.Queries[q].ProofSets
+		if len(proofSets) != s.nbSteps {
+			return ErrProofShape
+		}
+
+		// folded value at the current position
+		var folded fr.Element
+		layerSize := s.domain.Cardinality
+		for i := 0; i < s.nbSteps; i++ {
+
+			nbLeaves := layerSize / uint64(s.cfg.Arity)
+			leafIndex := pos % nbLeaves
+			if len(proofSets[i]) == 0 {
+				return ErrProofShape
+			}
+			if !merkletree.VerifyProof(s.h, proof.Roots[i], proofSets[i], leafIndex, nbLeaves) {
+				return ErrMerklePath
+			}
+			v, err := s.decodeLeaf(proofSets[i][0])
+			if err != nil {
+				return err
+			}
+
+			// the queried entry must be the value folded at the previous step
+			if i > 0 && !v[pos/nbLeaves].Equal(&folded) {
+				return ErrProximityTestFolding
+			}
+
+			var xInv fr.Element
+			xInv.Exp(gInv[i], new(big.Int).SetUint64(leafIndex))
+			folded = s.fold(v, xInv, xi[i])
+
+			pos = leafIndex
+			layerSize = nbLeaves
+		}
+
+		// last step: the folded value must be the evaluation of the final polynomial
+		var x fr.Element
+		x.Exp(s.finalDomain.Generator, new(big.Int).SetUint64(pos))
+		expected := eval(proof.FinalPolynomial, x)
+		if !expected.Equal(&folded) {
+			return ErrProximityTestFolding
+		}
+	}
+
+	return nil
+}
+
+// Open opens a polynomial at gⁱ where i = position.
+func (s configurableFri) Open(p []fr.Element, position uint64) (OpeningProof, error) {
+
+	// check that position is in the correct range
+	if position >= s.domain.Cardinality {
+		return OpeningProof{}, ErrRangePosition
+	}
+
+	q := s.evaluate(p)
+	leaves := s.leaves(q)
+	t, err := s.merkleTree(leaves, position%uint64(len(leaves)))
+	if err != nil {
+		return OpeningProof{}, err
+	}
+
+	var res OpeningProof
+	res.merkleRoot, res.ProofSet, res.index, res.numLeaves = t.Prove()
+	res.ClaimedValue.Set(&q[position])
+
+	return res, nil
+}
+
+// VerifyOpening verifies the opening of a polynomial at gⁱ where i = position. The root
+// of the Merkle proof must be the root of the first layer of the proof of proximity.
+func (s configurableFri) VerifyOpening(position uint64, openingProof OpeningProof, pp ProofOfProximity) error {
+
+	if position >= s.domain.Cardinality {
+		return ErrRangePosition
+	}
+	if len(pp.Roots) == 0 || len(openingProof.ProofSet) == 0 {
+		return ErrProofShape
+	}
+
+	// check that the merkle roots coincide
+	if !bytes.Equal(openingProof.merkleRoot, pp.Roots[0]) {
+		return ErrMerkleRoot
+	}
+
+	// check the Merkle proof, and that the claimed value is in the leaf
+	nbLeaves := s.domain.Cardinality / uint64(s.cfg.Arity)
+	if !merkletree.VerifyProof(s.h, openingProof.merkleRoot, openingProof.ProofSet, position%nbLeaves, nbLeaves) {
+		return ErrMerklePath
+	}
+	v, err := s.decodeLeaf(openingProof.ProofSet[0])
+	if err != nil {
+		return err
+	}
+	if !v[position/nbLeaves].Equal(&openingProof.ClaimedValue) {
+		return ErrMerklePath
+	}
+
+	return nil
+}
+
+// eval returns f(x), f in canonical basis
+func eval(f []fr.Element, x fr.Element) fr.Element {
+	var y fr.Element
+	for i := len(f) - 1; i >= 0; i-- {
+		y.Mul(&y, &x).Add(&y, &f[i])
+	}
+	return y
+}
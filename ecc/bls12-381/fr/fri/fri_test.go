@@ -15,6 +15,7 @@ import (
 	"github.com/leanovate/gopter"
 	"github.com/leanovate/gopter/gen"
 	"github.com/leanovate/gopter/prop"
+	"github.com/stretchr/testify/require"
 )
 
 // logFiber returns u, v such that {g^u, g^v} = f⁻¹((g²)^{_p})
@@ -201,6 +202,161 @@ func TestFRI(t *testing.T) {
 
 }
 
+func TestNewConfig(t *testing.T) {
+	assert := require.New(t)
+
+	cfg, err := NewConfig(8, 4, 100, 20)
+	assert.NoError(err)
+	assert.Equal(Config{BlowupFactor: 8, Arity: 4, NbQueries: 27, GrindingBits: 20}, cfg)
+	cfg, err = NewConfig(16, 16, 128, 0)
+	assert.NoError(err)
+	assert.Equal(32, cfg.NbQueries)
+
+	for _, c := range [][4]int{
+		{1, 2, 100, 0},  // blowup factor
+		{32, 2, 100, 0}, // blowup factor
+		{6, 2, 100, 0},  // blowup factor
+		{8, 3, 100, 0},  // arity
+		{8, 32, 100, 0}, // arity
+		{8, 2, 20, 20},  // grinding
+		{8, 2, 100, 40}, // grinding
+	} {
+		_, err = NewConfig(c[0], c[1], c[2], c[3])
+		assert.ErrorIs(err, ErrInvalidConfig, "%v", c)
+	}
+
+	// the default configuration is the radix 2 FRI
+	iop, err := RADIX_2_FRI.NewWithConfig(64, sha256.New(), DefaultConfig())
+	assert.NoError(err)
+	assert.IsType(radixTwoFri{}, iop)
+	_, err = RADIX_2_FRI.NewWithConfig(8, sha256.New(), Config{BlowupFactor: 2, Arity: 16, NbQueries: 1})
+	assert.ErrorIs(err, ErrInvalidConfig)
+	_, err = IOPP(42).NewWithConfig(8, sha256.New(), DefaultConfig())
+	assert.ErrorIs(err, ErrUnknownIOPP)
+}
+
+// TestZeroConfig checks that the proofs with a zero Config, such as the proofs built
+// before it was added, are verified with DefaultConfig.
+func TestZeroConfig(t *testing.T) {
+	assert := require.New(t)
+
+	const size = 1024
+	p := randomPolynomial(size, 5)
+	iop := RADIX_2_FRI.New(size, sha256.New())
+	proof, err := iop.BuildProofOfProximity(p)
+	assert.NoError(err)
+	assert.Equal(DefaultConfig(), proof.Config)
+
+	proof.Config = Config{}
+	assert.NoError(iop.VerifyProofOfProximity(proof))
+}
+
+// TestLayerTree checks that the stored Merkle trees of the layers give the same roots and
+// proofs as merkletree.
+func TestLayerTree(t *testing.T) {
+	assert := require.New(t)
+
+	cfg, err := NewConfig(2, 4, 40, 0)
+	assert.NoError(err)
+	iop, err := RADIX_2_FRI.NewWithConfig(64, sha256.New(), cfg)
+	assert.NoError(err)
+	s := iop.(configurableFri)
+
+	leaves := make([][]byte, 16)
+	for i := range leaves {
+		leaves[i] = []byte{byte(i), 42}
+	}
+	tree := s.buildLayerTree(leaves)
+	for i := range leaves {
+		expected, err := s.merkleTree(leaves, uint64(i))
+		assert.NoError(err)
+		root, proofSet, _, _ := expected.Prove()
+		assert.Equal(root, tree.root())
+		assert.Equal(proofSet, tree.proofSet(uint64(i)))
+	}
+}
+
+func TestConfigurableFRI(t *testing.T) {
+
+	const size = 1000
+	p := make(fr.Vector, size)
+	p.MustSetRandom()
+
+	for _, blowup := range []int{2, 16} {
+		for _, arity := range []int{2, 4, 8, 16} {
+			t.Run(fmt.Sprintf("blowup=%d/arity=%d", blowup, arity), func(t *testing.T) {
+				assert := require.New(t)
+
+				cfg, err := NewConfig(blowup, arity, 40, 4)
+				assert.NoError(err)
+				iop, err := RADIX_2_FRI.NewWithConfig(size, sha256.New(), cfg)
+				assert.NoError(err)
+
+				proof, err := iop.BuildProofOfProximity(p)
+				assert.NoError(err)
+				assert.Equal(cfg, proof.Config)
+				assert.Len(proof.Queries, cfg.NbQueries)
+				assert.NoError(iop.VerifyProofOfProximity(proof))
+
+				// opening
+				s := iop.(configurableFri)
+				pos := uint64(blowup*size - 3)
+				openingProof, err := iop.Open(p, pos)
+				assert.NoError(err)
+				var x fr.Element
+				x.Exp(s.domain.Generator, new(big.Int).SetUint64(pos))
+				expected := eval(p, x)
+				assert.True(expected.Equal(&openingProof.ClaimedValue))
+				assert.NoError(iop.VerifyOpening(pos, openingProof, proof))
+				assert.Error(iop.VerifyOpening(pos-1, openingProof, proof))
+
+				// the verifier rejects proofs built with other parameters
+				other, err := RADIX_2_FRI.NewWithConfig(size, sha256.New(), Config{BlowupFactor: blowup, Arity: arity, NbQueries: cfg.NbQueries + 1})
+				assert.NoError(err)
+				assert.ErrorIs(other.VerifyProofOfProximity(proof), ErrConfigMismatch)
+				assert.ErrorIs(RADIX_2_FRI.New(size, sha256.New()).VerifyProofOfProximity(proof), ErrConfigMismatch)
+
+				// wrong nonce, wrong final polynomial, wrong Merkle root
+				tampered := proof
+				tampered.Nonce++
+				assert.Error(iop.VerifyProofOfProximity(tampered))
+				tampered = proof
+				tampered.FinalPolynomial = make([]fr.Element, len(proof.FinalPolynomial))
+				copy(tampered.FinalPolynomial, proof.FinalPolynomial)
+				tampered.FinalPolynomial[0].SetOne()
+				assert.Error(iop.VerifyProofOfProximity(tampered))
+				tampered = proof
+				tampered.Roots = append([][]byte{}, proof.Roots...)
+				tampered.Roots[len(tampered.Roots)-1] = proof.Roots[0]
+				assert.Error(iop.VerifyProofOfProximity(tampered))
+
+				// a polynomial of higher degree is rejected
+				if blowup > 2 {
+					q := make(fr.Vector, 4*size)
+					q.MustSetRandom()
+					proof, err = iop.BuildProofOfProximity(q)
+					assert.NoError(err)
+					assert.ErrorIs(iop.VerifyProofOfProximity(proof), ErrProximityTestFolding)
+				}
+			})
+		}
+	}
+}
+
+func TestGrinding(t *testing.T) {
+	assert := require.New(t)
+
+	h := sha256.New()
+	seed := []byte("seed")
+	for _, nbBits := range []int{1, 7, 8, 12} {
+		nonce := grind(h, seed, nbBits)
+		assert.True(checkGrinding(h, seed, nonce, nbBits))
+		for n := uint64(0); n < nonce; n++ {
+			assert.False(checkGrinding(h, seed, n, nbBits))
+		}
+	}
+}
+
 // Benchmarks
 
 func BenchmarkProximityVerification(b *testing.B) {
@@ -0,0 +1,78 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+func TestCheck(t *testing.T) {
+	assert := require.New(t)
+
+	assert.NoError(testSrs.Check())
+	assert.NoError(mpcGetSrs(t).Check())
+
+	clone := func() *SRS {
+		var srs SRS
+		srs.Pk.G1 = make([]bls12381.G1Affine, len(testSrs.Pk.G1))
+		copy(srs.Pk.G1, testSrs.Pk.G1)
+		srs.Vk = testSrs.Vk
+		return &srs
+	}
+
+	// points not on the curve, the first one is reported
+	srs := clone()
+	srs.Pk.G1[7].X.Double(&srs.Pk.G1[7].X)
+	srs.Pk.G1[200].X.Double(&srs.Pk.G1[200].X)
+	err := srs.Check()
+	assert.ErrorIs(err, ErrInvalidPoint)
+	assert.ErrorContains(err, "G1 point 7")
+
+	srs = clone()
+	srs.Vk.G2[1].X.Double(&srs.Vk.G2[1].X)
+	assert.ErrorIs(srs.Check(), ErrInvalidPoint)
+
+	// valid points that are not successive powers
+	srs = clone()
+	srs.Pk.G1[3], srs.Pk.G1[4] = srs.Pk.G1[4], srs.Pk.G1[3]
+	assert.ErrorIs(srs.Check(), ErrPairingConsistency)
+
+	srs = clone()
+	srs.Vk.G2[1] = srs.Vk.G2[0]
+	srs.Vk.Lines[1] = srs.Vk.Lines[0]
+	assert.ErrorIs(srs.Check(), ErrPairingConsistency)
+
+	// inconsistent verifying key
+	srs = clone()
+	srs.Vk.G1 = srs.Pk.G1[1]
+	assert.ErrorIs(srs.Check(), ErrInconsistentVerifyingKey)
+
+	srs = clone()
+	srs.Vk.Lines[1] = srs.Vk.Lines[0]
+	assert.ErrorIs(srs.Check(), ErrInconsistentVerifyingKey)
+
+	// all points at infinity
+	srs = clone()
+	for i := range srs.Pk.G1 {
+		srs.Pk.G1[i].SetInfinity()
+	}
+	srs.Vk.G1.SetInfinity()
+	assert.ErrorIs(srs.Check(), ErrInvalidPoint)
+
+	srs = clone()
+	srs.Pk.G1 = srs.Pk.G1[:1]
+	assert.ErrorIs(srs.Check(), ErrMinSRSSize)
+}
+
+func BenchmarkCheck(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = testSrs.Check()
+	}
+}
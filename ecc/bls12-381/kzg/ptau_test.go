@@ -0,0 +1,125 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+)
+
+func TestPtauRoundTrip(t *testing.T) {
+	assert := require.New(t)
+
+	var buf bytes.Buffer
+	assert.NoError(testSrs.WritePtau(&buf))
+
+	// whole SRS
+	srs, err := NewSRSFromPtau(bytes.NewReader(buf.Bytes()), uint64(len(testSrs.Pk.G1)), WithPairingCheck())
+	assert.NoError(err)
+	assert.Equal(testSrs.Pk.G1, srs.Pk.G1)
+	assert.Equal(testSrs.Vk, srs.Vk)
+
+	// truncated SRS
+	srs, err = NewSRSFromPtau(bytes.NewReader(buf.Bytes()), 17, WithPairingCheck())
+	assert.NoError(err)
+	assert.Equal(testSrs.Pk.G1[:17], srs.Pk.G1)
+	assert.Equal(testSrs.Vk, srs.Vk)
+
+	_, err = NewSRSFromPtau(bytes.NewReader(buf.Bytes()), uint64(len(testSrs.Pk.G1)+1))
+	assert.ErrorIs(err, ErrSRSTooSmall)
+	_, err = NewSRSFromPtau(bytes.NewReader(buf.Bytes()), 1)
+	assert.ErrorIs(err, ErrMinSRSSize)
+}
+
+func TestPtauInvalid(t *testing.T) {
+	assert := require.New(t)
+
+	var buf bytes.Buffer
+	assert.NoError(testSrs.WritePtau(&buf))
+	const (
+		headerOffset = 4 + 4 + 4 + 12 // magic, version, number of sections, section header
+		g1Offset     = headerOffset + 4 + fp.Bytes + 8 + 12
+	)
+	size := uint64(len(testSrs.Pk.G1))
+
+	// wrong magic
+	data := bytes.Clone(buf.Bytes())
+	data[0] = 'x'
+	_, err := NewSRSFromPtau(bytes.NewReader(data), size)
+	assert.ErrorIs(err, ErrInvalidPtau)
+
+	// wrong modulus
+	data = bytes.Clone(buf.Bytes())
+	data[headerOffset+4]++
+	_, err = NewSRSFromPtau(bytes.NewReader(data), size)
+	assert.ErrorIs(err, ErrPtauCurve)
+
+	// point not on the curve
+	data = bytes.Clone(buf.Bytes())
+	data[g1Offset+3*2*fp.Bytes+1]++
+	_, err = NewSRSFromPtau(bytes.NewReader(data), size)
+	assert.ErrorIs(err, ErrInvalidPoint)
+	assert.ErrorContains(err, "G1 point 3")
+
+	// valid points that are not successive powers are only detected by the pairing check
+	data = bytes.Clone(buf.Bytes())
+	p3 := data[g1Offset+3*2*fp.Bytes : g1Offset+4*2*fp.Bytes]
+	p4 := data[g1Offset+4*2*fp.Bytes : g1Offset+5*2*fp.Bytes]
+	tmp := bytes.Clone(p3)
+	copy(p3, p4)
+	copy(p4, tmp)
+	_, err = NewSRSFromPtau(bytes.NewReader(data), size)
+	assert.NoError(err)
+	_, err = NewSRSFromPtau(bytes.NewReader(data), size, WithPairingCheck())
+	assert.ErrorIs(err, ErrPairingConsistency)
+
+	// truncated file
+	_, err = NewSRSFromPtau(bytes.NewReader(buf.Bytes()[:buf.Len()-1]), size)
+	assert.Error(err)
+}
+
+func TestG2PowersFromPtau(t *testing.T) {
+	assert := require.New(t)
+
+	const (
+		polySize   = 32
+		domainSize = 64
+		cellSize   = 4
+	)
+	var buf bytes.Buffer
+	assert.NoError(writePtau(&buf, testSrs.Pk.G1, testG2Powers(2*cellSize)))
+
+	g2Powers, err := G2PowersFromPtau(bytes.NewReader(buf.Bytes()), cellSize+1)
+	assert.NoError(err)
+	assert.Equal(testG2Powers(cellSize+1), g2Powers)
+	_, err = G2PowersFromPtau(bytes.NewReader(buf.Bytes()), 2*cellSize+1)
+	assert.ErrorIs(err, ErrSRSTooSmall)
+	_, err = G2PowersFromPtau(bytes.NewReader(buf.Bytes()), 1)
+	assert.ErrorIs(err, ErrMinSRSSize)
+
+	// the SRS is read the same way with more G₂ powers in the file
+	srs, err := NewSRSFromPtau(bytes.NewReader(buf.Bytes()), uint64(len(testSrs.Pk.G1)), WithPairingCheck())
+	assert.NoError(err)
+	assert.Equal(testSrs.Vk, srs.Vk)
+
+	// cell proofs verify with the key built from the file
+	vk, err := NewCellVerifyingKey(srs.Pk, g2Powers, cellSize)
+	assert.NoError(err)
+	fk, err := NewFK20(srs.Pk, polySize, domainSize, cellSize)
+	assert.NoError(err)
+	f := randomPolynomial(polySize)
+	digest, err := Commit(f, srs.Pk)
+	assert.NoError(err)
+	proofs, err := fk.OpenCells(f)
+	assert.NoError(err)
+	for k := range proofs {
+		assert.NoError(VerifyCell(&digest, &proofs[k], fk.CellShift(k), vk))
+	}
+}
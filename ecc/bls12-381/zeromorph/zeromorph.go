@@ -0,0 +1,570 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package zeromorph
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+)
+
+var (
+	ErrInvalidPolynomialSize    = errors.New("polynomial size must be 2^len(point) and not larger than the SRS size")
+	ErrInvalidNumberOfDigests   = errors.New("number of digests should be equal to the number of polynomials")
+	ErrInvalidNumberOfQuotients = errors.New("number of quotient commitments should be equal to the number of variables")
+	ErrVerifyOpeningProof       = errors.New("can't verify opening proof")
+	ErrNotEnoughG2Powers        = errors.New("the number of G₂ powers should be at least the SRS size")
+)
+
+// VerifyingKey used to verify multilinear opening proofs.
+//
+// It extends the kzg.VerifyingKey with the powers of α in G₂ that bound the degree of the
+// batched quotient, where N is the size of the ProvingKey it was generated with.
+type VerifyingKey struct {
+	kzg.VerifyingKey
+	ShiftedG2 []bls12381.G2Affine // [α^(N-1)]G₂, [α^(N-2)]G₂, [α^(N-4)]G₂, ..., [α^(N-2ⁿ)]G₂ for 2ⁿ ≤ N
+}
+
+// SRS must be computed through MPC and comprises the kzg.ProvingKey and the VerifyingKey.
+//
+// implements io.ReaderFrom and io.WriterTo
+type SRS struct {
+	Pk kzg.ProvingKey
+	Vk VerifyingKey
+}
+
+// NewSRS returns a new SRS of size points using alpha as randomness source, see kzg.NewSRS.
+//
+// In production, a SRS generated through MPC should be used, with the VerifyingKey built by
+// NewVerifyingKey from the G₂ powers published by the same ceremony.
+func NewSRS(size uint64, bAlpha *big.Int) (*SRS, error) {
+	srs, err := kzg.NewSRS(size, bAlpha)
+	if err != nil {
+		return nil, err
+	}
+
+	var alpha fr.Element
+	if bAlpha.Cmp(big.NewInt(-1)) == 0 {
+		// kzg.NewSRS uses an element of order 4 as α in this case
+		if alpha, err = fr.Generator(4); err != nil {
+			return nil, err
+		}
+	} else {
+		alpha.SetBigInt(bAlpha)
+	}
+
+	var res SRS
+	res.Pk = srs.Pk
+	res.Vk.VerifyingKey = srs.Vk
+	res.Vk.ShiftedG2 = make([]bls12381.G2Affine, bits.Len64(size))
+	var e, bShift big.Int
+	var shift fr.Element
+	for n := range res.Vk.ShiftedG2 {
+		e.SetUint64(size - 1<<n)
+		shift.Exp(alpha, &e)
+		shift.BigInt(&bShift)
+		res.Vk.ShiftedG2[n].ScalarMultiplication(&srs.Vk.G2[0], &bShift)
+	}
+
+	return &res, nil
+}
+
+// NewVerifyingKey returns the VerifyingKey for a ProvingKey of size points, from the G₂ powers
+// [G₂, [α]G₂, ..., [α^(size-1)]G₂] published by the ceremony, for instance read from a ptau file
+// with kzg.G2PowersFromPtau on the curves that support it.
+//
+// The first two G₂ powers must be the ones of vk.
+func NewVerifyingKey(vk kzg.VerifyingKey, g2Powers []bls12381.G2Affine, size uint64) (VerifyingKey, error) {
+	if size < 2 {
+		return VerifyingKey{}, kzg.ErrMinSRSSize
+	}
+	if uint64(len(g2Powers)) < size {
+		return VerifyingKey{}, ErrNotEnoughG2Powers
+	}
+	if !g2Powers[0].Equal(&vk.G2[0]) || !g2Powers[1].Equal(&vk.G2[1]) {
+		return VerifyingKey{}, kzg.ErrInconsistentVerifyingKey
+	}
+
+	res := VerifyingKey{VerifyingKey: vk}
+	res.ShiftedG2 = make([]bls12381.G2Affine, bits.Len64(size))
+	for n := range res.ShiftedG2 {
+		res.ShiftedG2[n] = g2Powers[size-1<<n]
+	}
+	return res, nil
+}
+
+// OpeningProof proof that a multilinear polynomial f in n variables evaluates to ClaimedValue at u ∈ 𝔽ⁿ.
+//
+// f(X) - f(u) = ∑ₖ(Xₖ - uₖ)qₖ(X₀, ..., Xₖ₋₁), and the identity is checked on the univariate
+// polynomials ∑ᵢfᵢXⁱ and ∑ᵢqₖ,ᵢXⁱ with a single KZG opening.
+//
+// implements io.ReaderFrom and io.WriterTo
+type OpeningProof struct {
+	// QuotientCommitments[k] commitment to the multilinear quotient qₖ in k variables
+	QuotientCommitments []kzg.Digest
+
+	// BatchedQuotient commitment to ∑ₖyᵏX^(2ⁿ-2ᵏ)qₖ(X), binding the degrees of the qₖ
+	BatchedQuotient kzg.Digest
+
+	// DegreeProof commitment to X^(N-2ⁿ) times the batched quotient, showing that its degree
+	// is smaller than 2ⁿ, where N is the size of the SRS
+	DegreeProof kzg.Digest
+
+	// Proof KZG opening proof at x of the polynomial vanishing at x built from the commitments
+	Proof kzg.Digest
+
+	// ClaimedValue purported value f(u)
+	ClaimedValue fr.Element
+}
+
+// BatchOpeningProof proof that several multilinear polynomials in n variables evaluate
+// to ClaimedValues at the same point u ∈ 𝔽ⁿ.
+//
+// implements io.ReaderFrom and io.WriterTo
+type BatchOpeningProof struct {
+	// QuotientCommitments, BatchedQuotient, DegreeProof and Proof are the components of the
+	// OpeningProof of the folded polynomial ∑ᵢγⁱfᵢ
+	QuotientCommitments []kzg.Digest
+	BatchedQuotient     kzg.Digest
+	DegreeProof         kzg.Digest
+	Proof               kzg.Digest
+
+	// ClaimedValues purported values fᵢ(u)
+	ClaimedValues []fr.Element
+}
+
+// Commit commits to a multilinear polynomial given by its 2ⁿ evaluations on the boolean hypercube,
+// as the univariate polynomial ∑ᵢfᵢXⁱ.
+func Commit(p polynomial.MultiLin, pk kzg.ProvingKey, nbTasks ...int) (kzg.Digest, error) {
+	if len(p) == 0 || len(p)&(len(p)-1) != 0 || len(p) > len(pk.G1) {
+		return kzg.Digest{}, ErrInvalidPolynomialSize
+	}
+	return kzg.Commit(p, pk, nbTasks...)
+}
+
+// Open computes an opening proof of the multilinear polynomial p at point, with the same
+// variables order as p.Evaluate(point).
+//
+// digest is the commitment to p, and dataTranscript is some extra data that might be needed
+// for Fiat Shamir, appended to the transcript.
+func Open(p polynomial.MultiLin, digest kzg.Digest, point []fr.Element, hf hash.Hash, pk kzg.ProvingKey, dataTranscript ...[]byte) (OpeningProof, error) {
+	if err := checkSize(p, point, pk); err != nil {
+		return OpeningProof{}, err
+	}
+
+	fs := fiatshamir.NewTranscript(hf, "y", "x", "z")
+	if err := bindData(fs, "y", dataTranscript); err != nil {
+		return OpeningProof{}, err
+	}
+
+	return open(p, digest, point, fs, pk)
+}
+
+// Verify verifies a multilinear opening proof at point, with the commitment digest.
+func Verify(digest *kzg.Digest, proof *OpeningProof, point []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	fs := fiatshamir.NewTranscript(hf, "y", "x", "z")
+	if err := bindData(fs, "y", dataTranscript); err != nil {
+		return err
+	}
+
+	return verify(*digest, proof, point, fs, vk)
+}
+
+// BatchOpen computes a proof of the openings of several multilinear polynomials at the same point.
+//
+// The polynomials are folded as ∑ᵢγⁱfᵢ where γ is derived from the digests, the point and the
+// claimed values, and the folded polynomial is opened.
+func BatchOpen(polynomials []polynomial.MultiLin, digests []kzg.Digest, point []fr.Element, hf hash.Hash, pk kzg.ProvingKey, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	var res BatchOpeningProof
+
+	if len(polynomials) == 0 || len(polynomials) != len(digests) {
+		return res, ErrInvalidNumberOfDigests
+	}
+	for i := range polynomials {
+		if err := checkSize(polynomials[i], point, pk); err != nil {
+			return res, err
+		}
+	}
+
+	// claimed values
+	res.ClaimedValues = make([]fr.Element, len(polynomials))
+	for i := range polynomials {
+		res.ClaimedValues[i] = polynomials[i].Evaluate(point, nil)
+	}
+
+	// derive γ
+	fs := fiatshamir.NewTranscript(hf, "gamma", "y", "x", "z")
+	gamma, err := deriveGamma(fs, digests, point, res.ClaimedValues, dataTranscript)
+	if err != nil {
+		return res, err
+	}
+
+	// ∑ᵢγⁱfᵢ and its commitment
+	gammas := powers(gamma, len(polynomials))
+	folded := make(polynomial.MultiLin, len(polynomials[0]))
+	var t fr.Element
+	for i := range polynomials {
+		for j := range folded {
+			t.Mul(&polynomials[i][j], &gammas[i])
+			folded[j].Add(&folded[j], &t)
+		}
+	}
+	var foldedDigest kzg.Digest
+	if _, err := foldedDigest.MultiExp(digests, gammas, ecc.MultiExpConfig{}); err != nil {
+		return res, err
+	}
+
+	proof, err := open(folded, foldedDigest, point, fs, pk)
+	if err != nil {
+		return res, err
+	}
+	res.QuotientCommitments = proof.QuotientCommitments
+	res.BatchedQuotient = proof.BatchedQuotient
+	res.DegreeProof = proof.DegreeProof
+	res.Proof = proof.Proof
+
+	return res, nil
+}
+
+// BatchVerify verifies a batch opening proof of several multilinear polynomials at point,
+// with their commitments digests, in the same order as in BatchOpen.
+func BatchVerify(digests []kzg.Digest, proof *BatchOpeningProof, point []fr.Element, hf hash.Hash, vk VerifyingKey, dataTranscript ...[]byte) error {
+	if len(digests) == 0 || len(digests) != len(proof.ClaimedValues) {
+		return ErrInvalidNumberOfDigests
+	}
+
+	// derive γ
+	fs := fiatshamir.NewTranscript(hf, "gamma", "y", "x", "z")
+	gamma, err := deriveGamma(fs, digests, point, proof.ClaimedValues, dataTranscript)
+	if err != nil {
+		return err
+	}
+
+	// ∑ᵢγⁱ[fᵢ] and ∑ᵢγⁱfᵢ(u)
+	gammas := powers(gamma, len(digests))
+	var foldedDigest kzg.Digest
+	if _, err := foldedDigest.MultiExp(digests, gammas, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+	foldedProof := OpeningProof{
+		QuotientCommitments: proof.QuotientCommitments,
+		BatchedQuotient:     proof.BatchedQuotient,
+		DegreeProof:         proof.DegreeProof,
+		Proof:               proof.Proof,
+	}
+	var t fr.Element
+	for i := range proof.ClaimedValues {
+		t.Mul(&proof.ClaimedValues[i], &gammas[i])
+		foldedProof.ClaimedValue.Add(&foldedProof.ClaimedValue, &t)
+	}
+
+	return verify(foldedDigest, &foldedProof, point, fs, vk)
+}
+
+// open computes the opening proof of f at point, using the transcript fs
+// whose next challenges are y, x and z.
+func open(f polynomial.MultiLin, digest kzg.Digest, point []fr.Element, fs *fiatshamir.Transcript, pk kzg.ProvingKey) (OpeningProof, error) {
+	var res OpeningProof
+	var err error
+	n := len(point)
+	size := len(f)
+
+	// qₖ = f⁽ᵏ⁺¹⁾(X₀, ..., Xₖ₋₁, 1) - f⁽ᵏ⁺¹⁾(X₀, ..., Xₖ₋₁, 0) where f⁽ⁿ⁾ = f and
+	// f⁽ᵏ⁾ = f⁽ᵏ⁺¹⁾(X₀, ..., Xₖ₋₁, uₖ). The most significant bit of the index is the
+	// first variable folded by MultiLin, so uₖ = point[n-1-k].
+	quotients := make([]polynomial.MultiLin, n)
+	folded := f.Clone()
+	for k := n - 1; k >= 0; k-- {
+		half := 1 << k
+		quotients[k] = make(polynomial.MultiLin, half)
+		for i := 0; i < half; i++ {
+			quotients[k][i].Sub(&folded[i+half], &folded[i])
+		}
+		folded.Fold(point[n-1-k])
+	}
+	res.ClaimedValue = folded[0]
+
+	res.QuotientCommitments = make([]kzg.Digest, n)
+	for k := range quotients {
+		if res.QuotientCommitments[k], err = kzg.Commit(quotients[k], pk); err != nil {
+			return res, err
+		}
+	}
+
+	// derive y
+	y, err := deriveY(fs, digest, point, res.ClaimedValue, res.QuotientCommitments)
+	if err != nil {
+		return res, err
+	}
+
+	// q̂ = ∑ₖyᵏX^(2ⁿ-2ᵏ)qₖ
+	batched := make([]fr.Element, size)
+	ys := powers(y, n)
+	var t fr.Element
+	for k := range quotients {
+		offset := size - len(quotients[k])
+		for i := range quotients[k] {
+			t.Mul(&quotients[k][i], &ys[k])
+			batched[offset+i].Add(&batched[offset+i], &t)
+		}
+	}
+	if res.BatchedQuotient, err = kzg.Commit(batched, pk); err != nil {
+		return res, err
+	}
+
+	// [X^(N-2ⁿ)q̂] with the last 2ⁿ points of the SRS
+	shiftedPk := kzg.ProvingKey{G1: pk.G1[len(pk.G1)-size:]}
+	if res.DegreeProof, err = kzg.Commit(batched, shiftedPk); err != nil {
+		return res, err
+	}
+
+	// derive x, z
+	x, z, err := deriveXZ(fs, res.BatchedQuotient)
+	if err != nil {
+		return res, err
+	}
+
+	// g = q̂ + z(f - f(u)Φₙ(x)) - ∑ₖ sₖqₖ vanishes at x (see coefficients)
+	scalars, phi := coefficients(point, x, z, ys)
+	g := batched
+	for k := range quotients {
+		for i := range quotients[k] {
+			t.Mul(&quotients[k][i], &scalars[k])
+			g[i].Sub(&g[i], &t)
+		}
+	}
+	for i := range f {
+		t.Mul(&f[i], &z)
+		g[i].Add(&g[i], &t)
+	}
+	t.Mul(&res.ClaimedValue, &phi).Mul(&t, &z)
+	g[0].Sub(&g[0], &t)
+
+	// without variables, g is the zero constant and the proof is the point at infinity
+	if size == 1 {
+		return res, nil
+	}
+	proof, err := kzg.Open(g, x, pk)
+	if err != nil {
+		return res, err
+	}
+	res.Proof = proof.H
+
+	return res, nil
+}
+
+// verify verifies the opening proof of the polynomial committed to digest at point,
+// using the transcript fs whose next challenges are y, x and z.
+func verify(digest kzg.Digest, proof *OpeningProof, point []fr.Element, fs *fiatshamir.Transcript, vk VerifyingKey) error {
+	n := len(point)
+	if len(proof.QuotientCommitments) != n {
+		return ErrInvalidNumberOfQuotients
+	}
+	if n >= len(vk.ShiftedG2) {
+		return ErrInvalidPolynomialSize
+	}
+
+	// deg(q̂) < 2ⁿ: e([q̂], [α^(N-2ⁿ)]G₂) = e([X^(N-2ⁿ)q̂], G₂). Then y being drawn after the [qₖ],
+	// deg(qₖ) < 2ᵏ for all k.
+	var negDegreeProof kzg.Digest
+	negDegreeProof.Neg(&proof.DegreeProof)
+	ok, err := bls12381.PairingCheck(
+		[]bls12381.G1Affine{proof.BatchedQuotient, negDegreeProof},
+		[]bls12381.G2Affine{vk.ShiftedG2[n], vk.G2[0]},
+	)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVerifyOpeningProof
+	}
+
+	// derive y, x, z
+	y, err := deriveY(fs, digest, point, proof.ClaimedValue, proof.QuotientCommitments)
+	if err != nil {
+		return err
+	}
+	x, z, err := deriveXZ(fs, proof.BatchedQuotient)
+	if err != nil {
+		return err
+	}
+
+	// [g] = [q̂] + z[f] - zf(u)Φₙ(x)[1] - ∑ₖsₖ[qₖ]
+	scalars, phi := coefficients(point, x, z, powers(y, n))
+	points := make([]kzg.Digest, 0, n+3)
+	points = append(points, proof.BatchedQuotient, digest, vk.G1)
+	points = append(points, proof.QuotientCommitments...)
+	s := make([]fr.Element, 0, n+3)
+	var one, c fr.Element
+	one.SetOne()
+	c.Mul(&proof.ClaimedValue, &phi).Mul(&c, &z).Neg(&c)
+	s = append(s, one, z, c)
+	for k := range scalars {
+		scalars[k].Neg(&scalars[k])
+	}
+	s = append(s, scalars...)
+
+	var g kzg.Digest
+	if _, err := g.MultiExp(points, s, ecc.MultiExpConfig{}); err != nil {
+		return err
+	}
+
+	// g(x) = 0
+	if err := kzg.Verify(&g, &kzg.OpeningProof{H: proof.Proof}, x, vk.VerifyingKey); err != nil {
+		if errors.Is(err, kzg.ErrVerifyOpeningProof) {
+			return ErrVerifyOpeningProof
+		}
+		return err
+	}
+	return nil
+}
+
+// coefficients returns the scalars sₖ = yᵏx^(2ⁿ-2ᵏ) + z(x^(2ᵏ)Φₙ₋ₖ₋₁(x^(2ᵏ⁺¹)) - uₖΦₙ₋ₖ(x^(2ᵏ))),
+// and Φₙ(x), where Φₘ(a) = ∑_{i<2ᵐ}aⁱ = ∏_{i<m}(1+a^(2ⁱ)).
+//
+// Then ∑ᵢfᵢXⁱ - f(u)Φₙ(X) = ∑ₖ(X^(2ᵏ)Φₙ₋ₖ₋₁(X^(2ᵏ⁺¹)) - uₖΦₙ₋ₖ(X^(2ᵏ)))∑ᵢqₖ,ᵢXⁱ and
+// g = q̂ + z(∑ᵢfᵢXⁱ - f(u)Φₙ(x)) - ∑ₖsₖ∑ᵢqₖ,ᵢXⁱ vanishes at x.
+func coefficients(point []fr.Element, x, z fr.Element, ys []fr.Element) ([]fr.Element, fr.Element) {
+	n := len(point)
+
+	// x^(2ᵏ) for k ≤ n
+	xPow := make([]fr.Element, n+1)
+	xPow[0] = x
+	for k := 1; k <= n; k++ {
+		xPow[k].Square(&xPow[k-1])
+	}
+
+	// phi[k] = Φₙ₋ₖ(x^(2ᵏ)) = ∏_{k≤i<n}(1+x^(2ⁱ)) and shift[k] = x^(2ⁿ-2ᵏ) = ∏_{k≤i<n}x^(2ⁱ)
+	phi := make([]fr.Element, n+1)
+	shift := make([]fr.Element, n+1)
+	phi[n].SetOne()
+	shift[n].SetOne()
+	var one, t fr.Element
+	one.SetOne()
+	for k := n - 1; k >= 0; k-- {
+		t.Add(&xPow[k], &one)
+		phi[k].Mul(&phi[k+1], &t)
+		shift[k].Mul(&shift[k+1], &xPow[k])
+	}
+
+	scalars := make([]fr.Element, n)
+	for k := 0; k < n; k++ {
+		var c fr.Element
+		c.Mul(&xPow[k], &phi[k+1])
+		t.Mul(&point[n-1-k], &phi[k])
+		c.Sub(&c, &t).Mul(&c, &z)
+		t.Mul(&ys[k], &shift[k])
+		scalars[k].Add(&c, &t)
+	}
+
+	return scalars, phi[0]
+}
+
+// checkSize checks that the multilinear polynomial has 2^len(point) evaluations, within the SRS size
+func checkSize(p polynomial.MultiLin, point []fr.Element, pk kzg.ProvingKey) error {
+	if len(point) >= 63 || len(p) != 1<<len(point) || len(p) > len(pk.G1) {
+		return ErrInvalidPolynomialSize
+	}
+	return nil
+}
+
+// deriveGamma derives the folding challenge γ, bound to the digests, the point, the claimed values
+// and the extra data.
+func deriveGamma(fs *fiatshamir.Transcript, digests []kzg.Digest, point, claimedValues []fr.Element, dataTranscript [][]byte) (fr.Element, error) {
+	for i := range digests {
+		if err := fs.Bind("gamma", digests[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range point {
+		if err := fs.Bind("gamma", point[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	for i := range claimedValues {
+		if err := fs.Bind("gamma", claimedValues[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	if err := bindData(fs, "gamma", dataTranscript); err != nil {
+		return fr.Element{}, err
+	}
+	return computeChallenge(fs, "gamma")
+}
+
+// deriveY derives the challenge y, bound to the digest, the point, the claimed value
+// and the quotient commitments.
+func deriveY(fs *fiatshamir.Transcript, digest kzg.Digest, point []fr.Element, claimedValue fr.Element, quotients []kzg.Digest) (fr.Element, error) {
+	if err := fs.Bind("y", digest.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range point {
+		if err := fs.Bind("y", point[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	if err := fs.Bind("y", claimedValue.Marshal()); err != nil {
+		return fr.Element{}, err
+	}
+	for i := range quotients {
+		if err := fs.Bind("y", quotients[i].Marshal()); err != nil {
+			return fr.Element{}, err
+		}
+	}
+	return computeChallenge(fs, "y")
+}
+
+// deriveXZ derives the challenges x and z, bound to the batched quotient.
+func deriveXZ(fs *fiatshamir.Transcript, batchedQuotient kzg.Digest) (x, z fr.Element, err error) {
+	if err = fs.Bind("x", batchedQuotient.Marshal()); err != nil {
+		return
+	}
+	if x, err = computeChallenge(fs, "x"); err != nil {
+		return
+	}
+	z, err = computeChallenge(fs, "z")
+	return
+}
+
+func bindData(fs *fiatshamir.Transcript, name string, dataTranscript [][]byte) error {
+	for i := range dataTranscript {
+		if err := fs.Bind(name, dataTranscript[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func computeChallenge(fs *fiatshamir.Transcript, name string) (fr.Element, error) {
+	challengeByte, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return fr.Element{}, err
+	}
+	var challenge fr.Element
+	challenge.SetBytes(challengeByte)
+	return challenge, nil
+}
+
+// powers returns [1, a, a², ..., aⁿ⁻¹]
+func powers(a fr.Element, n int) []fr.Element {
+	res := make([]fr.Element, n)
+	if n == 0 {
+		return res
+	}
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &a)
+	}
+	return res
+}
@@ -0,0 +1,429 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package zeromorph
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr/polynomial"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	"github.com/consensys/gnark-crypto/utils/testutils"
+)
+
+// Test SRS re-used across tests of the multilinear commitment scheme, with the VerifyingKey
+// built from the G₂ powers as a ceremony would publish them
+var testSrs *SRS
+
+const srsSize = 64
+
+func init() {
+	srs, err := kzg.NewSRS(srsSize, new(big.Int).SetInt64(42))
+	if err != nil {
+		panic(err)
+	}
+	vk, err := NewVerifyingKey(srs.Vk, testG2Powers(srsSize, 42), srsSize)
+	if err != nil {
+		panic(err)
+	}
+	testSrs = &SRS{Pk: srs.Pk, Vk: vk}
+}
+
+// testG2Powers returns [G₂, [α]G₂, ..., [αⁿ⁻¹]G₂]
+func testG2Powers(n int, alpha int64) []bls12381.G2Affine {
+	_, _, _, g2 := bls12381.Generators()
+	scalars := make([]fr.Element, n)
+	var a fr.Element
+	a.SetInt64(alpha)
+	scalars[0].SetOne()
+	for i := 1; i < n; i++ {
+		scalars[i].Mul(&scalars[i-1], &a)
+	}
+	return bls12381.BatchScalarMultiplicationG2(&g2, scalars)
+}
+
+func randomMultiLin(nbVars int) polynomial.MultiLin {
+	res := make(polynomial.MultiLin, 1<<nbVars)
+	fr.Vector(res).MustSetRandom()
+	return res
+}
+
+func randomPoint(nbVars int) []fr.Element {
+	res := make([]fr.Element, nbVars)
+	fr.Vector(res).MustSetRandom()
+	return res
+}
+
+func TestOpen(t *testing.T) {
+	assert := require.New(t)
+
+	for nbVars := 0; nbVars <= 6; nbVars++ {
+		p := randomMultiLin(nbVars)
+		point := randomPoint(nbVars)
+
+		digest, err := Commit(p, testSrs.Pk)
+		assert.NoError(err)
+
+		proof, err := Open(p, digest, point, sha256.New(), testSrs.Pk, []byte("data"))
+		assert.NoError(err)
+		expected := p.Evaluate(point, nil)
+		assert.True(expected.Equal(&proof.ClaimedValue), "inconsistent claimed value")
+
+		assert.NoError(Verify(&digest, &proof, point, sha256.New(), testSrs.Vk, []byte("data")))
+
+		// wrong claimed value
+		var one fr.Element
+		one.SetOne()
+		wrongProof := proof
+		wrongProof.ClaimedValue.Add(&wrongProof.ClaimedValue, &one)
+		assert.ErrorIs(Verify(&digest, &wrongProof, point, sha256.New(), testSrs.Vk, []byte("data")), ErrVerifyOpeningProof)
+
+		if nbVars == 0 {
+			continue
+		}
+
+		// wrong transcript data
+		assert.ErrorIs(Verify(&digest, &proof, point, sha256.New(), testSrs.Vk), ErrVerifyOpeningProof)
+
+		// wrong point
+		wrongPoint := make([]fr.Element, nbVars)
+		copy(wrongPoint, point)
+		wrongPoint[0].Add(&wrongPoint[0], &one)
+		assert.ErrorIs(Verify(&digest, &proof, wrongPoint, sha256.New(), testSrs.Vk, []byte("data")), ErrVerifyOpeningProof)
+
+		// wrong quotient
+		wrongProof = proof
+		wrongProof.QuotientCommitments = make([]kzg.Digest, nbVars)
+		copy(wrongProof.QuotientCommitments, proof.QuotientCommitments)
+		wrongProof.QuotientCommitments[0].Add(&wrongProof.QuotientCommitments[0], &testSrs.Vk.G1)
+		assert.ErrorIs(Verify(&digest, &wrongProof, point, sha256.New(), testSrs.Vk, []byte("data")), ErrVerifyOpeningProof)
+
+		// wrong number of quotients
+		wrongProof.QuotientCommitments = wrongProof.QuotientCommitments[1:]
+		assert.ErrorIs(Verify(&digest, &wrongProof, point, sha256.New(), testSrs.Vk, []byte("data")), ErrInvalidNumberOfQuotients)
+	}
+}
+
+func TestNewVerifyingKey(t *testing.T) {
+	assert := require.New(t)
+
+	g2Powers := testG2Powers(srsSize, 42)
+	_, err := NewVerifyingKey(testSrs.Vk.VerifyingKey, g2Powers, 1)
+	assert.ErrorIs(err, kzg.ErrMinSRSSize)
+	_, err = NewVerifyingKey(testSrs.Vk.VerifyingKey, g2Powers[:srsSize-1], srsSize)
+	assert.ErrorIs(err, ErrNotEnoughG2Powers)
+	_, err = NewVerifyingKey(testSrs.Vk.VerifyingKey, testG2Powers(srsSize, 43), srsSize)
+	assert.ErrorIs(err, kzg.ErrInconsistentVerifyingKey)
+
+	// a smaller proving key only needs the first powers
+	vk, err := NewVerifyingKey(testSrs.Vk.VerifyingKey, g2Powers, 6)
+	assert.NoError(err)
+	assert.Equal([]bls12381.G2Affine{g2Powers[5], g2Powers[4], g2Powers[2]}, vk.ShiftedG2)
+
+	// same key as the one computed from α
+	srs, err := NewSRS(srsSize, new(big.Int).SetInt64(42))
+	assert.NoError(err)
+	assert.Equal(srs.Vk, testSrs.Vk)
+}
+
+func TestNewSRS(t *testing.T) {
+	assert := require.New(t)
+
+	// e([αⁱ]G₁, G₂) = e(G₁, [α^(N-2ⁿ)]G₂) with i = N-2ⁿ
+	size := len(testSrs.Pk.G1)
+	assert.Len(testSrs.Vk.ShiftedG2, 7)
+	for n := range testSrs.Vk.ShiftedG2 {
+		var negG1 bls12381.G1Affine
+		negG1.Neg(&testSrs.Vk.G1)
+		ok, err := bls12381.PairingCheck(
+			[]bls12381.G1Affine{testSrs.Pk.G1[size-1<<n], negG1},
+			[]bls12381.G2Affine{testSrs.Vk.G2[0], testSrs.Vk.ShiftedG2[n]},
+		)
+		assert.NoError(err)
+		assert.True(ok, "wrong shifted G₂ power for n=%d", n)
+	}
+}
+
+// TestVerifyDegreeBound opens f at u to f(u)+1, with quotients above the degree bounds
+// deg(qₖ) < 2ᵏ, which the SRS is large enough to commit to.
+func TestVerifyDegreeBound(t *testing.T) {
+	assert := require.New(t)
+
+	const nbVars = 2
+	f := randomMultiLin(nbVars)
+	point := randomPoint(nbVars)
+	digest, err := Commit(f, testSrs.Pk)
+	assert.NoError(err)
+	proof, err := Open(f, digest, point, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+	assert.NoError(Verify(&digest, &proof, point, sha256.New(), testSrs.Vk))
+
+	// honest quotients q₀ = f(u₀, 1) - f(u₀, 0) and q₁(X₀) = f(X₀, 1) - f(X₀, 0)
+	var one fr.Element
+	one.SetOne()
+	q1 := make([]fr.Element, 2)
+	q1[0].Sub(&f[2], &f[0])
+	q1[1].Sub(&f[3], &f[1])
+	folded := f.Clone()
+	folded.Fold(point[0])
+	q0 := make([]fr.Element, 1)
+	q0[0].Sub(&folded[1], &folded[0])
+
+	// f - f(u)Φ₂ = c₀q₀ + c₁q₁ with c₀ = X + X³ - point[1]Φ₂(X) and c₁ = X² - point[0](1 + X²).
+	// For a and b such that ac₀ + bc₁ = Φ₂, f - (f(u)+1)Φ₂ = c₀(q₀ - a) + c₁(q₁ - b).
+	var c0, c1 [5]fr.Element
+	c0[0].Neg(&point[1])
+	c0[1].Sub(&one, &point[1])
+	c0[2].Neg(&point[1])
+	c0[3].Sub(&one, &point[1])
+	c1[0].Neg(&point[0])
+	c1[2].Sub(&one, &point[0])
+	// columns a₀, a₁, b₀, b₁, b₂ of the system on the coefficients of X⁰, ..., X⁴
+	m := make([][]fr.Element, 5)
+	for i := range m {
+		m[i] = make([]fr.Element, 6)
+		for j := 0; j < 2 && j <= i; j++ {
+			m[i][j] = c0[i-j]
+		}
+		for j := 0; j < 3 && j <= i; j++ {
+			m[i][2+j] = c1[i-j]
+		}
+		if i < 4 {
+			m[i][5].SetOne()
+		}
+	}
+	ab := solve(m)
+	for j := range q0 {
+		q0[j].Sub(&q0[j], &ab[j])
+	}
+	q0 = append(q0, fr.Element{})
+	q0[1].Neg(&ab[1])
+	for j := range q1 {
+		q1[j].Sub(&q1[j], &ab[2+j])
+	}
+	q1 = append(q1, fr.Element{})
+	q1[2].Neg(&ab[4])
+	quotients := [][]fr.Element{q0, q1}
+
+	// the rest of the proof is computed as in open
+	var forged OpeningProof
+	forged.ClaimedValue.Add(&proof.ClaimedValue, &one)
+	forged.QuotientCommitments = make([]kzg.Digest, nbVars)
+	for k := range quotients {
+		forged.QuotientCommitments[k], err = kzg.Commit(quotients[k], testSrs.Pk)
+		assert.NoError(err)
+	}
+	fs := fiatshamir.NewTranscript(sha256.New(), "y", "x", "z")
+	y, err := deriveY(fs, digest, point, forged.ClaimedValue, forged.QuotientCommitments)
+	assert.NoError(err)
+	ys := powers(y, nbVars)
+	batched := make([]fr.Element, 5)
+	var t0 fr.Element
+	for k := range quotients {
+		offset := 4 - 1<<k
+		for i := range quotients[k] {
+			t0.Mul(&quotients[k][i], &ys[k])
+			batched[offset+i].Add(&batched[offset+i], &t0)
+		}
+	}
+	forged.BatchedQuotient, err = kzg.Commit(batched, testSrs.Pk)
+	assert.NoError(err)
+	x, z, err := deriveXZ(fs, forged.BatchedQuotient)
+	assert.NoError(err)
+	scalars, phi := coefficients(point, x, z, ys)
+	g := batched
+	for k := range quotients {
+		for i := range quotients[k] {
+			t0.Mul(&quotients[k][i], &scalars[k])
+			g[i].Sub(&g[i], &t0)
+		}
+	}
+	for i := range f {
+		t0.Mul(&f[i], &z)
+		g[i].Add(&g[i], &t0)
+	}
+	t0.Mul(&forged.ClaimedValue, &phi).Mul(&t0, &z)
+	g[0].Sub(&g[0], &t0)
+	gProof, err := kzg.Open(g, x, testSrs.Pk)
+	assert.NoError(err)
+	forged.Proof = gProof.H
+
+	// without the degree check, i.e. [α^(N-2ⁿ)]G₂ = G₂, the forged proof passes
+	forged.DegreeProof = forged.BatchedQuotient
+	unboundedVk := testSrs.Vk
+	unboundedVk.ShiftedG2 = make([]bls12381.G2Affine, len(testSrs.Vk.ShiftedG2))
+	copy(unboundedVk.ShiftedG2, testSrs.Vk.ShiftedG2)
+	unboundedVk.ShiftedG2[nbVars] = testSrs.Vk.G2[0]
+	assert.NoError(Verify(&digest, &forged, point, sha256.New(), unboundedVk))
+
+	// [X^(N-2ⁿ)q̂] would need the point [α^N]G₁, out of the SRS
+	assert.ErrorIs(Verify(&digest, &forged, point, sha256.New(), testSrs.Vk), ErrVerifyOpeningProof)
+	forged.DegreeProof, err = kzg.Commit(batched[:4], kzg.ProvingKey{G1: testSrs.Pk.G1[len(testSrs.Pk.G1)-4:]})
+	assert.NoError(err)
+	assert.ErrorIs(Verify(&digest, &forged, point, sha256.New(), testSrs.Vk), ErrVerifyOpeningProof)
+}
+
+// solve returns the solution of the square linear system whose augmented matrix is m,
+// by Gaussian elimination.
+func solve(m [][]fr.Element) []fr.Element {
+	n := len(m)
+	var t fr.Element
+	for i := 0; i < n; i++ {
+		pivot := i
+		for m[pivot][i].IsZero() {
+			pivot++
+		}
+		m[i], m[pivot] = m[pivot], m[i]
+		var inv fr.Element
+		inv.Inverse(&m[i][i])
+		for j := i; j <= n; j++ {
+			m[i][j].Mul(&m[i][j], &inv)
+		}
+		for r := 0; r < n; r++ {
+			if r == i || m[r][i].IsZero() {
+				continue
+			}
+			c := m[r][i]
+			for j := i; j <= n; j++ {
+				t.Mul(&c, &m[i][j])
+				m[r][j].Sub(&m[r][j], &t)
+			}
+		}
+	}
+	res := make([]fr.Element, n)
+	for i := range res {
+		res[i] = m[i][n]
+	}
+	return res
+}
+
+func TestOpenInvalidSize(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := Commit(make(polynomial.MultiLin, 3), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+	_, err = Commit(make(polynomial.MultiLin, 128), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+
+	p := randomMultiLin(3)
+	digest, err := Commit(p, testSrs.Pk)
+	assert.NoError(err)
+	_, err = Open(p, digest, randomPoint(2), sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+
+	_, err = BatchOpen([]polynomial.MultiLin{p}, nil, randomPoint(3), sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidNumberOfDigests)
+	_, err = BatchOpen([]polynomial.MultiLin{p, randomMultiLin(2)}, []kzg.Digest{digest, digest}, randomPoint(3), sha256.New(), testSrs.Pk)
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
+func TestBatchOpen(t *testing.T) {
+	assert := require.New(t)
+
+	const (
+		nbVars  = 5
+		nbPolys = 4
+	)
+	polys := make([]polynomial.MultiLin, nbPolys)
+	digests := make([]kzg.Digest, nbPolys)
+	for i := range polys {
+		polys[i] = randomMultiLin(nbVars)
+		var err error
+		digests[i], err = Commit(polys[i], testSrs.Pk)
+		assert.NoError(err)
+	}
+	point := randomPoint(nbVars)
+
+	proof, err := BatchOpen(polys, digests, point, sha256.New(), testSrs.Pk)
+	assert.NoError(err)
+	for i := range polys {
+		expected := polys[i].Evaluate(point, nil)
+		assert.True(expected.Equal(&proof.ClaimedValues[i]), "inconsistent claimed value")
+	}
+
+	assert.NoError(BatchVerify(digests, &proof, point, sha256.New(), testSrs.Vk))
+
+	// wrong claimed value
+	var one fr.Element
+	one.SetOne()
+	proof.ClaimedValues[2].Add(&proof.ClaimedValues[2], &one)
+	assert.ErrorIs(BatchVerify(digests, &proof, point, sha256.New(), testSrs.Vk), ErrVerifyOpeningProof)
+	proof.ClaimedValues[2].Sub(&proof.ClaimedValues[2], &one)
+
+	// swapped digests
+	digests[0], digests[1] = digests[1], digests[0]
+	assert.ErrorIs(BatchVerify(digests, &proof, point, sha256.New(), testSrs.Vk), ErrVerifyOpeningProof)
+
+	// wrong number of digests
+	assert.ErrorIs(BatchVerify(digests[1:], &proof, point, sha256.New(), testSrs.Vk), ErrInvalidNumberOfDigests)
+}
+
+func TestSerialization(t *testing.T) {
+	_, _, g, _ := bls12381.Generators()
+
+	var proof OpeningProof
+	proof.QuotientCommitments = make([]kzg.Digest, 5)
+	for i := range proof.QuotientCommitments {
+		proof.QuotientCommitments[i].Set(&g)
+	}
+	proof.BatchedQuotient.Set(&g)
+	proof.DegreeProof.Set(&g)
+	proof.Proof.Set(&g)
+	proof.ClaimedValue.MustSetRandom()
+	t.Run("opening proof round trip", testutils.SerializationRoundTrip(&proof))
+
+	var batchProof BatchOpeningProof
+	batchProof.QuotientCommitments = proof.QuotientCommitments
+	batchProof.BatchedQuotient.Set(&g)
+	batchProof.DegreeProof.Set(&g)
+	batchProof.Proof.Set(&g)
+	batchProof.ClaimedValues = make([]fr.Element, 3)
+	fr.Vector(batchProof.ClaimedValues).MustSetRandom()
+	t.Run("batch opening proof round trip", testutils.SerializationRoundTrip(&batchProof))
+
+	srs, err := NewSRS(16, big.NewInt(-1))
+	require.NoError(t, err)
+	t.Run("srs round trip", testutils.SerializationRoundTrip(srs))
+	t.Run("srs raw round trip", testutils.SerializationRoundTripRaw(srs))
+}
+
+func BenchmarkOpen(b *testing.B) {
+	const nbVars = 14
+	srs, err := NewSRS(1<<nbVars, big.NewInt(-1))
+	require.NoError(b, err)
+	p := randomMultiLin(nbVars)
+	point := randomPoint(nbVars)
+	digest, err := Commit(p, srs.Pk)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = Open(p, digest, point, sha256.New(), srs.Pk)
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	const nbVars = 14
+	srs, err := NewSRS(1<<nbVars, big.NewInt(-1))
+	require.NoError(b, err)
+	p := randomMultiLin(nbVars)
+	point := randomPoint(nbVars)
+	digest, err := Commit(p, srs.Pk)
+	require.NoError(b, err)
+	proof, err := Open(p, digest, point, sha256.New(), srs.Pk)
+	require.NoError(b, err)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Verify(&digest, &proof, point, sha256.New(), srs.Vk)
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr/fft"
+
+	"github.com/consensys/gnark-crypto/utils/testutils"
+)
+
+func TestNewSRSLagrange(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := NewSRSLagrange(testSrs, 24)
+	assert.ErrorIs(err, ErrInvalidLagrangeSRSSize)
+	_, err = NewSRSLagrange(testSrs, uint64(2*len(testSrs.Pk.G1)))
+	assert.ErrorIs(err, ErrInvalidLagrangeSRSSize)
+
+	const size = 32
+	srsLagrange, err := NewSRSLagrange(testSrs, size)
+	assert.NoError(err)
+	assert.Equal(size, len(srsLagrange.Pk.G1))
+	assert.Equal(testSrs.Vk, srsLagrange.Vk)
+
+	expected, err := ToLagrangeG1(testSrs.Pk.G1[:size])
+	assert.NoError(err)
+	assert.Equal(expected, srsLagrange.Pk.G1)
+}
+
+func TestOpenLagrange(t *testing.T) {
+	const size = 64
+
+	test := func(srs *SRS) func(*testing.T) {
+		return func(t *testing.T) {
+			assert := require.New(t)
+
+			srsLagrange, err := NewSRSLagrange(srs, size)
+			assert.NoError(err)
+
+			// random polynomial in Lagrange form, and its canonical form
+			evaluations := randomPolynomial(size)
+			coefficients := make([]fr.Element, size)
+			copy(coefficients, evaluations)
+			d := fft.NewDomain(uint64(size))
+			d.FFTInverse(coefficients, fft.DIF)
+			fft.BitReverse(coefficients)
+
+			digest, err := CommitLagrange(evaluations, srsLagrange.Pk)
+			assert.NoError(err)
+			digestCanonical, err := Commit(coefficients, srs.Pk)
+			assert.NoError(err)
+			assert.True(digest.Equal(&digestCanonical), "error CommitLagrange")
+
+			// points outside and inside the domain
+			points := make([]fr.Element, 4)
+			points[0].MustSetRandom()
+			points[1].SetOne()
+			points[2].Set(&d.Generator)
+			points[3].Exp(d.Generator, big.NewInt(size-1))
+
+			for i := range points {
+				proof, err := OpenLagrange(evaluations, points[i], srsLagrange.Pk)
+				assert.NoError(err)
+
+				// the proof is the same as in canonical form
+				expected, err := Open(coefficients, points[i], srs.Pk)
+				assert.NoError(err)
+				assert.True(expected.ClaimedValue.Equal(&proof.ClaimedValue), "inconsistent claimed value")
+				assert.True(expected.H.Equal(&proof.H), "inconsistent quotient")
+
+				assert.NoError(Verify(&digest, &proof, points[i], srsLagrange.Vk))
+
+				// verify wrong proof
+				proof.ClaimedValue.Double(&proof.ClaimedValue)
+				assert.Error(Verify(&digest, &proof, points[i], srsLagrange.Vk))
+			}
+
+			_, err = CommitLagrange(evaluations[:size-1], srsLagrange.Pk)
+			assert.ErrorIs(err, ErrInvalidNbEvaluations)
+			_, err = OpenLagrange(evaluations[:size-1], points[0], srsLagrange.Pk)
+			assert.ErrorIs(err, ErrInvalidNbEvaluations)
+		}
+	}
+	t.Run("unsafe", test(testSrs))
+	t.Run("mpcsetup", test(mpcGetSrs(t)))
+}
+
+func TestSerializationSRSLagrange(t *testing.T) {
+	assert := require.New(t)
+
+	srsLagrange, err := NewSRSLagrange(testSrs, 64)
+	assert.NoError(err)
+	t.Run("proving key round-trip", testutils.SerializationRoundTrip(&srsLagrange.Pk))
+	t.Run("proving key raw round-trip", testutils.SerializationRoundTripRaw(&srsLagrange.Pk))
+	t.Run("whole SRS round-trip", testutils.SerializationRoundTrip(srsLagrange))
+	t.Run("whole SRS raw round-trip", testutils.SerializationRoundTripRaw(srsLagrange))
+	t.Run("unsafe whole SRS round-trip", testutils.UnsafeBinaryMarshalerRoundTrip(srsLagrange))
+
+	// the Lagrange basis can't be truncated
+	var buf bytes.Buffer
+	assert.ErrorIs(srsLagrange.WriteDump(&buf, 32), ErrInvalidLagrangeSRSSize)
+	buf.Reset()
+	assert.NoError(srsLagrange.WriteDump(&buf))
+	var read SRSLagrange
+	assert.ErrorIs(read.ReadDump(bytes.NewReader(buf.Bytes()), 32), ErrInvalidLagrangeSRSSize)
+}
+
+func BenchmarkOpenLagrange(b *testing.B) {
+	const size = 1 << 14
+	srs, err := NewSRS(size, big.NewInt(-1))
+	require.NoError(b, err)
+	srsLagrange, err := NewSRSLagrange(srs, size)
+	require.NoError(b, err)
+	evaluations := randomPolynomial(size)
+	var point fr.Element
+	point.MustSetRandom()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = OpenLagrange(evaluations, point, srsLagrange.Pk)
+	}
+}
@@ -22,6 +22,7 @@ var (
 	ErrVerifyOpeningProof     = errors.New("can't verify batch opening proof")
 	ErrInvalidNumberOfDigests = errors.New("number of digests should be equal to the number of polynomials")
 	ErrPairingCheck           = errors.New("pairing product is not 1")
+	ErrInvalidNumberOfProofs  = errors.New("number of proofs should be equal to the number of digests and points lists, and nonzero")
 )
 
 // OpeningProof KZG proof for opening (fᵢ)_{i} at a different points (xᵢ)_{i}.
@@ -178,11 +179,97 @@ func BatchOpen(polynomials [][]fr.Element, digests []kzg.Digest, points [][]fr.E
 // of the original transcript.
 func BatchVerify(proof OpeningProof, digests []kzg.Digest, points [][]fr.Element, hf hash.Hash, vk kzg.VerifyingKey, dataTranscript ...[]byte) error {
 
+	f, err := foldedClaim(&proof, digests, points, hf, vk, dataTranscript...)
+	if err != nil {
+		return err
+	}
+
+	// check that e(F+zW',[1]_{2})=e(W',[x]_{2})
+	check, err := bls24315.PairingCheckFixedQ(
+		[]bls24315.G1Affine{f, proof.WPrime},
+		vk.Lines[:],
+	)
+	if err != nil {
+		return ErrPairingCheck
+	}
+
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+
+	return nil
+}
+
+// BatchVerifyProofs verifies a list of independent proofs with a single pairing check: proofs[i]
+// is a proof that digests[i] correctly open to proofs[i].ClaimedValues at points[i], as in BatchVerify.
+// dataTranscript is shared by all the proofs.
+//
+// The verification equations e(Fᵢ+zᵢW'ᵢ,[1]_{2})=e(W'ᵢ,[x]_{2}) are folded using random λᵢ:
+// e(∑ᵢλᵢ(Fᵢ+zᵢW'ᵢ),[1]_{2})=e(∑ᵢλᵢW'ᵢ,[x]_{2})
+func BatchVerifyProofs(proofs []OpeningProof, digests [][]kzg.Digest, points [][][]fr.Element, hf hash.Hash, vk kzg.VerifyingKey, dataTranscript ...[]byte) error {
+
+	if len(proofs) == 0 || len(proofs) != len(digests) || len(proofs) != len(points) {
+		return ErrInvalidNumberOfProofs
+	}
+	if len(proofs) == 1 {
+		return BatchVerify(proofs[0], digests[0], points[0], hf, vk, dataTranscript...)
+	}
+
+	// -(Fᵢ+zᵢW'ᵢ) and W'ᵢ
+	f := make([]bls24315.G1Affine, len(proofs))
+	wPrime := make([]bls24315.G1Affine, len(proofs))
+	for i := range proofs {
+		var err error
+		if f[i], err = foldedClaim(&proofs[i], digests[i], points[i], hf, vk, dataTranscript...); err != nil {
+			return err
+		}
+		wPrime[i] = proofs[i].WPrime
+	}
+
+	// sample random numbers λᵢ
+	lambda := make([]fr.Element, len(proofs))
+	lambda[0].SetOne()
+	for i := 1; i < len(lambda); i++ {
+		if _, err := lambda[i].SetRandom(); err != nil {
+			return err
+		}
+	}
+
+	// -∑ᵢλᵢ(Fᵢ+zᵢW'ᵢ) and ∑ᵢλᵢW'ᵢ
+	config := ecc.MultiExpConfig{}
+	var foldedF, foldedWPrime bls24315.G1Affine
+	if _, err := foldedF.MultiExp(f, lambda, config); err != nil {
+		return err
+	}
+	if _, err := foldedWPrime.MultiExp(wPrime, lambda, config); err != nil {
+		return err
+	}
+
+	check, err := bls24315.PairingCheckFixedQ(
+		[]bls24315.G1Affine{foldedF, foldedWPrime},
+		vk.Lines[:],
+	)
+	if err != nil {
+		return ErrPairingCheck
+	}
+
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+
+	return nil
+}
+
+// foldedClaim returns -(F+zW'), where F = ∑ᵢγⁱZ_{T\xᵢ}[Com]_{i}-[∑ᵢγⁱZ_{T\xᵢ}(z)fᵢ(z)]_{1}-Z_{T}(z)[W],
+// such that the proof is correct iff e(-(F+zW'),[1]_{2})e(W',[x]_{2})=1
+func foldedClaim(proof *OpeningProof, digests []kzg.Digest, points [][]fr.Element, hf hash.Hash, vk kzg.VerifyingKey, dataTranscript ...[]byte) (bls24315.G1Affine, error) {
+
+	var f kzg.Digest
 	if len(digests) != len(proof.ClaimedValues) {
-		return ErrInvalidNumberOfPoints
+		return f, ErrInvalidNumberOfPoints
 	}
 	if len(digests) != len(points) {
-		return ErrInvalidNumberOfPoints
+		return f, ErrInvalidNumberOfPoints
 	}
 
 	// transcript
@@ -191,18 +278,17 @@ func BatchVerify(proof OpeningProof, digests []kzg.Digest, points [][]fr.Element
 	// derive γ
 	gamma, err := deriveChallenge("gamma", points, digests, fs, dataTranscript...)
 	if err != nil {
-		return err
+		return f, err
 	}
 
 	// derive z
 	// TODO seems ok that z depend only on W, need to check that carefully
 	z, err := deriveChallenge("z", nil, []kzg.Digest{proof.W}, fs)
 	if err != nil {
-		return err
+		return f, err
 	}
 
-	// check that e(F + zW', [1]_{2})=e(W',[x]_{2})
-	// where F = ∑ᵢγⁱZ_{T\xᵢ}[Com]_{i}-[∑ᵢγⁱZ_{T\xᵢ}(z)fᵢ(z)]_{1}-Z_{T}(z)[W]
+	// F = ∑ᵢγⁱZ_{T\xᵢ}[Com]_{i}-[∑ᵢγⁱZ_{T\xᵢ}(z)fᵢ(z)]_{1}-Z_{T}(z)[W]
 	var sumGammaiZTminusSiRiz, tmp, accGamma fr.Element
 	nbInstances := len(points)
 	gammaiZTminusSiz := make([]fr.Element, nbInstances)
@@ -227,7 +313,7 @@ func BatchVerify(proof OpeningProof, digests []kzg.Digest, points [][]fr.Element
 	var sumGammaiZtMinusSiComi kzg.Digest
 	_, err = sumGammaiZtMinusSiComi.MultiExp(digests, gammaiZTminusSiz, config)
 	if err != nil {
-		return err
+		return f, err
 	}
 
 	var bufBigInt big.Int
@@ -246,7 +332,6 @@ func BatchVerify(proof OpeningProof, digests []kzg.Digest, points [][]fr.Element
 	ztW.ScalarMultiplication(&proof.W, &bufBigInt)
 
 	// F = ∑ᵢγⁱZ_{T\xᵢ}[Com]_{i} - [∑ᵢγⁱZ_{T\xᵢ}fᵢ(z)]_{1} - Z_{T}(z)[W]
-	var f kzg.Digest
 	f.Sub(&sumGammaiZtMinusSiComi, &sumGammaiZTminusSiRizCom).
 		Sub(&f, &ztW)
 
@@ -257,20 +342,7 @@ func BatchVerify(proof OpeningProof, digests []kzg.Digest, points [][]fr.Element
 	f.Add(&f, &zWPrime)
 	f.Neg(&f)
 
-	// check that e(F+zW',[1]_{2})=e(W',[x]_{2})
-	check, err := bls24315.PairingCheckFixedQ(
-		[]bls24315.G1Affine{f, proof.WPrime},
-		vk.Lines[:],
-	)
-	if err != nil {
-		return ErrPairingCheck
-	}
-
-	if !check {
-		return ErrVerifyOpeningProof
-	}
-
-	return nil
+	return f, nil
 }
 
 // deriveChallenge derives a challenge using Fiat Shamir to polynomials.
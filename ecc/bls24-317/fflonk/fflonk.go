@@ -148,6 +148,38 @@ func BatchOpen(p [][][]fr.Element, digests []kzg.Digest, points [][]fr.Element,
 // were folded) at the relevant powers of the points.
 func BatchVerify(proof OpeningProof, digests []kzg.Digest, points [][]fr.Element, hf hash.Hash, vk kzg.VerifyingKey, dataTranscript ...[]byte) error {
 
+	extendedPoints, err := checkFolding(&proof, points)
+	if err != nil {
+		return err
+	}
+	return shplonk.BatchVerify(proof.SOpeningProof, digests, extendedPoints, hf, vk, dataTranscript...)
+}
+
+// BatchVerifyProofs verifies a list of independent proofs, where proofs[i] is a proof that
+// digests[i] are correctly opened on the sets points[i], as in BatchVerify. The embedded shplonk
+// proofs are verified with a single pairing check, see shplonk.BatchVerifyProofs.
+// dataTranscript is shared by all the proofs.
+func BatchVerifyProofs(proofs []OpeningProof, digests [][]kzg.Digest, points [][][]fr.Element, hf hash.Hash, vk kzg.VerifyingKey, dataTranscript ...[]byte) error {
+
+	if len(proofs) != len(points) {
+		return shplonk.ErrInvalidNumberOfProofs
+	}
+	sProofs := make([]shplonk.OpeningProof, len(proofs))
+	extendedPoints := make([][][]fr.Element, len(proofs))
+	for i := range proofs {
+		var err error
+		if extendedPoints[i], err = checkFolding(&proofs[i], points[i]); err != nil {
+			return err
+		}
+		sProofs[i] = proofs[i].SOpeningProof
+	}
+	return shplonk.BatchVerifyProofs(sProofs, digests, extendedPoints, hf, vk, dataTranscript...)
+}
+
+// checkFolding checks the consistency between the claimed values of the embedded shplonk proof
+// and the outer claimed values, and returns the sets on which the shplonk proof opens the digests.
+func checkFolding(proof *OpeningProof, points [][]fr.Element) ([][]fr.Element, error) {
+
 	// step 0: consistency checks between the folded claimed values of shplonk and the claimed
 	// values at the powers of the Sᵢ
 	for i := 0; i < len(proof.ClaimedValues); i++ {
@@ -155,14 +187,14 @@ func BatchVerify(proof OpeningProof, digests []kzg.Digest, points [][]fr.Element
 		for j := 1; j < len(proof.ClaimedValues[i]); j++ {
 			// each set of opening must be of the same size (openings on powers of Si)
 			if sizeSi != len(proof.ClaimedValues[i][j]) {
-				return ErrNbPolynomialsNbPoints
+				return nil, ErrNbPolynomialsNbPoints
 			}
 		}
 		currNbPolynomials := len(proof.ClaimedValues[i])
 		sizeSi = sizeSi * currNbPolynomials
 		// |originalPolynomials_{i}|x|Sᵢ| == |foldedPolynomials|x|folded Sᵢ|
 		if sizeSi != len(proof.SOpeningProof.ClaimedValues[i]) {
-			return ErrInconsistentNumberFoldedPoints
+			return nil, ErrInconsistentNumberFoldedPoints
 		}
 	}
 
@@ -173,7 +205,7 @@ func BatchVerify(proof OpeningProof, digests []kzg.Digest, points [][]fr.Element
 		t := len(proof.ClaimedValues[i])
 		omega, err := getIthRootOne(t)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		sizeSi := len(proof.ClaimedValues[i][0])
 		polyClaimedValues := make([]fr.Element, t)
@@ -185,26 +217,25 @@ func BatchVerify(proof OpeningProof, digests []kzg.Digest, points [][]fr.Element
 			for l := 0; l < t; l++ {
 				curFoldedClaimedValue = eval(polyClaimedValues, omgeaiPoint)
 				if !curFoldedClaimedValue.Equal(&proof.SOpeningProof.ClaimedValues[i][j*t+l]) {
-					return ErrInonsistentFolding
+					return nil, ErrInonsistentFolding
 				}
 				omgeaiPoint.Mul(&omgeaiPoint, &omega)
 			}
 		}
 	}
 
-	// step 2: verify the embedded shplonk proof
+	// step 2: extend the sets of points to the t-th roots of their elements
 	extendedPoints := make([][]fr.Element, len(points))
 	var err error
 	for i := 0; i < len(points); i++ {
 		t := len(proof.ClaimedValues[i])
 		extendedPoints[i], err = extendSet(points[i], t)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	err = shplonk.BatchVerify(proof.SOpeningProof, digests, extendedPoints, hf, vk, dataTranscript...)
 
-	return err
+	return extendedPoints, nil
 }
 
 // utils
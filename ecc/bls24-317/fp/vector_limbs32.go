@@ -0,0 +1,72 @@
+//go:build !limbs64 && (386 || arm || mips || mipsle || wasm || (purego && limbs32))
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fp
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	addVecGeneric(*vector, a, b)
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	subVecGeneric(*vector, a, b)
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *Element) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	var bb, t [nbLimbs32]uint32
+	toLimbs32(&bb, b)
+	for i := 0; i < len(a); i++ {
+		toLimbs32(&t, &a[i])
+		mulLimbs32(&(*vector)[i], &t, &bb)
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res Element) {
+	sumVecGeneric(&res, *vector)
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res Element) {
+	v := *vector
+	if len(v) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	var a, b [nbLimbs32]uint32
+	var tmp Element
+	for i := 0; i < len(v); i++ {
+		toLimbs32(&a, &v[i])
+		toLimbs32(&b, &other[i])
+		mulLimbs32(&tmp, &a, &b)
+		res.Add(&res, &tmp)
+	}
+	return
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	var ta, tb [nbLimbs32]uint32
+	for i := 0; i < len(a); i++ {
+		toLimbs32(&ta, &a[i])
+		toLimbs32(&tb, &b[i])
+		mulLimbs32(&(*vector)[i], &ta, &tb)
+	}
+}
@@ -0,0 +1,600 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"errors"
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+)
+
+// MixedRadixDomain is a subgroup of 𝔽ᵣˣ of cardinality n = 2ᵃ·3ᵇ·5ᶜ.
+//
+// Unlike Domain, the cardinality is not rounded up to a power of 2, which saves up to half
+// of the memory for sizes such as 2ᵃ·3. The FFT starts (decimation in frequency) with the
+// radix 3 stages, then the radix 5 stages, then computes a radix 2 FFT as in Domain on each
+// contiguous block of size 2ᵃ.
+//
+// The bit-reversal permutation generalises to the digit-reversal permutation: with
+// Radices = (r₀, …, rₛ₋₁) and mₖ = n / (r₀⋯rₖ), the position i = Σ tₖ·mₖ (0 ⩽ tₖ < rₖ)
+// holds the entry of index Σ tₖ·(r₀⋯rₖ₋₁). When n is a power of 2, it is the bit reversal.
+type MixedRadixDomain struct {
+	Cardinality            uint64
+	CardinalityInv         fr.Element
+	Generator              fr.Element
+	GeneratorInv           fr.Element
+	FrMultiplicativeGen    fr.Element // generator of Fr*
+	FrMultiplicativeGenInv fr.Element
+
+	// Radices of the FFT stages, in the order of the decimation in frequency
+	Radices []uint64
+
+	// number of radix 2 stages, the last ones
+	nbRadix2Stages int
+
+	// oddReversed[i] is the digit reversal of i < 3ᵇ·5ᶜ for the radix 3 and 5 stages
+	oddReversed []uint64
+
+	// see Domain
+	withPrecompute bool
+
+	// twiddles factor for the FFT using Generator for each stage (see Twiddles)
+	twiddles [][]fr.Element
+
+	// twiddles factor for the FFT using GeneratorInv for each stage
+	twiddlesInv [][]fr.Element
+
+	// cosetTable <1, u, u², ..., uⁿ⁻¹> where u is the shifting element
+	cosetTable []fr.Element
+
+	// cosetTableInv same as cosetTable but with u⁻¹
+	cosetTableInv []fr.Element
+}
+
+// NewMixedRadixDomain returns a subgroup of cardinality n = 2ᵃ·3ᵇ·5ᶜ ⩾ m, the smallest
+// such that n divides r - 1.
+// shift: when specified, it's the element by which the set of root of unity is shifted.
+func NewMixedRadixDomain(m uint64, opts ...DomainOption) *MixedRadixDomain {
+	opt := domainOptions(opts...)
+	n, radices, err := mixedRadixCardinality(m)
+	if err != nil {
+		panic(err)
+	}
+	domain := &MixedRadixDomain{
+		Cardinality: n,
+		Radices:     radices,
+	}
+	domain.FrMultiplicativeGen = GeneratorFullMultiplicativeGroup()
+	if opt.shift != nil {
+		domain.FrMultiplicativeGen.Set(opt.shift)
+	}
+	domain.FrMultiplicativeGenInv.Inverse(&domain.FrMultiplicativeGen)
+
+	domain.Generator, err = mixedRadixGenerator(n)
+	if err != nil {
+		panic(err)
+	}
+	domain.GeneratorInv.Inverse(&domain.Generator)
+	domain.CardinalityInv.SetUint64(n).Inverse(&domain.CardinalityInv)
+
+	for _, r := range radices {
+		if r == 2 {
+			domain.nbRadix2Stages++
+		}
+	}
+	domain.buildOddReversed()
+
+	// twiddle factors
+	domain.withPrecompute = opt.withPrecompute
+	if domain.withPrecompute {
+		domain.preComputeTwiddles()
+	}
+
+	return domain
+}
+
+// mixedRadixCardinality returns the smallest n = 2ᵃ·3ᵇ·5ᶜ ⩾ m dividing r - 1,
+// and the corresponding radices: b 3s, c 5s then a 2s.
+func mixedRadixCardinality(m uint64) (uint64, []uint64, error) {
+	m = max(m, 1)
+
+	// valuations of r - 1 at 2, 3 and 5
+	primes := [3]uint64{2, 3, 5}
+	var valuations [3]int
+	q := fr.Modulus()
+	q.Sub(q, big.NewInt(1))
+	for i, p := range primes {
+		bp := new(big.Int).SetUint64(p)
+		var quo, rem big.Int
+		for {
+			quo.QuoRem(q, bp, &rem)
+			if rem.Sign() != 0 {
+				break
+			}
+			q.Set(&quo)
+			valuations[i]++
+		}
+	}
+	// the cardinality fits in a uint64
+	valuations[0] = min(valuations[0], 63)
+
+	var n uint64
+	var a, b, c int
+	for j, p5 := 0, uint64(1); j <= valuations[2]; j, p5 = j+1, p5*5 {
+		for i, p3 := 0, uint64(1); i <= valuations[1]; i, p3 = i+1, p3*3 {
+			overflow, odd := bits.Mul64(p5, p3)
+			if overflow != 0 || odd > 1<<63 {
+				break
+			}
+			// smallest power of 2 such that 2ᵏ·odd ⩾ m
+			k := 0
+			if odd < m {
+				k = bits.Len64((m - 1) / odd)
+			}
+			if k > valuations[0] || bits.Len64(odd)+k > 64 {
+				continue
+			}
+			if nk := odd << k; n == 0 || nk < n {
+				n, a, b, c = nk, k, i, j
+			}
+		}
+		if p5 > (1<<63)/5 {
+			break
+		}
+	}
+	if n == 0 {
+		return 0, nil, errors.New("mixed radix domain: m is too big")
+	}
+
+	radices := make([]uint64, 0, a+b+c)
+	for i := 0; i < b; i++ {
+		radices = append(radices, 3)
+	}
+	for i := 0; i < c; i++ {
+		radices = append(radices, 5)
+	}
+	for i := 0; i < a; i++ {
+		radices = append(radices, 2)
+	}
+	return n, radices, nil
+}
+
+// mixedRadixGenerator returns a primitive n-th root of unity, n must be of the form 2ᵃ·3ᵇ·5ᶜ
+// and divide r - 1.
+func mixedRadixGenerator(n uint64) (fr.Element, error) {
+	var w fr.Element
+	q := fr.Modulus()
+	q.Sub(q, big.NewInt(1))
+	var e, rem big.Int
+	e.QuoRem(q, new(big.Int).SetUint64(n), &rem)
+	if rem.Sign() != 0 {
+		return w, errors.New("mixed radix domain: n does not divide r - 1")
+	}
+	w = GeneratorFullMultiplicativeGroup()
+	w.Exp(w, &e)
+
+	// w has order n iff w^(n/p) ≠ 1 for the primes p dividing n
+	var t fr.Element
+	for _, p := range []uint64{2, 3, 5} {
+		if n%p != 0 {
+			continue
+		}
+		t.Exp(w, new(big.Int).SetUint64(n/p))
+		if t.IsOne() {
+			return w, errors.New("mixed radix domain: no primitive root of unity of order n")
+		}
+	}
+	return w, nil
+}
+
+func (d *MixedRadixDomain) buildOddReversed() {
+	oddRadices := d.Radices[:len(d.Radices)-d.nbRadix2Stages]
+	size := d.Cardinality >> d.nbRadix2Stages
+	d.oddReversed = make([]uint64, size)
+	for i := range d.oddReversed {
+		// i = Σ tₖ·mₖ ↦ Σ tₖ·(r₀⋯rₖ₋₁)
+		j, m, w := uint64(i), size, uint64(1)
+		for _, r := range oddRadices {
+			m /= r
+			d.oddReversed[i] += (j / m) * w
+			j %= m
+			w *= r
+		}
+	}
+}
+
+// digitReversed returns the digit reversal of i (see MixedRadixDomain)
+func (d *MixedRadixDomain) digitReversed(i uint64) uint64 {
+	lo := i & (1<<d.nbRadix2Stages - 1)
+	if d.nbRadix2Stages != 0 {
+		lo = bits.Reverse64(lo) >> (64 - d.nbRadix2Stages)
+	}
+	return d.oddReversed[i>>d.nbRadix2Stages] + uint64(len(d.oddReversed))*lo
+}
+
+// DigitReverse applies the digit-reversal permutation to v (see MixedRadixDomain):
+// on output, v[i] is the input v[j] where j is the digit reversal of i.
+// It puts a vector in natural order in the order expected by the FFT with decimation in time.
+//
+// len(v) must be the cardinality of the domain.
+func (d *MixedRadixDomain) DigitReverse(v []fr.Element) {
+	d.checkSize(v)
+	if d.nbRadix2Stages == len(d.Radices) {
+		BitReverse(v)
+		return
+	}
+	visited := make([]uint64, (len(v)+63)/64)
+	for s := range v {
+		if visited[s/64]&(1<<(s%64)) != 0 {
+			continue
+		}
+		// follow the cycle of s
+		tmp := v[s]
+		i := uint64(s)
+		for {
+			visited[i/64] |= 1 << (i % 64)
+			j := d.digitReversed(i)
+			if j == uint64(s) {
+				v[i] = tmp
+				break
+			}
+			v[i] = v[j]
+			i = j
+		}
+	}
+}
+
+// DigitReverseInverse is the inverse of DigitReverse: on output, v[j] is the input v[i]
+// where j is the digit reversal of i.
+// It puts the output of the FFT with decimation in frequency in natural order.
+// DigitReverse and DigitReverseInverse are equal when all the radices are equal.
+//
+// len(v) must be the cardinality of the domain.
+func (d *MixedRadixDomain) DigitReverseInverse(v []fr.Element) {
+	d.checkSize(v)
+	if d.nbRadix2Stages == len(d.Radices) {
+		BitReverse(v)
+		return
+	}
+	visited := make([]uint64, (len(v)+63)/64)
+	for s := range v {
+		if visited[s/64]&(1<<(s%64)) != 0 {
+			continue
+		}
+		// follow the cycle of s
+		cur := v[s]
+		i := uint64(s)
+		for {
+			visited[i/64] |= 1 << (i % 64)
+			j := d.digitReversed(i)
+			v[j], cur = cur, v[j]
+			if j == uint64(s) {
+				break
+			}
+			i = j
+		}
+	}
+}
+
+func (d *MixedRadixDomain) checkSize(v []fr.Element) {
+	if uint64(len(v)) != d.Cardinality {
+		panic("len(v) must be the cardinality of the domain")
+	}
+}
+
+// Twiddles returns the twiddles factor for the FFT using Generator for each stage,
+// or an error if the domain was created with the WithoutPrecompute option.
+//
+// For a radix 2 stage, they are the ones of Domain. For the k-th stage of radix p = 3 or 5
+// operating on blocks of size L, Twiddles()[k][j] = w^j for j < L/p, where w = Generator^(n/L).
+func (d *MixedRadixDomain) Twiddles() ([][]fr.Element, error) {
+	if d.twiddles == nil {
+		return nil, errors.New("twiddles not precomputed")
+	}
+	return d.twiddles, nil
+}
+
+// TwiddlesInv returns the twiddles factor for the FFT using GeneratorInv for each stage,
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *MixedRadixDomain) TwiddlesInv() ([][]fr.Element, error) {
+	if d.twiddlesInv == nil {
+		return nil, errors.New("twiddles not precomputed")
+	}
+	return d.twiddlesInv, nil
+}
+
+// CosetTable returns the cosetTable u*<1,g,..,g^(n-1)>
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *MixedRadixDomain) CosetTable() ([]fr.Element, error) {
+	if d.cosetTable == nil {
+		return nil, errors.New("cosetTable not precomputed")
+	}
+	return d.cosetTable, nil
+}
+
+// CosetTableInv returns the cosetTableInv u*<1,g,..,g^(n-1)>
+// or an error if the domain was created with the WithoutPrecompute option
+func (d *MixedRadixDomain) CosetTableInv() ([]fr.Element, error) {
+	if d.cosetTableInv == nil {
+		return nil, errors.New("cosetTableInv not precomputed")
+	}
+	return d.cosetTableInv, nil
+}
+
+func (d *MixedRadixDomain) preComputeTwiddles() {
+	d.cosetTable = make([]fr.Element, d.Cardinality)
+	d.cosetTableInv = make([]fr.Element, d.Cardinality)
+
+	var wg sync.WaitGroup
+
+	expTable := func(x fr.Element, t []fr.Element) {
+		BuildExpTable(x, t)
+		wg.Done()
+	}
+
+	wg.Add(4)
+	go func() {
+		d.twiddles = d.buildTwiddles(d.Generator)
+		wg.Done()
+	}()
+	go func() {
+		d.twiddlesInv = d.buildTwiddles(d.GeneratorInv)
+		wg.Done()
+	}()
+	go expTable(d.FrMultiplicativeGen, d.cosetTable)
+	go expTable(d.FrMultiplicativeGenInv, d.cosetTableInv)
+
+	wg.Wait()
+}
+
+// buildTwiddles returns the twiddles of each stage for the FFT using the n-th root of unity omega
+func (d *MixedRadixDomain) buildTwiddles(omega fr.Element) [][]fr.Element {
+	nbOddStages := len(d.Radices) - d.nbRadix2Stages
+	t := make([][]fr.Element, len(d.Radices))
+
+	if nbOddStages > 0 {
+		// we just compute the first stage; the radices are sorted, so that the twiddles
+		// of the next stages are in the first one, with a larger stride
+		t[0] = make([]fr.Element, d.Cardinality/d.Radices[0])
+		BuildExpTable(omega, t[0])
+		L := d.Cardinality / d.Radices[0]
+		for k := 1; k < nbOddStages; k++ {
+			stride := d.Cardinality / L
+			t[k] = make([]fr.Element, L/d.Radices[k])
+			for j := range t[k] {
+				t[k][j] = t[0][uint64(j)*stride]
+			}
+			L /= d.Radices[k]
+		}
+	}
+
+	// the radix 2 stages use the root of unity of order 2ᵃ
+	var w fr.Element
+	w.Exp(omega, new(big.Int).SetUint64(d.Cardinality>>d.nbRadix2Stages))
+	buildTwiddles(t[nbOddStages:], w, uint64(d.nbRadix2Stages))
+
+	return t
+}
+
+// FFT computes the discrete Fourier transform of a and stores the result in a.
+// if decimation == DIT (decimation in time), the input must be in digit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in digit-reversed order
+// len(a) must be the cardinality of the domain.
+func (d *MixedRadixDomain) FFT(a []fr.Element, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts)
+	d.checkSize(a)
+
+	// if coset != 0, scale by coset table
+	if opt.coset {
+		cosetTable := d.cosetTable
+		if !d.withPrecompute {
+			cosetTable = make([]fr.Element, len(a))
+			BuildExpTable(d.FrMultiplicativeGen, cosetTable)
+		}
+		// with DIT, the coset table is accessed in digit reversed order
+		d.scale(a, cosetTable, nil, decimation == DIT, opt.nbTasks)
+	}
+
+	twiddles := d.twiddles
+	if !d.withPrecompute {
+		twiddles = d.buildTwiddles(d.Generator)
+	}
+	d.fft(a, d.Generator, twiddles, decimation, opt.nbTasks)
+}
+
+// FFTInverse computes the inverse discrete Fourier transform of a and stores the result in a.
+// if decimation == DIT (decimation in time), the input must be in digit-reversed order
+// if decimation == DIF (decimation in frequency), the output will be in digit-reversed order
+// len(a) must be the cardinality of the domain.
+func (d *MixedRadixDomain) FFTInverse(a []fr.Element, decimation Decimation, opts ...Option) {
+	opt := fftOptions(opts)
+	d.checkSize(a)
+
+	twiddlesInv := d.twiddlesInv
+	if !d.withPrecompute {
+		twiddlesInv = d.buildTwiddles(d.GeneratorInv)
+	}
+	d.fft(a, d.GeneratorInv, twiddlesInv, decimation, opt.nbTasks)
+
+	// scale by CardinalityInv
+	if !opt.coset {
+		parallel.Execute(len(a), func(start, end int) {
+			for i := start; i < end; i++ {
+				a[i].Mul(&a[i], &d.CardinalityInv)
+			}
+		}, opt.nbTasks)
+		return
+	}
+
+	cosetTableInv := d.cosetTableInv
+	if !d.withPrecompute {
+		cosetTableInv = make([]fr.Element, len(a))
+		BuildExpTable(d.FrMultiplicativeGenInv, cosetTableInv)
+	}
+	// with DIF, the coset table is accessed in digit reversed order
+	d.scale(a, cosetTableInv, &d.CardinalityInv, decimation == DIF, opt.nbTasks)
+}
+
+// scale sets a[i] *= table[i] (or table[digit reversal of i]), times factor if it is not nil
+func (d *MixedRadixDomain) scale(a, table []fr.Element, factor *fr.Element, digitReversed bool, nbTasks int) {
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			j := uint64(i)
+			if digitReversed {
+				j = d.digitReversed(j)
+			}
+			a[i].Mul(&a[i], &table[j])
+			if factor != nil {
+				a[i].Mul(&a[i], factor)
+			}
+		}
+	}, nbTasks)
+}
+
+func (d *MixedRadixDomain) fft(a []fr.Element, omega fr.Element, twiddles [][]fr.Element, decimation Decimation, nbTasks int) {
+	nbOddStages := len(d.Radices) - d.nbRadix2Stages
+	switch decimation {
+	case DIF:
+		for k := 0; k < nbOddStages; k++ {
+			d.oddStage(a, omega, twiddles[k], k, decimation, nbTasks)
+		}
+		d.radix2Stages(a, omega, twiddles[nbOddStages:], decimation, nbTasks)
+	case DIT:
+		d.radix2Stages(a, omega, twiddles[nbOddStages:], decimation, nbTasks)
+		for k := nbOddStages - 1; k >= 0; k-- {
+			d.oddStage(a, omega, twiddles[k], k, decimation, nbTasks)
+		}
+	default:
+		panic("not implemented")
+	}
+}
+
+// oddStage applies the k-th stage, of radix p = 3 or 5, to a.
+//
+// The stage operates on blocks of size L; for j < m = L/p, the entries xₜ at j + t·m of a block
+// are mapped (DIF) to yₜ = wᵗʲ·Σ ωᵖᵗⁱ·xᵢ where w has order L and ωₚ = w^m has order p.
+// The decimation in time is the transpose: the twiddles are applied first.
+func (d *MixedRadixDomain) oddStage(a []fr.Element, omega fr.Element, twiddles []fr.Element, k int, decimation Decimation, nbTasks int) {
+	p := d.Radices[k]
+	L := d.Cardinality
+	for _, r := range d.Radices[:k] {
+		L /= r
+	}
+	m := L / p
+
+	// roots[i] = ωₚⁱ
+	var roots [5]fr.Element
+	roots[0].SetOne()
+	roots[1].Exp(omega, new(big.Int).SetUint64(d.Cardinality/p))
+	for i := uint64(2); i < p; i++ {
+		roots[i].Mul(&roots[i-1], &roots[1])
+	}
+
+	parallel.Execute(int(d.Cardinality/p), func(start, end int) {
+		var x, tw [5]fr.Element
+		for b := start; b < end; b++ {
+			j := uint64(b) % m
+			off := (uint64(b)/m)*L + j
+			for t := uint64(0); t < p; t++ {
+				x[t] = a[off+t*m]
+			}
+			tw[1] = twiddles[j]
+			for t := uint64(2); t < p; t++ {
+				tw[t].Mul(&tw[t-1], &tw[1])
+			}
+
+			if decimation == DIT {
+				for t := uint64(1); t < p; t++ {
+					x[t].Mul(&x[t], &tw[t])
+				}
+			}
+			if p == 3 {
+				dft3(&x[0], &x[1], &x[2], &roots[1])
+			} else {
+				dft5(&x, &roots)
+			}
+			if decimation == DIF {
+				for t := uint64(1); t < p; t++ {
+					x[t].Mul(&x[t], &tw[t])
+				}
+			}
+
+			for t := uint64(0); t < p; t++ {
+				a[off+t*m] = x[t]
+			}
+		}
+	}, nbTasks)
+}
+
+// radix2Stages applies the radix 2 FFT to each contiguous block of size 2ᵃ of a
+func (d *MixedRadixDomain) radix2Stages(a []fr.Element, omega fr.Element, twiddles [][]fr.Element, decimation Decimation, nbTasks int) {
+	if d.nbRadix2Stages == 0 {
+		return
+	}
+	fft := difFFT
+	if decimation == DIT {
+		fft = ditFFT
+	}
+	blockSize := 1 << d.nbRadix2Stages
+	nbBlocks := len(a) / blockSize
+
+	// root of unity of order 2ᵃ
+	var w fr.Element
+	w.Exp(omega, big.NewInt(int64(nbBlocks)))
+
+	if nbBlocks >= nbTasks {
+		parallel.Execute(nbBlocks, func(start, end int) {
+			for b := start; b < end; b++ {
+				fft(a[b*blockSize:(b+1)*blockSize], w, twiddles, 0, 0, -1, nil, 1)
+			}
+		}, nbTasks)
+		return
+	}
+
+	// few blocks, we parallelize the FFTs instead
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(uint64(nbTasks)))
+	for b := 0; b < nbBlocks; b++ {
+		fft(a[b*blockSize:(b+1)*blockSize], w, twiddles, 0, 0, maxSplits, nil, nbTasks)
+	}
+}
+
+// dft3 computes in place the DFT of size 3 of (x₀, x₁, x₂), w is a primitive cube root of unity:
+//
+//	y₀ = x₀ + x₁ + x₂
+//	y₁ = x₀ + w·x₁ + w²·x₂ = (x₀ - x₂) + w·(x₁ - x₂)
+//	y₂ = x₀ + w²·x₁ + w·x₂ = (x₀ - x₁) - w·(x₁ - x₂)
+//
+// since 1 + w + w² = 0.
+func dft3(x0, x1, x2, w *fr.Element) {
+	var t, y1, y2 fr.Element
+	t.Sub(x1, x2).Mul(&t, w)
+	y1.Sub(x0, x2).Add(&y1, &t)
+	y2.Sub(x0, x1).Sub(&y2, &t)
+	x0.Add(x0, x1).Add(x0, x2)
+	*x1 = y1
+	*x2 = y2
+}
+
+// dft5 computes in place the DFT of size 5 of x: yₜ = Σ roots[i·t mod 5]·xᵢ
+func dft5(x, roots *[5]fr.Element) {
+	var y [5]fr.Element
+	y[0].Add(&x[0], &x[1]).Add(&y[0], &x[2]).Add(&y[0], &x[3]).Add(&y[0], &x[4])
+	var t fr.Element
+	for k := 1; k < 5; k++ {
+		y[k] = x[0]
+		for i := 1; i < 5; i++ {
+			t.Mul(&x[i], &roots[(i*k)%5])
+			y[k].Add(&y[k], &t)
+		}
+	}
+	*x = y
+}
@@ -119,7 +119,27 @@ type ProofOfProximity struct {
 
 	// round contains the data corresponding to a single round
 	// of fri. There are nbRounds rounds of Interactions.
+	// Only set with DefaultConfig.
 	Rounds []Round
+
+	// Config parameters of the IOPP which built the proof.
+	// The zero value stands for DefaultConfig, as in the proofs built before it was added.
+	Config Config
+
+	// Nonce proof of work computed before sampling the queries.
+	Nonce uint64
+
+	// Roots Merkle roots of the successive folded polynomials.
+	// Only set for configurations other than DefaultConfig.
+	Roots [][]byte
+
+	// FinalPolynomial coefficients of the fully folded polynomial.
+	// Only set for configurations other than DefaultConfig.
+	FinalPolynomial []fr.Element
+
+	// Queries openings of the layers at the positions queried by the verifier.
+	// Only set for configurations other than DefaultConfig.
+	Queries []QueryProof
 }
 
 // Iopp interface that an iopp should implement
@@ -149,7 +169,8 @@ func init() {
 	twoInv.SetUint64(2).Inverse(&twoInv)
 }
 
-// New creates a new IOPP capable to handle degree(size) polynomials.
+// New creates a new IOPP capable to handle degree(size) polynomials, using
+// DefaultConfig. See NewWithConfig for other parameters.
 func (iopp IOPP) New(size uint64, h hash.Hash) Iopp {
 	switch iopp {
 	case RADIX_2_FRI:
@@ -496,6 +517,7 @@ func (s radixTwoFri) BuildProofOfProximity(p []fr.Element) (ProofOfProximity, er
 
 	// the proof will contain nbSteps Interactions
 	var proof ProofOfProximity
+	proof.Config = DefaultConfig()
 	proof.Rounds = make([]Round, nbRounds)
 
 	// evaluate p
@@ -673,6 +695,13 @@ func (s radixTwoFri) verifyProofOfProximitySingleRound(salt fr.Element, proof Ro
 // by one.
 func (s radixTwoFri) VerifyProofOfProximity(proof ProofOfProximity) error {
 
+	if proof.Config != (Config{}) && proof.Config != DefaultConfig() {
+		return ErrConfigMismatch
+	}
+	if len(proof.Rounds) != nbRounds {
+		return ErrProofShape
+	}
+
 	var salt, one fr.Element
 	one.SetOne()
 	for i := 0; i < nbRounds; i++ {
@@ -0,0 +1,229 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+
+	"github.com/consensys/gnark-crypto/utils/testutils"
+)
+
+func TestNewFK20(t *testing.T) {
+	assert := require.New(t)
+
+	_, err := NewFK20(testSrs.Pk, 0, 64, 1)
+	assert.ErrorIs(err, ErrInvalidFK20Parameters)
+	_, err = NewFK20(testSrs.Pk, 65, 64, 1)
+	assert.ErrorIs(err, ErrInvalidFK20Parameters)
+	_, err = NewFK20(testSrs.Pk, 32, 48, 1)
+	assert.ErrorIs(err, ErrInvalidFK20Parameters)
+	_, err = NewFK20(testSrs.Pk, 32, 64, 3)
+	assert.ErrorIs(err, ErrInvalidFK20Parameters)
+	_, err = NewFK20(testSrs.Pk, 32, 64, 128)
+	assert.ErrorIs(err, ErrInvalidFK20Parameters)
+	_, err = NewFK20(testSrs.Pk, uint64(len(testSrs.Pk.G1)+1), uint64(4*len(testSrs.Pk.G1)), 1)
+	assert.ErrorIs(err, ErrInvalidFK20Parameters)
+
+	fk, err := NewFK20(testSrs.Pk, 32, 64, 2)
+	assert.NoError(err)
+	_, err = fk.Open(randomPolynomial(32))
+	assert.ErrorIs(err, ErrFK20CellSize)
+	_, err = fk.ComputeProofs(randomPolynomial(33))
+	assert.ErrorIs(err, ErrInvalidPolynomialSize)
+}
+
+func TestFK20Open(t *testing.T) {
+	const (
+		polySize   = 32
+		domainSize = 64
+	)
+
+	test := func(srs *SRS, size int) func(*testing.T) {
+		return func(t *testing.T) {
+			assert := require.New(t)
+
+			fk, err := NewFK20(srs.Pk, polySize, domainSize, 1)
+			assert.NoError(err)
+
+			f := randomPolynomial(size)
+			digest, err := Commit(f, srs.Pk)
+			assert.NoError(err)
+
+			proofs, err := fk.Open(f)
+			assert.NoError(err)
+			assert.Equal(domainSize, len(proofs))
+
+			for k := range proofs {
+				point := fk.CellShift(k)
+				expected, err := Open(f, point, srs.Pk)
+				assert.NoError(err)
+				assert.True(expected.ClaimedValue.Equal(&proofs[k].ClaimedValue), "inconsistent claimed value")
+				assert.True(expected.H.Equal(&proofs[k].H), "inconsistent quotient")
+				assert.NoError(Verify(&digest, &proofs[k], point, srs.Vk))
+			}
+		}
+	}
+	t.Run("unsafe", test(testSrs, polySize))
+	t.Run("unsafe/smaller polynomial", test(testSrs, polySize-7))
+	t.Run("mpcsetup", test(mpcGetSrs(t), polySize))
+}
+
+func TestFK20OpenCells(t *testing.T) {
+	const (
+		polySize   = 32
+		domainSize = 64
+	)
+	g2Powers := testG2Powers(domainSize + 1)
+
+	for _, cellSize := range []int{1, 2, 4, 64} {
+		assert := require.New(t)
+
+		fk, err := NewFK20(testSrs.Pk, polySize, domainSize, uint64(cellSize))
+		assert.NoError(err)
+
+		f := randomPolynomial(polySize - 3)
+		digest, err := Commit(f, testSrs.Pk)
+		assert.NoError(err)
+
+		proofs, err := fk.OpenCells(f)
+		assert.NoError(err)
+		assert.Equal(domainSize/cellSize, len(proofs))
+
+		vk, err := NewCellVerifyingKey(testSrs.Pk, g2Powers, uint64(cellSize))
+		assert.NoError(err)
+
+		for k := range proofs {
+			x := fk.CellShift(k)
+
+			// the proof is the commitment to the quotient of f by X^ℓ - x^ℓ
+			var xl fr.Element
+			xl.Exp(x, big.NewInt(int64(cellSize)))
+			expected, err := Commit(divideByXlMinusA(f, cellSize, xl), testSrs.Pk)
+			assert.NoError(err)
+			assert.True(expected.Equal(&proofs[k].H), "inconsistent quotient")
+
+			// the claimed values are the evaluations of f on the cell
+			var zeta fr.Element
+			generator, err := fr.Generator(uint64(cellSize))
+			assert.NoError(err)
+			zeta.Set(&x)
+			for j := range proofs[k].ClaimedValues {
+				expected := eval(f, zeta)
+				assert.True(expected.Equal(&proofs[k].ClaimedValues[j]), "inconsistent claimed value")
+				zeta.Mul(&zeta, &generator)
+			}
+
+			assert.NoError(VerifyCell(&digest, &proofs[k], x, vk))
+		}
+
+		// wrong claimed value
+		proofs[0].ClaimedValues[cellSize-1].Double(&proofs[0].ClaimedValues[cellSize-1])
+		assert.ErrorIs(VerifyCell(&digest, &proofs[0], fk.CellShift(0), vk), ErrVerifyCellProof)
+
+		// wrong cell
+		if len(proofs) > 1 {
+			assert.ErrorIs(VerifyCell(&digest, &proofs[1], fk.CellShift(0), vk), ErrVerifyCellProof)
+		}
+
+		// wrong number of values
+		proofs[0].ClaimedValues = proofs[0].ClaimedValues[1:]
+		assert.ErrorIs(VerifyCell(&digest, &proofs[0], fk.CellShift(0), vk), ErrInvalidNbEvaluations)
+	}
+}
+
+func TestCellOpeningProofSerialization(t *testing.T) {
+	assert := require.New(t)
+
+	fk, err := NewFK20(testSrs.Pk, 32, 64, 4)
+	assert.NoError(err)
+	proofs, err := fk.OpenCells(randomPolynomial(32))
+	assert.NoError(err)
+
+	t.Run("cell opening proof round-trip", testutils.SerializationRoundTrip(&proofs[3]))
+}
+
+func TestNewCellVerifyingKey(t *testing.T) {
+	assert := require.New(t)
+
+	g2Powers := testG2Powers(65)
+
+	_, err := NewCellVerifyingKey(testSrs.Pk, g2Powers, 0)
+	assert.ErrorIs(err, ErrInvalidFK20Parameters)
+	_, err = NewCellVerifyingKey(testSrs.Pk, g2Powers, 3)
+	assert.ErrorIs(err, ErrInvalidFK20Parameters)
+	_, err = NewCellVerifyingKey(testSrs.Pk, g2Powers, 128)
+	assert.ErrorIs(err, ErrInvalidFK20Parameters)
+	_, err = NewCellVerifyingKey(testSrs.Pk, g2Powers[:64], 64)
+	assert.ErrorIs(err, ErrInvalidFK20Parameters)
+
+	vk, err := NewCellVerifyingKey(testSrs.Pk, g2Powers, 4)
+	assert.NoError(err)
+	assert.Equal(testSrs.Pk.G1[:4], vk.G1)
+	assert.Equal(testSrs.Vk.G2[0], vk.G2[0])
+	assert.Equal(g2Powers[4], vk.G2[1])
+
+	// [τ⁵]G₂ instead of [τ⁴]G₂
+	g2Powers[4] = g2Powers[5]
+	_, err = NewCellVerifyingKey(testSrs.Pk, g2Powers, 4)
+	assert.ErrorIs(err, ErrInconsistentVerifyingKey)
+}
+
+// testG2Powers returns the n first G₂ powers of bAlpha, as published by the ceremony of testSrs
+func testG2Powers(n int) []bls24317.G2Affine {
+	scalars := make([]fr.Element, n)
+	var alpha fr.Element
+	alpha.SetBigInt(bAlpha)
+	scalars[0].SetOne()
+	for i := 1; i < n; i++ {
+		scalars[i].Mul(&scalars[i-1], &alpha)
+	}
+	return bls24317.BatchScalarMultiplicationG2(&testSrs.Vk.G2[0], scalars)
+}
+
+// divideByXlMinusA returns the quotient of the euclidean division of f by Xˡ - a
+func divideByXlMinusA(f []fr.Element, l int, a fr.Element) []fr.Element {
+	if len(f) <= l {
+		return make([]fr.Element, 1)
+	}
+	r := make([]fr.Element, len(f))
+	copy(r, f)
+	q := make([]fr.Element, len(f)-l)
+	var t fr.Element
+	for i := len(f) - 1; i >= l; i-- {
+		q[i-l] = r[i]
+		t.Mul(&r[i], &a)
+		r[i-l].Add(&r[i-l], &t)
+	}
+	return q
+}
+
+func BenchmarkFK20(b *testing.B) {
+	const (
+		polySize   = 1 << 10
+		domainSize = 2 * polySize
+	)
+	srs, err := NewSRS(polySize, big.NewInt(-1))
+	require.NoError(b, err)
+	f := randomPolynomial(polySize)
+
+	for _, cellSize := range []uint64{1, 16} {
+		fk, err := NewFK20(srs.Pk, polySize, domainSize, cellSize)
+		require.NoError(b, err)
+		b.Run("cellSize="+strconv.Itoa(int(cellSize)), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = fk.ComputeProofs(f)
+			}
+		})
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+	"github.com/consensys/gnark-crypto/ecc/bls24-317/fr"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidHidingGenerator = errors.New("the hiding generator scalar must be non zero")
+	ErrInvalidHidingBound     = errors.New("hiding bound must be positive and smaller than the hiding SRS size")
+	ErrInvalidNbBlindings     = errors.New("number of blinding polynomials is not the same as the number of polynomials")
+)
+
+// HidingProvingKey used to create or open hiding commitments.
+// It extends the ProvingKey with the powers of α times a second generator H,
+// whose discrete logarithm in base G₁ is unknown.
+type HidingProvingKey struct {
+	ProvingKey
+	H []bls24317.G1Affine // [H, [α]H, [α²]H, ... ]
+}
+
+// HidingVerifyingKey used to verify hiding opening proofs
+type HidingVerifyingKey struct {
+	VerifyingKey
+	H bls24317.G1Affine
+}
+
+// HidingSRS SRS for hiding commitments, cf Marlin (https://eprint.iacr.org/2019/1047.pdf, appendix B).
+//
+// A commitment to p with the blinding polynomial r is [p(α)]G₁ + [r(α)]H.
+//
+// implements io.ReaderFrom and io.WriterTo
+type HidingSRS struct {
+	Pk HidingProvingKey
+	Vk HidingVerifyingKey
+}
+
+// HidingOpeningProof hiding KZG proof for opening at a single point.
+//
+// implements io.ReaderFrom and io.WriterTo
+type HidingOpeningProof struct {
+	// H commitment to the quotients (f - f(z))/(x-z) and (r - r(z))/(x-z)
+	H bls24317.G1Affine
+
+	// ClaimedValue purported value f(z)
+	ClaimedValue fr.Element
+
+	// BlindingValue value r(z) of the blinding polynomial
+	BlindingValue fr.Element
+}
+
+// HidingBatchOpeningProof hiding opening proof for many polynomials at the same point
+//
+// implements io.ReaderFrom and io.WriterTo
+type HidingBatchOpeningProof struct {
+	// H commitment to the quotients of ∑ᵢγⁱfᵢ and ∑ᵢγⁱrᵢ by (x-z)
+	H bls24317.G1Affine
+
+	// ClaimedValues purported values fᵢ(z)
+	ClaimedValues []fr.Element
+
+	// BlindingValue value ∑ᵢγⁱrᵢ(z) of the folded blinding polynomial
+	BlindingValue fr.Element
+}
+
+// NewHidingSRS extends srs with the second generator H = [γ]G₁ and its powers [αⁱ]H = [γ]([αⁱ]G₁).
+//
+// γ must be sampled at random and discarded, in the same way as the toxic waste of the SRS:
+// anyone knowing it can break the hiding property. In production, it should be generated through MPC.
+func NewHidingSRS(srs *SRS, bGamma *big.Int) (*HidingSRS, error) {
+	var gamma fr.Element
+	gamma.SetBigInt(bGamma)
+	if gamma.IsZero() {
+		return nil, ErrInvalidHidingGenerator
+	}
+	var bGammaReduced big.Int
+	gamma.BigInt(&bGammaReduced)
+
+	var res HidingSRS
+	res.Pk.ProvingKey = srs.Pk
+	res.Vk.VerifyingKey = srs.Vk
+	res.Vk.H.ScalarMultiplication(&srs.Vk.G1, &bGammaReduced)
+
+	h := make([]bls24317.G1Jac, len(srs.Pk.G1))
+	parallel.Execute(len(h), func(start, end int) {
+		var s big.Int
+		s.Set(&bGammaReduced)
+		for i := start; i < end; i++ {
+			h[i].FromAffine(&srs.Pk.G1[i])
+			h[i].ScalarMultiplication(&h[i], &s)
+		}
+	})
+	res.Pk.H = bls24317.BatchJacobianToAffineG1(h)
+
+	return &res, nil
+}
+
+// CommitHiding commits to p with a random blinding polynomial of hidingBound+1 coefficients,
+// and returns the commitment [p(α)]G₁ + [r(α)]H and the blinding polynomial r.
+//
+// The commitment and hidingBound openings of it reveal nothing about p. The blinding polynomial
+// is needed to open the commitment, see OpenHiding.
+func CommitHiding(p []fr.Element, pk HidingProvingKey, hidingBound int, nbTasks ...int) (Digest, []fr.Element, error) {
+	if len(p) == 0 || len(p) > len(pk.G1) {
+		return Digest{}, nil, ErrInvalidPolynomialSize
+	}
+	if hidingBound < 1 || hidingBound >= len(pk.H) {
+		return Digest{}, nil, ErrInvalidHidingBound
+	}
+
+	blinding := make([]fr.Element, hidingBound+1)
+	for i := range blinding {
+		if _, err := blinding[i].SetRandom(); err != nil {
+			return Digest{}, nil, err
+		}
+	}
+
+	res, err := commitHiding(p, blinding, pk, nbTasks...)
+	if err != nil {
+		return Digest{}, nil, err
+	}
+	return res, blinding, nil
+}
+
+// OpenHiding computes a hiding opening proof of polynomial p at given point,
+// where blinding is the blinding polynomial returned by CommitHiding.
+func OpenHiding(p, blinding []fr.Element, point fr.Element, pk HidingProvingKey) (HidingOpeningProof, error) {
+	if len(p) == 0 || len(p) > len(pk.G1) {
+		return HidingOpeningProof{}, ErrInvalidPolynomialSize
+	}
+	if len(blinding) < 2 || len(blinding) > len(pk.H) {
+		return HidingOpeningProof{}, ErrInvalidHidingBound
+	}
+
+	res := HidingOpeningProof{
+		ClaimedValue:  eval(p, point),
+		BlindingValue: eval(blinding, point),
+	}
+
+	// quotients of p and the blinding polynomial by (X - point)
+	_p := make([]fr.Element, len(p))
+	copy(_p, p)
+	h := dividePolyByXminusA(_p, res.ClaimedValue, point)
+	_r := make([]fr.Element, len(blinding))
+	copy(_r, blinding)
+	hr := dividePolyByXminusA(_r, res.BlindingValue, point)
+
+	var err error
+	if res.H, err = commitHiding(h, hr, pk); err != nil {
+		return HidingOpeningProof{}, err
+	}
+
+	return res, nil
+}
+
+// VerifyHiding verifies a hiding KZG opening proof at a single point
+func VerifyHiding(commitment *Digest, proof *HidingOpeningProof, point fr.Element, vk HidingVerifyingKey) error {
+
+	// [f(a)]G₁ + [r(a)]H + [-a]([W(α)]G₁) - C
+	var totalG1 bls24317.G1Jac
+	var pointNeg fr.Element
+	pointNeg.Neg(&point)
+	if _, err := totalG1.MultiExp(
+		[]bls24317.G1Affine{vk.G1, vk.H, proof.H},
+		[]fr.Element{proof.ClaimedValue, proof.BlindingValue, pointNeg},
+		ecc.MultiExpConfig{},
+	); err != nil {
+		return err
+	}
+	var commitmentJac bls24317.G1Jac
+	commitmentJac.FromAffine(commitment)
+	totalG1.SubAssign(&commitmentJac)
+
+	// e([f(a)+r(a)γ-a*W(α)-C]G₁, G₂).e([W(α)]G₁, [α]G₂) == 1
+	var totalG1Aff bls24317.G1Affine
+	totalG1Aff.FromJacobian(&totalG1)
+	check, err := bls24317.PairingCheckFixedQ(
+		[]bls24317.G1Affine{totalG1Aff, proof.H},
+		vk.Lines[:],
+	)
+	if err != nil {
+		return err
+	}
+	if !check {
+		return ErrVerifyOpeningProof
+	}
+	return nil
+}
+
+// BatchOpenSinglePointHiding creates a hiding batch opening proof at point of a list of polynomials,
+// where blindings[i] is the blinding polynomial of polynomials[i] returned by CommitHiding.
+//
+// The challenge γ used for folding is derived as in BatchOpenSinglePoint.
+func BatchOpenSinglePointHiding(polynomials, blindings [][]fr.Element, digests []Digest, point fr.Element, hf hash.Hash, pk HidingProvingKey, dataTranscript ...[]byte) (HidingBatchOpeningProof, error) {
+
+	// check for invalid sizes
+	nbDigests := len(digests)
+	if nbDigests == 0 {
+		return HidingBatchOpeningProof{}, ErrZeroNbDigests
+	}
+	if nbDigests != len(polynomials) {
+		return HidingBatchOpeningProof{}, ErrInvalidNbDigests
+	}
+	if nbDigests != len(blindings) {
+		return HidingBatchOpeningProof{}, ErrInvalidNbBlindings
+	}
+	largestPoly, largestBlinding := 0, 0
+	for i := range polynomials {
+		if len(polynomials[i]) == 0 || len(polynomials[i]) > len(pk.G1) {
+			return HidingBatchOpeningProof{}, ErrInvalidPolynomialSize
+		}
+		if len(blindings[i]) < 2 || len(blindings[i]) > len(pk.H) {
+			return HidingBatchOpeningProof{}, ErrInvalidHidingBound
+		}
+		largestPoly = max(largestPoly, len(polynomials[i]))
+		largestBlinding = max(largestBlinding, len(blindings[i]))
+	}
+
+	var res HidingBatchOpeningProof
+
+	// compute the purported values
+	res.ClaimedValues = make([]fr.Element, nbDigests)
+	parallel.Execute(nbDigests, func(start, end int) {
+		for i := start; i < end; i++ {
+			res.ClaimedValues[i] = eval(polynomials[i], point)
+		}
+	})
+
+	// derive the challenge γ, binded to the point and the commitments
+	gamma, err := deriveGamma(point, digests, res.ClaimedValues, hf, dataTranscript...)
+	if err != nil {
+		return HidingBatchOpeningProof{}, err
+	}
+
+	// ∑ᵢγⁱfᵢ and ∑ᵢγⁱrᵢ
+	gammai := make([]fr.Element, nbDigests)
+	gammai[0].SetOne()
+	for i := 1; i < nbDigests; i++ {
+		gammai[i].Mul(&gammai[i-1], &gamma)
+	}
+	foldedPolynomials := foldPolynomials(polynomials, gammai, largestPoly)
+	foldedBlindings := foldPolynomials(blindings, gammai, largestBlinding)
+
+	// ∑ᵢγⁱfᵢ(a), ∑ᵢγⁱrᵢ(a)
+	var foldedEvaluations, t fr.Element
+	for i := range res.ClaimedValues {
+		t.Mul(&res.ClaimedValues[i], &gammai[i])
+		foldedEvaluations.Add(&foldedEvaluations, &t)
+	}
+	res.BlindingValue = eval(foldedBlindings, point)
+
+	// compute H
+	h := dividePolyByXminusA(foldedPolynomials, foldedEvaluations, point)
+	hr := dividePolyByXminusA(foldedBlindings, res.BlindingValue, point)
+	if res.H, err = commitHiding(h, hr, pk); err != nil {
+		return HidingBatchOpeningProof{}, err
+	}
+
+	return res, nil
+}
+
+// BatchVerifySinglePointHiding verifies a hiding batched opening proof at a single point of a list of polynomials.
+//
+// * digests list of hiding commitments on which opening proof is done
+// * batchOpeningProof proof of correct opening on the digests
+// * dataTranscript extra data that might be needed to derive the challenge used for the folding
+func BatchVerifySinglePointHiding(digests []Digest, batchOpeningProof *HidingBatchOpeningProof, point fr.Element, hf hash.Hash, vk HidingVerifyingKey, dataTranscript ...[]byte) error {
+
+	// fold the proof, the blinding value is already folded
+	foldedProof, foldedDigest, err := FoldProof(digests, &BatchOpeningProof{
+		H:             batchOpeningProof.H,
+		ClaimedValues: batchOpeningProof.ClaimedValues,
+	}, point, hf, dataTranscript...)
+	if err != nil {
+		return err
+	}
+
+	return VerifyHiding(&foldedDigest, &HidingOpeningProof{
+		H:             foldedProof.H,
+		ClaimedValue:  foldedProof.ClaimedValue,
+		BlindingValue: batchOpeningProof.BlindingValue,
+	}, point, vk)
+}
+
+// commitHiding returns [p(α)]G₁ + [r(α)]H, where p or r may be empty
+func commitHiding(p, r []fr.Element, pk HidingProvingKey, nbTasks ...int) (Digest, error) {
+	if len(p) > len(pk.G1) {
+		return Digest{}, ErrInvalidPolynomialSize
+	}
+	if len(r) > len(pk.H) {
+		return Digest{}, ErrInvalidHidingBound
+	}
+
+	config := ecc.MultiExpConfig{}
+	if len(nbTasks) > 0 {
+		config.NbTasks = nbTasks[0]
+	}
+
+	var res, tmp bls24317.G1Jac
+	if len(p) > 0 {
+		if _, err := res.MultiExp(pk.G1[:len(p)], p, config); err != nil {
+			return Digest{}, err
+		}
+	}
+	if len(r) > 0 {
+		if _, err := tmp.MultiExp(pk.H[:len(r)], r, config); err != nil {
+			return Digest{}, err
+		}
+		res.AddAssign(&tmp)
+	}
+
+	var resAff Digest
+	resAff.FromJacobian(&res)
+	return resAff, nil
+}
+
+// foldPolynomials returns ∑ᵢgammai[i]pᵢ, of size size
+func foldPolynomials(polynomials [][]fr.Element, gammai []fr.Element, size int) []fr.Element {
+	res := make([]fr.Element, size)
+	copy(res, polynomials[0])
+	for i := 1; i < len(polynomials); i++ {
+		i := i
+		parallel.Execute(len(polynomials[i]), func(start, end int) {
+			var t fr.Element
+			for j := start; j < end; j++ {
+				t.Mul(&polynomials[i][j], &gammai[i])
+				res[j].Add(&res[j], &t)
+			}
+		})
+	}
+	return res
+}
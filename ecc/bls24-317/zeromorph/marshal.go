@@ -0,0 +1,168 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package zeromorph
+
+import (
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bls24-317"
+)
+
+// WriteTo writes binary encoding of a OpeningProof
+func (proof *OpeningProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bls24317.NewEncoder(w)
+
+	toEncode := []interface{}{
+		proof.QuotientCommitments,
+		&proof.BatchedQuotient,
+		&proof.DegreeProof,
+		&proof.Proof,
+		&proof.ClaimedValue,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes OpeningProof data from reader.
+func (proof *OpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls24317.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&proof.QuotientCommitments,
+		&proof.BatchedQuotient,
+		&proof.DegreeProof,
+		&proof.Proof,
+		&proof.ClaimedValue,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of a BatchOpeningProof
+func (proof *BatchOpeningProof) WriteTo(w io.Writer) (int64, error) {
+	enc := bls24317.NewEncoder(w)
+
+	toEncode := []interface{}{
+		proof.QuotientCommitments,
+		&proof.BatchedQuotient,
+		&proof.DegreeProof,
+		&proof.Proof,
+		proof.ClaimedValues,
+	}
+
+	for _, v := range toEncode {
+		if err := enc.Encode(v); err != nil {
+			return enc.BytesWritten(), err
+		}
+	}
+
+	return enc.BytesWritten(), nil
+}
+
+// ReadFrom decodes BatchOpeningProof data from reader.
+func (proof *BatchOpeningProof) ReadFrom(r io.Reader) (int64, error) {
+	dec := bls24317.NewDecoder(r)
+
+	toDecode := []interface{}{
+		&proof.QuotientCommitments,
+		&proof.BatchedQuotient,
+		&proof.DegreeProof,
+		&proof.Proof,
+		&proof.ClaimedValues,
+	}
+
+	for _, v := range toDecode {
+		if err := dec.Decode(v); err != nil {
+			return dec.BytesRead(), err
+		}
+	}
+
+	return dec.BytesRead(), nil
+}
+
+// WriteTo writes binary encoding of the VerifyingKey
+func (vk *VerifyingKey) WriteTo(w io.Writer) (int64, error) {
+	// encode the kzg.VerifyingKey, then the shifted G₂ powers
+	n, err := vk.VerifyingKey.WriteTo(w)
+	if err != nil {
+		return n, err
+	}
+	enc := bls24317.NewEncoder(w)
+	err = enc.Encode(vk.ShiftedG2)
+	return n + enc.BytesWritten(), err
+}
+
+// WriteRawTo writes binary encoding of the VerifyingKey to w without point compression
+func (vk *VerifyingKey) WriteRawTo(w io.Writer) (int64, error) {
+	// encode the kzg.VerifyingKey, then the shifted G₂ powers
+	n, err := vk.VerifyingKey.WriteRawTo(w)
+	if err != nil {
+		return n, err
+	}
+	enc := bls24317.NewEncoder(w, bls24317.RawEncoding())
+	err = enc.Encode(vk.ShiftedG2)
+	return n + enc.BytesWritten(), err
+}
+
+// ReadFrom decodes VerifyingKey data from reader.
+func (vk *VerifyingKey) ReadFrom(r io.Reader) (int64, error) {
+	// decode the kzg.VerifyingKey, then the shifted G₂ powers
+	n, err := vk.VerifyingKey.ReadFrom(r)
+	if err != nil {
+		return n, err
+	}
+	dec := bls24317.NewDecoder(r)
+	err = dec.Decode(&vk.ShiftedG2)
+	return n + dec.BytesRead(), err
+}
+
+// WriteTo writes binary encoding of the entire SRS
+func (srs *SRS) WriteTo(w io.Writer) (int64, error) {
+	// encode the SRS
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.WriteTo(w); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.WriteTo(w)
+	return pn + vn, err
+}
+
+// WriteRawTo writes binary encoding of the entire SRS without point compression
+func (srs *SRS) WriteRawTo(w io.Writer) (int64, error) {
+	// encode the SRS
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.WriteRawTo(w); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.WriteRawTo(w)
+	return pn + vn, err
+}
+
+// ReadFrom decodes SRS data from reader.
+func (srs *SRS) ReadFrom(r io.Reader) (int64, error) {
+	// decode the SRS
+	var pn, vn int64
+	var err error
+	if pn, err = srs.Pk.ReadFrom(r); err != nil {
+		return pn, err
+	}
+	vn, err = srs.Vk.ReadFrom(r)
+	return pn + vn, err
+}
@@ -6,8 +6,11 @@
 package ecdsa
 
 import (
+	"bytes"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/sha256"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 	"math/big"
 	"testing"
@@ -51,8 +54,80 @@ func TestECDSA(t *testing.T) {
 		},
 	))
 
+	properties.Property("[BN254] test the deterministic signing (RFC 6979)", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			privKey.SetDeterministicNonces(sha256.New)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing ECDSA")
+			hFunc := sha256.New()
+			sig1, _ := privKey.Sign(msg, hFunc)
+			sig2, _ := privKey.Sign(msg, hFunc)
+			flag, _ := publicKey.Verify(sig1, msg, hFunc)
+
+			// the signature of another message differs
+			sig3, _ := privKey.Sign([]byte("testing ECDSA again"), hFunc)
+
+			return flag && bytes.Equal(sig1, sig2) && !bytes.Equal(sig1, sig3)
+		},
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
+
+// RFC 6979, Appendix A.2: the nonces of the signatures of "sample" and "test" with SHA-256 on the
+// NIST curves, which only depend on the order of the curve. The orders are shorter and longer
+// than the hash, and not a multiple of 8 bits for P-521.
+func TestRFC6979(t *testing.T) {
+	p192, _ := new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFF99DEF836146BC9B1B4D22831", 16)
+	for _, v := range []struct {
+		curve     string
+		q         *big.Int
+		x, msg, k string
+	}{
+		{"P-192", p192, "6FAB034934E4C0FC9AE67F5B5659A9D7D1FEFD187EE09FD4", "sample", "32B1B6D7D42A05CB449065727A84804FB1A3E34D8F261496"},
+		{"P-224", elliptic.P224().Params().N, "F220266E1105BFE3083E03EC7A3A654651F45E37167E88600BF257C1", "sample", "AD3029E0278F80643DE33917CE6908C70A8FF50A411F06E41DEDFCDC"},
+		{"P-256", elliptic.P256().Params().N, "C9AFA9D845BA75166B5C215767B1D6934E50C3DB36E89B127B8A622B120F6721", "sample", "A6E3C57DD01ABE90086538398355DD4C3B17AA873382B0F24D6129493D8AAD60"},
+		{"P-256", elliptic.P256().Params().N, "C9AFA9D845BA75166B5C215767B1D6934E50C3DB36E89B127B8A622B120F6721", "test", "D16B6AE827F17175E040871A1C7EC3500192C4C92677336EC2537ACAEE0008E0"},
+		{"P-384", elliptic.P384().Params().N, "6B9D3DAD2E1B8C1C05B19875B6659F4DE23C3B667BF297BA9AA47740787137D896D5724E4C70A825F872C9EA60D2EDF5", "sample", "180AE9F9AEC5438A44BC159A1FCB277C7BE54FA20E7CF404B490650A8ACC414E375572342863C899F9F2EDF9747A9B60"},
+		{"P-521", elliptic.P521().Params().N, "0FAD06DAA62BA3B25D2FB40133DA757205DE67F5BB0018FEE8C86E1B68C7E75CAA896EB32F1F47C70855836A6D16FCC1466F6D8FBEC67DB89EC0C08B0E996B83538", "sample", "0EDF38AFCAAECAB4383358B34D67C9F2216C8382AAEA44A3DAD5FDC9C32575761793FEF24EB0FC276DFC4F6E3EC476752F043CF01415387470BCBD8678ED2C7E1A0"},
+	} {
+		x, _ := new(big.Int).SetString(v.x, 16)
+		h := sha256.Sum256([]byte(v.msg))
+		k := newHMACDRBG(sha256.New, v.q, x, h[:]).next()
+		if expected, _ := new(big.Int).SetString(v.k, 16); k.Cmp(expected) != 0 {
+			t.Fatalf("%s, %s: wrong nonce %X", v.curve, v.msg, k)
+		}
+	}
+}
+
+// The nonce of a deterministic signature, k = s⁻¹ ⋅ (m + sk ⋅ r), is the first output of the
+// HMAC-DRBG with the order of the curve.
+func TestDeterministicNonce(t *testing.T) {
+	privKey, _ := GenerateKey(rand.Reader)
+	privKey.SetDeterministicNonces(sha256.New)
+	sigBin, err := privKey.Sign([]byte("sample"), sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		t.Fatal(err)
+	}
+	r, s := new(big.Int).SetBytes(sig.R[:sizeFr]), new(big.Int).SetBytes(sig.S[:sizeFr])
+
+	sk := new(big.Int).SetBytes(privKey.scalar[:sizeFr])
+	h := sha256.Sum256([]byte("sample"))
+	k := new(big.Int).Mul(sk, r)
+	k.Add(k, HashToInt(h[:])).
+		Mul(k, s.ModInverse(s, order)).
+		Mod(k, order)
+	if k.Cmp(newHMACDRBG(sha256.New, order, sk, h[:]).next()) != 0 {
+		t.Fatal("the nonce isn't the output of the HMAC-DRBG")
+	}
+}
 func TestRecoverPublicKey(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -76,9 +151,78 @@ func TestRecoverPublicKey(t *testing.T) {
 			return pk.Equal(&recovered)
 		},
 	))
+	properties.Property("[BN254] test public key recover with deterministic nonces", prop.ForAll(
+		func() bool {
+			sk, err := GenerateKey(rand.Reader)
+			if err != nil {
+				return false
+			}
+			sk.SetDeterministicNonces(sha256.New)
+			pk := sk.PublicKey
+			msg := []byte("test")
+			v, r, s, err := sk.SignForRecover(msg, nil)
+			if err != nil {
+				return false
+			}
+			v2, r2, s2, err := sk.SignForRecover(msg, nil)
+			if err != nil || v != v2 || r.Cmp(r2) != 0 || s.Cmp(s2) != 0 {
+				return false
+			}
+			var recovered PublicKey
+			if err = recovered.RecoverFrom(msg, v, r, s); err != nil {
+				return false
+			}
+			return pk.Equal(&recovered)
+		},
+	))
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+// The first nonce leads to s = 0 and is rejected: v must only describe the second one.
+func TestSignForRecoverRetry(t *testing.T) {
+	privKey, _ := GenerateKey(rand.Reader)
+	sk := new(big.Int).SetBytes(privKey.scalar[:sizeFr])
+
+	// k1 ⋅ g1Gen has an odd y, and k2 ⋅ g1Gen an even y
+	var P1, P2 bn254.G1Affine
+	var k1, k2 *big.Int
+	for k1 == nil || k2 == nil {
+		k, err := randFieldElement(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var P bn254.G1Affine
+		P.ScalarMultiplicationBase(k)
+		if P.Y.BigInt(new(big.Int)).Bit(0) == 1 {
+			k1, P1 = k, P
+		} else {
+			k2, P2 = k, P
+		}
+	}
+
+	// m = -sk ⋅ r1, so that s = k1⁻¹ ⋅ (m + sk ⋅ r1) = 0
+	r1 := P1.X.BigInt(new(big.Int))
+	m := new(big.Int).Mul(sk, r1)
+	m.Neg(m).Mod(m, order)
+
+	nonces := []*big.Int{k1, k2}
+	v, r, _, err := privKey.signForRecover(m, func() (*big.Int, error) {
+		k := nonces[0]
+		nonces = nonces[1:]
+		return k, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x2 := P2.X.BigInt(new(big.Int))
+	if r.Cmp(new(big.Int).Mod(x2, order)) != 0 {
+		t.Fatal("the signature must use the second nonce")
+	}
+	if expected := uint(new(big.Int).Div(x2, order).Uint64()) << 1; v != expected {
+		t.Fatalf("v = %d, expected %d", v, expected)
+	}
+}
+
 func TestNonMalleability(t *testing.T) {
 
 	// buffer too big
@@ -1,4 +1,4 @@
-//go:build purego || (!amd64 && !arm64)
+//go:build (purego || (!amd64 && !arm64)) && !(!limbs64 && (386 || arm || mips || mipsle || wasm || (purego && limbs32)))
 
 // Copyright 2020-2025 Consensys Software Inc.
 // Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
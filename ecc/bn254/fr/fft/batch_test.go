@@ -0,0 +1,148 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
+)
+
+func TestFFTBatch(t *testing.T) {
+	for _, logN := range []int{0, 1, 4, 7} {
+		n := 1 << logN
+		for domainName, domainOpts := range map[string][]DomainOption{
+			"with precompute":    nil,
+			"without precompute": {WithoutPrecompute()},
+		} {
+			domain := NewDomain(uint64(n), domainOpts...)
+			for _, nbColumns := range []int{1, 3, 8} {
+				for _, decimation := range []Decimation{DIF, DIT} {
+					for _, inverse := range []bool{false, true} {
+						for _, coset := range []bool{false, true} {
+							name := fmt.Sprintf("n=%d/%s/columns=%d/DIF=%v/inverse=%v/coset=%v", n, domainName, nbColumns, decimation == DIF, inverse, coset)
+							t.Run(name, func(t *testing.T) {
+								testFFTBatch(t, domain, nbColumns, decimation, inverse, coset)
+							})
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func testFFTBatch(t *testing.T, domain *Domain, nbColumns int, decimation Decimation, inverse, coset bool) {
+	n := int(domain.Cardinality)
+	var opts []Option
+	if coset {
+		opts = append(opts, OnCoset())
+	}
+
+	// expected[j] is the transform of the j-th polynomial with FFT or FFTInverse
+	polynomials := make([][]fr.Element, nbColumns)
+	expected := make([][]fr.Element, nbColumns)
+	matrix := make([]fr.Element, n*nbColumns)
+	for j := range polynomials {
+		polynomials[j] = make([]fr.Element, n)
+		expected[j] = make([]fr.Element, n)
+		for i := range polynomials[j] {
+			polynomials[j][i].MustSetRandom()
+			matrix[i*nbColumns+j] = polynomials[j][i]
+		}
+		copy(expected[j], polynomials[j])
+		if inverse {
+			domain.FFTInverse(expected[j], decimation, opts...)
+		} else {
+			domain.FFT(expected[j], decimation, opts...)
+		}
+	}
+
+	for _, nbTasks := range []int{1, 2, 16} {
+		o := append([]Option{WithNbTasks(nbTasks)}, opts...)
+
+		batch := make([][]fr.Element, nbColumns)
+		for j := range batch {
+			batch[j] = make([]fr.Element, n)
+			copy(batch[j], polynomials[j])
+		}
+		if inverse {
+			domain.FFTInverseBatch(batch, decimation, o...)
+		} else {
+			domain.FFTBatch(batch, decimation, o...)
+		}
+		for j := range batch {
+			for i := range batch[j] {
+				if !batch[j][i].Equal(&expected[j][i]) {
+					t.Fatalf("nbTasks=%d: FFTBatch mismatch for polynomial %d at index %d", nbTasks, j, i)
+				}
+			}
+		}
+
+		for _, transpose := range []bool{false, true} {
+			o := o
+			if transpose {
+				o = append(o, WithTranspose())
+			}
+			m := make([]fr.Element, len(matrix))
+			copy(m, matrix)
+			if inverse {
+				domain.FFTInverseMatrix(m, nbColumns, decimation, o...)
+			} else {
+				domain.FFTMatrix(m, nbColumns, decimation, o...)
+			}
+			for j := 0; j < nbColumns; j++ {
+				for i := 0; i < n; i++ {
+					if !m[i*nbColumns+j].Equal(&expected[j][i]) {
+						t.Fatalf("nbTasks=%d transpose=%v: FFTMatrix mismatch for column %d at row %d", nbTasks, transpose, j, i)
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkFFTBatch(b *testing.B) {
+	const logN = 14
+	const nbColumns = 64
+	domain := NewDomain(1 << logN)
+
+	polynomials := make([][]fr.Element, nbColumns)
+	for j := range polynomials {
+		polynomials[j] = make([]fr.Element, 1<<logN)
+		for i := range polynomials[j] {
+			polynomials[j][i].MustSetRandom()
+		}
+	}
+	matrix := make([]fr.Element, nbColumns<<logN)
+	for i := range matrix {
+		matrix[i].MustSetRandom()
+	}
+
+	b.Run("loop", func(b *testing.B) {
+		for k := 0; k < b.N; k++ {
+			for j := range polynomials {
+				domain.FFT(polynomials[j], DIF)
+			}
+		}
+	})
+	b.Run("FFTBatch", func(b *testing.B) {
+		for k := 0; k < b.N; k++ {
+			domain.FFTBatch(polynomials, DIF)
+		}
+	})
+	b.Run("FFTMatrix", func(b *testing.B) {
+		for k := 0; k < b.N; k++ {
+			domain.FFTMatrix(matrix, nbColumns, DIF)
+		}
+	})
+	b.Run("FFTMatrix/transpose", func(b *testing.B) {
+		for k := 0; k < b.N; k++ {
+			domain.FFTMatrix(matrix, nbColumns, DIF, WithTranspose())
+		}
+	})
+}
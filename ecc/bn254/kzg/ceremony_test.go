@@ -0,0 +1,68 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+// TestPtauHermez reads the τ sections of the Hermez powers of tau of power 1, as written to
+// testdata by go run testdata/fetch.go. snarkjs files start with the generators of G₁ and G₂.
+func TestPtauHermez(t *testing.T) {
+	assert := require.New(t)
+
+	data, err := os.ReadFile("testdata/powersOfTau28_hez_final_01.ptau")
+	assert.NoError(err, "the powers of tau are fetched with go run testdata/fetch.go")
+
+	// a file of power p holds 2ᵖ⁺¹-1 G₁ powers and 2ᵖ G₂ powers
+	srs, err := NewSRSFromPtau(bytes.NewReader(data), 3, WithPairingCheck())
+	assert.NoError(err)
+	_, _, g1, g2 := bn254.Generators()
+	assert.Equal(g1, srs.Pk.G1[0])
+	assert.Equal(g2, srs.Vk.G2[0])
+
+	g2Powers, err := G2PowersFromPtau(bytes.NewReader(data), 2)
+	assert.NoError(err)
+	assert.Equal(srs.Vk.G2[:], g2Powers)
+	_, err = G2PowersFromPtau(bytes.NewReader(data), 3)
+	assert.ErrorIs(err, ErrSRSTooSmall)
+}
+
+// TestIgnitionTranscript reads the first points of the Aztec Ignition transcript00.dat, as written
+// to testdata by go run testdata/fetch.go, and checks [x]G₂ against its published value.
+func TestIgnitionTranscript(t *testing.T) {
+	assert := require.New(t)
+
+	f, err := os.Open("testdata/transcript00.dat")
+	assert.NoError(err, "the transcript is fetched with go run testdata/fetch.go")
+	defer f.Close()
+
+	srs, err := NewSRSFromIgnition([]io.Reader{f}, 17, WithPairingCheck())
+	assert.NoError(err)
+
+	var xG2 bn254.G2Affine
+	for _, c := range []struct {
+		e   *fp.Element
+		hex string
+	}{
+		{&xG2.X.A0, "0x0118c4d5b837bcc2bc89b5b398b5974e9f5944073b32078b7e231fec938883b0"},
+		{&xG2.X.A1, "0x260e01b251f6f1c7e7ff4e580791dee8ea51d87a358e038b4efe30fac09383c1"},
+		{&xG2.Y.A0, "0x22febda3c0c0632a56475b4214e5615e11e6dd3f96e6cea2854a87d4dacc5e55"},
+		{&xG2.Y.A1, "0x04fc6369f7110fe3d25156c1bb9a72859cf2a04641f99ba4ee413c80da6a5fe4"},
+	} {
+		_, err := c.e.SetString(c.hex)
+		assert.NoError(err)
+	}
+	assert.True(xG2.Equal(&srs.Vk.G2[1]), "unexpected [x]G₂")
+}
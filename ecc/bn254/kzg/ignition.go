@@ -0,0 +1,143 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var ErrInvalidIgnitionTranscript = errors.New("invalid ignition transcript")
+
+// ignitionManifest header of an Aztec Ignition transcript, in big endian
+type ignitionManifest struct {
+	TranscriptNumber uint32
+	TotalTranscripts uint32
+	TotalG1Points    uint32
+	TotalG2Points    uint32
+	NumG1Points      uint32
+	NumG2Points      uint32
+	StartFrom        uint32
+}
+
+// NewSRSFromIgnition reads the Aztec Ignition transcripts (transcript00.dat, transcript01.dat, ...),
+// in order, and returns the SRS made of the first size powers [τⁱ]G₁ and of [G₂, [τ]G₂].
+//
+// cf https://github.com/AztecProtocol/ignition-verification. The transcripts hold the powers
+// [τⁱ]G₁ starting from i = 1, and the first one holds [τ]G₂. Only the transcripts needed to
+// reach size are read, and their checksums are not verified. The points are checked to be in
+// the correct subgroup, and the G₁ powers are checked against [τ]G₂ if WithPairingCheck is set.
+func NewSRSFromIgnition(transcripts []io.Reader, size uint64, opts ...ImportOption) (*SRS, error) {
+	if size < 2 {
+		return nil, ErrMinSRSSize
+	}
+	var cfg importConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var srs SRS
+	_, _, srs.Vk.G1, srs.Vk.G2[0] = bn254.Generators()
+	srs.Pk.G1 = make([]bn254.G1Affine, size)
+	srs.Pk.G1[0] = srs.Vk.G1
+
+	// next index in srs.Pk.G1
+	next := uint64(1)
+	for i := 0; i < len(transcripts) && next < size; i++ {
+		var manifest ignitionManifest
+		if err := binary.Read(transcripts[i], binary.BigEndian, &manifest); err != nil {
+			return nil, err
+		}
+		if manifest.TranscriptNumber != uint32(i) || uint64(manifest.StartFrom)+1 != next {
+			return nil, fmt.Errorf("%w: unexpected transcript %d", ErrInvalidIgnitionTranscript, manifest.TranscriptNumber)
+		}
+
+		nbPoints := min(uint64(manifest.NumG1Points), size-next)
+		if err := readIgnitionG1(transcripts[i], srs.Pk.G1[next:next+nbPoints], next); err != nil {
+			return nil, err
+		}
+		next += nbPoints
+
+		if i == 0 {
+			if manifest.NumG2Points == 0 {
+				return nil, fmt.Errorf("%w: missing [τ]G₂", ErrInvalidIgnitionTranscript)
+			}
+			// skip the remaining G₁ points
+			remaining := int64(manifest.NumG1Points) - int64(nbPoints)
+			if _, err := io.CopyN(io.Discard, transcripts[i], remaining*2*fp.Bytes); err != nil {
+				return nil, err
+			}
+			if err := readIgnitionG2(transcripts[i], &srs.Vk.G2[1]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if next < size {
+		return nil, ErrSRSTooSmall
+	}
+
+	srs.Vk.Lines[0] = bn254.PrecomputeLines(srs.Vk.G2[0])
+	srs.Vk.Lines[1] = bn254.PrecomputeLines(srs.Vk.G2[1])
+
+	if cfg.pairingCheck {
+		if err := checkPowers(srs.Pk.G1, srs.Vk.G2); err != nil {
+			return nil, err
+		}
+	}
+
+	return &srs, nil
+}
+
+// readIgnitionG1 reads len(points) G₁ points, offset is the index of the first one in the SRS
+func readIgnitionG1(r io.Reader, points []bn254.G1Affine, offset uint64) error {
+	buf := make([]byte, len(points)*2*fp.Bytes)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
+	}
+	var errs firstError
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			b := buf[i*2*fp.Bytes:]
+			if !setIgnition(&points[i].X, b) || !setIgnition(&points[i].Y, b[fp.Bytes:]) || !points[i].IsInSubGroup() {
+				errs.set(i, fmt.Errorf("%w: G1 point %d", ErrInvalidPoint, uint64(i)+offset))
+				return
+			}
+		}
+	})
+	return errs.err
+}
+
+// readIgnitionG2 reads a G₂ point
+func readIgnitionG2(r io.Reader, point *bn254.G2Affine) error {
+	var buf [4 * fp.Bytes]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return err
+	}
+	if !setIgnition(&point.X.A0, buf[:]) || !setIgnition(&point.X.A1, buf[fp.Bytes:]) ||
+		!setIgnition(&point.Y.A0, buf[2*fp.Bytes:]) || !setIgnition(&point.Y.A1, buf[3*fp.Bytes:]) ||
+		!point.IsInSubGroup() {
+		return fmt.Errorf("%w: G2 point", ErrInvalidPoint)
+	}
+	return nil
+}
+
+// setIgnition sets e from its Ignition encoding: the limbs of its regular form, least significant
+// first, each in big endian. It returns false if e is not canonical.
+func setIgnition(e *fp.Element, b []byte) bool {
+	var le [fp.Bytes]byte
+	for j := 0; j < fp.Limbs; j++ {
+		binary.LittleEndian.PutUint64(le[8*j:], binary.BigEndian.Uint64(b[8*j:]))
+	}
+	var err error
+	*e, err = fp.LittleEndian.Element(&le)
+	return err == nil
+}
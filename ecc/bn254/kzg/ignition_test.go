@@ -0,0 +1,106 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+)
+
+// writeIgnition writes the powers g1[1:] of srs split in transcripts of nbPointsPerTranscript points
+func writeIgnition(srs *SRS, nbPointsPerTranscript int) []io.Reader {
+	putIgnition := func(buf *bytes.Buffer, e *fp.Element) {
+		var le [fp.Bytes]byte
+		fp.LittleEndian.PutElement(&le, *e)
+		for j := 0; j < fp.Limbs; j++ {
+			var limb [8]byte
+			binary.BigEndian.PutUint64(limb[:], binary.LittleEndian.Uint64(le[8*j:]))
+			buf.Write(limb[:])
+		}
+	}
+
+	g1 := srs.Pk.G1[1:]
+	nbTranscripts := (len(g1) + nbPointsPerTranscript - 1) / nbPointsPerTranscript
+	res := make([]io.Reader, nbTranscripts)
+	for i := range res {
+		points := g1[i*nbPointsPerTranscript : min(len(g1), (i+1)*nbPointsPerTranscript)]
+		manifest := ignitionManifest{
+			TranscriptNumber: uint32(i),
+			TotalTranscripts: uint32(nbTranscripts),
+			TotalG1Points:    uint32(len(g1)),
+			TotalG2Points:    2,
+			NumG1Points:      uint32(len(points)),
+			StartFrom:        uint32(i * nbPointsPerTranscript),
+		}
+		if i == 0 {
+			manifest.NumG2Points = 2
+		}
+
+		var buf bytes.Buffer
+		_ = binary.Write(&buf, binary.BigEndian, &manifest)
+		for j := range points {
+			putIgnition(&buf, &points[j].X)
+			putIgnition(&buf, &points[j].Y)
+		}
+		if i == 0 {
+			for _, p := range []bn254.G2Affine{srs.Vk.G2[1], srs.Vk.G2[1]} {
+				putIgnition(&buf, &p.X.A0)
+				putIgnition(&buf, &p.X.A1)
+				putIgnition(&buf, &p.Y.A0)
+				putIgnition(&buf, &p.Y.A1)
+			}
+		}
+		buf.Write(make([]byte, 64)) // checksum
+		res[i] = &buf
+	}
+	return res
+}
+
+func TestIgnition(t *testing.T) {
+	assert := require.New(t)
+
+	const nbPointsPerTranscript = 50
+
+	// whole SRS
+	size := uint64(len(testSrs.Pk.G1))
+	srs, err := NewSRSFromIgnition(writeIgnition(testSrs, nbPointsPerTranscript), size, WithPairingCheck())
+	assert.NoError(err)
+	assert.Equal(testSrs.Pk.G1, srs.Pk.G1)
+	assert.Equal(testSrs.Vk, srs.Vk)
+
+	// truncated SRS, within the first transcript and across transcripts
+	for _, size := range []uint64{2, 30, 120} {
+		srs, err = NewSRSFromIgnition(writeIgnition(testSrs, nbPointsPerTranscript), size, WithPairingCheck())
+		assert.NoError(err)
+		assert.Equal(testSrs.Pk.G1[:size], srs.Pk.G1)
+		assert.Equal(testSrs.Vk, srs.Vk)
+	}
+
+	// not enough points
+	_, err = NewSRSFromIgnition(writeIgnition(testSrs, nbPointsPerTranscript), size+1)
+	assert.ErrorIs(err, ErrSRSTooSmall)
+
+	// transcripts out of order
+	transcripts := writeIgnition(testSrs, nbPointsPerTranscript)
+	transcripts[1], transcripts[2] = transcripts[2], transcripts[1]
+	_, err = NewSRSFromIgnition(transcripts, size)
+	assert.ErrorIs(err, ErrInvalidIgnitionTranscript)
+
+	// invalid point
+	transcripts = writeIgnition(testSrs, nbPointsPerTranscript)
+	data := transcripts[1].(*bytes.Buffer).Bytes()
+	data[28+5*2*fp.Bytes+fp.Bytes-1]++
+	_, err = NewSRSFromIgnition(transcripts, size)
+	assert.ErrorIs(err, ErrInvalidPoint)
+	assert.ErrorContains(err, "G1 point 56")
+}
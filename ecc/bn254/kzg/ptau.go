@@ -0,0 +1,356 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrInvalidPtau = errors.New("invalid ptau file")
+	ErrPtauCurve   = errors.New("ptau file is not on bn254")
+	ErrSRSTooSmall = errors.New("the transcript has fewer points than the requested SRS size")
+)
+
+// snarkjs .ptau format, cf https://github.com/iden3/snarkjs/blob/master/src/powersoftau_new.js
+const (
+	ptauMagic         = "ptau"
+	ptauVersion       = 1
+	ptauSectionHeader = 1
+	ptauSectionTauG1  = 2
+	ptauSectionTauG2  = 3
+)
+
+// ImportOption configures the import of an SRS from a ceremony transcript
+type ImportOption func(*importConfig)
+
+type importConfig struct {
+	pairingCheck bool
+}
+
+// WithPairingCheck checks after the import that the G₁ points are successive powers of τ,
+// where [τ]G₂ is the G₂ point of the SRS. The check uses a random linear combination of
+// the G₁ points and two pairings.
+func WithPairingCheck() ImportOption {
+	return func(c *importConfig) {
+		c.pairingCheck = true
+	}
+}
+
+// NewSRSFromPtau reads a snarkjs powers of tau file (.ptau) and returns the SRS made of the
+// first size powers [τⁱ]G₁ and of [G₂, [τ]G₂].
+//
+// Only the header and the τ sections of the file are read, and the points are checked to be
+// in the correct subgroup. The G₁ powers are checked against [τ]G₂ if WithPairingCheck is set.
+func NewSRSFromPtau(r io.Reader, size uint64, opts ...ImportOption) (*SRS, error) {
+	if size < 2 {
+		return nil, ErrMinSRSSize
+	}
+	var cfg importConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	g1, g2, err := readPtau(r, size, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	var srs SRS
+	srs.Pk.G1 = g1
+	srs.Vk.G1 = srs.Pk.G1[0]
+	copy(srs.Vk.G2[:], g2)
+	srs.Vk.Lines[0] = bn254.PrecomputeLines(srs.Vk.G2[0])
+	srs.Vk.Lines[1] = bn254.PrecomputeLines(srs.Vk.G2[1])
+
+	if cfg.pairingCheck {
+		if err := checkPowers(srs.Pk.G1, srs.Vk.G2); err != nil {
+			return nil, err
+		}
+	}
+
+	return &srs, nil
+}
+
+// G2PowersFromPtau reads a snarkjs powers of tau file (.ptau) and returns the first n powers
+// [τⁱ]G₂ of its τG₂ section, checked to be in the correct subgroup. A file of power p holds
+// 2ᵖ of them. They are needed to verify the FK20 cell proofs, see NewCellVerifyingKey.
+func G2PowersFromPtau(r io.Reader, n uint64) ([]bn254.G2Affine, error) {
+	if n < 2 {
+		return nil, ErrMinSRSSize
+	}
+	_, g2, err := readPtau(r, 0, n)
+	return g2, err
+}
+
+// readPtau reads the first nbG1 powers [τⁱ]G₁ and the first nbG2 powers [τⁱ]G₂ of a ptau file.
+// The τG₁ section is skipped if nbG1 is 0.
+func readPtau(r io.Reader, nbG1, nbG2 uint64) (g1 []bn254.G1Affine, g2 []bn254.G2Affine, err error) {
+	var magic [4]byte
+	if _, err = io.ReadFull(r, magic[:]); err != nil {
+		return
+	}
+	if string(magic[:]) != ptauMagic {
+		return nil, nil, ErrInvalidPtau
+	}
+	var version, nbSections uint32
+	if err = binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return
+	}
+	if err = binary.Read(r, binary.LittleEndian, &nbSections); err != nil {
+		return
+	}
+	if version != ptauVersion {
+		return nil, nil, ErrInvalidPtau
+	}
+
+	headerRead, g1Read, g2Read := false, nbG1 == 0, false
+	for i := uint32(0); i < nbSections && !(g1Read && g2Read); i++ {
+		var sectionType uint32
+		var sectionSize uint64
+		if err = binary.Read(r, binary.LittleEndian, &sectionType); err != nil {
+			return
+		}
+		if err = binary.Read(r, binary.LittleEndian, &sectionSize); err != nil {
+			return
+		}
+
+		switch sectionType {
+		case ptauSectionHeader:
+			if err = readPtauHeader(r, sectionSize); err != nil {
+				return
+			}
+			headerRead = true
+		case ptauSectionTauG1:
+			if !headerRead || sectionSize%(2*fp.Bytes) != 0 {
+				return nil, nil, ErrInvalidPtau
+			}
+			if sectionSize/(2*fp.Bytes) < nbG1 {
+				return nil, nil, ErrSRSTooSmall
+			}
+			g1 = make([]bn254.G1Affine, nbG1)
+			if err = readPtauG1(r, g1); err != nil {
+				return
+			}
+			if _, err = io.CopyN(io.Discard, r, int64(sectionSize-nbG1*2*fp.Bytes)); err != nil {
+				return
+			}
+			g1Read = true
+		case ptauSectionTauG2:
+			if !headerRead || sectionSize%(4*fp.Bytes) != 0 || sectionSize < 2*4*fp.Bytes {
+				return nil, nil, ErrInvalidPtau
+			}
+			if sectionSize/(4*fp.Bytes) < nbG2 {
+				return nil, nil, ErrSRSTooSmall
+			}
+			g2 = make([]bn254.G2Affine, nbG2)
+			if err = readPtauG2(r, g2); err != nil {
+				return
+			}
+			if _, err = io.CopyN(io.Discard, r, int64(sectionSize-nbG2*4*fp.Bytes)); err != nil {
+				return
+			}
+			g2Read = true
+		default:
+			if _, err = io.CopyN(io.Discard, r, int64(sectionSize)); err != nil {
+				return
+			}
+		}
+	}
+	if !g1Read || !g2Read {
+		return nil, nil, ErrInvalidPtau
+	}
+	return g1, g2, nil
+}
+
+// WritePtau writes the SRS in the snarkjs .ptau format.
+//
+// The file contains the header and the τ sections only, with all the G₁ powers of the SRS
+// and [G₂, [τ]G₂]. The power in the header is ⌊log₂(len(srs.Pk.G1))⌋. It is readable by
+// NewSRSFromPtau, but not by the snarkjs commands that need the α, β or Lagrange sections.
+func (srs *SRS) WritePtau(w io.Writer) error {
+	if len(srs.Pk.G1) < 2 {
+		return ErrMinSRSSize
+	}
+	return writePtau(w, srs.Pk.G1, srs.Vk.G2[:])
+}
+
+// writePtau writes the header and the τ sections of a ptau file with the given powers
+func writePtau(w io.Writer, g1 []bn254.G1Affine, g2 []bn254.G2Affine) error {
+	power := uint32(bits.Len64(uint64(len(g1))) - 1)
+
+	if _, err := w.Write([]byte(ptauMagic)); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, []uint32{ptauVersion, 3}); err != nil {
+		return err
+	}
+
+	// header: n8, q, power, ceremony power
+	var q [fp.Bytes]byte
+	fp.Modulus().FillBytes(q[:])
+	for i, j := 0, fp.Bytes-1; i < j; i, j = i+1, j-1 {
+		q[i], q[j] = q[j], q[i]
+	}
+	if err := writePtauSectionHeader(w, ptauSectionHeader, 4+fp.Bytes+8); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint32(fp.Bytes)); err != nil {
+		return err
+	}
+	if _, err := w.Write(q[:]); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, []uint32{power, power}); err != nil {
+		return err
+	}
+
+	// τ sections
+	buf := make([]byte, len(g1)*2*fp.Bytes)
+	parallel.Execute(len(g1), func(start, end int) {
+		for i := start; i < end; i++ {
+			b := buf[i*2*fp.Bytes:]
+			putLEM(b, &g1[i].X)
+			putLEM(b[fp.Bytes:], &g1[i].Y)
+		}
+	})
+	if err := writePtauSectionHeader(w, ptauSectionTauG1, uint64(len(buf))); err != nil {
+		return err
+	}
+	if _, err := w.Write(buf); err != nil {
+		return err
+	}
+
+	buf = make([]byte, len(g2)*4*fp.Bytes)
+	for i := range g2 {
+		b := buf[i*4*fp.Bytes:]
+		putLEM(b, &g2[i].X.A0)
+		putLEM(b[fp.Bytes:], &g2[i].X.A1)
+		putLEM(b[2*fp.Bytes:], &g2[i].Y.A0)
+		putLEM(b[3*fp.Bytes:], &g2[i].Y.A1)
+	}
+	if err := writePtauSectionHeader(w, ptauSectionTauG2, uint64(len(buf))); err != nil {
+		return err
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+func writePtauSectionHeader(w io.Writer, sectionType uint32, sectionSize uint64) error {
+	if err := binary.Write(w, binary.LittleEndian, sectionType); err != nil {
+		return err
+	}
+	return binary.Write(w, binary.LittleEndian, sectionSize)
+}
+
+// readPtauHeader reads the header section and checks that the file is on the curve
+func readPtauHeader(r io.Reader, sectionSize uint64) error {
+	var n8 uint32
+	if err := binary.Read(r, binary.LittleEndian, &n8); err != nil {
+		return err
+	}
+	if n8 != fp.Bytes || sectionSize < 4+fp.Bytes+8 {
+		return ErrPtauCurve
+	}
+	var q [fp.Bytes]byte
+	if _, err := io.ReadFull(r, q[:]); err != nil {
+		return err
+	}
+	var expected [fp.Bytes]byte
+	fp.Modulus().FillBytes(expected[:])
+	for i := range q {
+		if q[i] != expected[fp.Bytes-1-i] {
+			return ErrPtauCurve
+		}
+	}
+	// power and ceremony power are not needed, the number of points is given by the section sizes
+	_, err := io.CopyN(io.Discard, r, int64(sectionSize-4-fp.Bytes))
+	return err
+}
+
+// readPtauG1 reads len(points) G₁ points, coordinates in Montgomery form and little endian
+func readPtauG1(r io.Reader, points []bn254.G1Affine) error {
+	buf := make([]byte, len(points)*2*fp.Bytes)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
+	}
+	var errs firstError
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			b := buf[i*2*fp.Bytes:]
+			if !setLEM(&points[i].X, b) || !setLEM(&points[i].Y, b[fp.Bytes:]) || !points[i].IsInSubGroup() {
+				errs.set(i, fmt.Errorf("%w: G1 point %d", ErrInvalidPoint, i))
+				return
+			}
+		}
+	})
+	return errs.err
+}
+
+// readPtauG2 reads len(points) G₂ points, coordinates in Montgomery form and little endian
+func readPtauG2(r io.Reader, points []bn254.G2Affine) error {
+	buf := make([]byte, len(points)*4*fp.Bytes)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return err
+	}
+	var errs firstError
+	parallel.Execute(len(points), func(start, end int) {
+		for i := start; i < end; i++ {
+			b := buf[i*4*fp.Bytes:]
+			if !setLEM(&points[i].X.A0, b) || !setLEM(&points[i].X.A1, b[fp.Bytes:]) ||
+				!setLEM(&points[i].Y.A0, b[2*fp.Bytes:]) || !setLEM(&points[i].Y.A1, b[3*fp.Bytes:]) ||
+				!points[i].IsInSubGroup() {
+				errs.set(i, fmt.Errorf("%w: G2 point %d", ErrInvalidPoint, i))
+				return
+			}
+		}
+	})
+	return errs.err
+}
+
+// setLEM sets e from its Montgomery form in little endian, that is from the
+// little endian encoding of its limbs. It returns false if e is not canonical.
+func setLEM(e *fp.Element, b []byte) bool {
+	for j := 0; j < fp.Limbs; j++ {
+		e[j] = binary.LittleEndian.Uint64(b[8*j:])
+	}
+	return lessThanModulus(e)
+}
+
+// putLEM writes the Montgomery form of e in little endian
+func putLEM(b []byte, e *fp.Element) {
+	for j := 0; j < fp.Limbs; j++ {
+		binary.LittleEndian.PutUint64(b[8*j:], e[j])
+	}
+}
+
+// fpModulusLimbs limbs of the modulus of fp
+var fpModulusLimbs = func() (res [fp.Limbs]uint64) {
+	var q [fp.Bytes]byte
+	fp.Modulus().FillBytes(q[:])
+	for j := range res {
+		res[j] = binary.BigEndian.Uint64(q[fp.Bytes-8*(j+1):])
+	}
+	return
+}()
+
+// lessThanModulus returns true if the limbs of e are smaller than the modulus
+func lessThanModulus(e *fp.Element) bool {
+	for j := fp.Limbs - 1; j >= 0; j-- {
+		if e[j] != fpModulusLimbs[j] {
+			return e[j] < fpModulusLimbs[j]
+		}
+	}
+	return false
+}
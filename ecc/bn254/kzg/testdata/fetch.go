@@ -0,0 +1,159 @@
+//go:build ignore
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// fetch downloads the beginning of two public BN254 ceremonies, for TestPtauHermez and
+// TestIgnitionTranscript:
+//
+//	go run testdata/fetch.go
+//
+// The header and τ sections of the Hermez (snarkjs) powers of tau of power 1 are written to
+// testdata/powersOfTau28_hez_final_01.ptau. The first G₁ points and the G₂ points of the first
+// Aztec Ignition transcript are written to testdata/transcript00.dat, with its manifest updated
+// to the number of G₁ points kept.
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+const (
+	ptauURL       = "https://hermez.s3-eu-west-1.amazonaws.com/powersOfTau28_hez_final_01.ptau"
+	transcriptURL = "https://aztec-ignition.s3.amazonaws.com/MAIN%20IGNITION/monomial/transcript00.dat"
+
+	// nbIgnitionG1Points number of G₁ points kept from the transcript
+	nbIgnitionG1Points = 16
+
+	ignitionManifestSize = 28
+	ignitionG1Size       = 64
+	ignitionG2Size       = 128
+)
+
+// ptauSections sections kept from the ptau file: header, τG₁ and τG₂
+var ptauSections = map[uint32]bool{1: true, 2: true, 3: true}
+
+func main() {
+	dir := "testdata"
+	if _, err := os.Stat(filepath.Join(dir, "fetch.go")); err != nil {
+		log.Fatal("fetch must be run from the kzg package directory")
+	}
+
+	ptau, err := download(ptauURL, "")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if ptau, err = trimPtau(ptau); err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "powersOfTau28_hez_final_01.ptau"), ptau, 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	transcript, err := fetchTranscript()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(filepath.Join(dir, "transcript00.dat"), transcript, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// download returns the content of url, or of the given byte range of it if not empty
+func download(url, byteRange string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	expected := http.StatusOK
+	if byteRange != "" {
+		req.Header.Set("Range", "bytes="+byteRange)
+		expected = http.StatusPartialContent
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != expected {
+		return nil, fmt.Errorf("%s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// trimPtau returns the ptau file with the header and τ sections only
+func trimPtau(ptau []byte) ([]byte, error) {
+	if len(ptau) < 12 || string(ptau[:4]) != "ptau" {
+		return nil, fmt.Errorf("%s: not a ptau file", ptauURL)
+	}
+	var sections bytes.Buffer
+	nbSections := uint32(0)
+	for b := ptau[12:]; len(b) > 0; {
+		if len(b) < 12 {
+			return nil, fmt.Errorf("%s: truncated section header", ptauURL)
+		}
+		sectionType := binary.LittleEndian.Uint32(b)
+		sectionSize := binary.LittleEndian.Uint64(b[4:])
+		if uint64(len(b)-12) < sectionSize {
+			return nil, fmt.Errorf("%s: truncated section %d", ptauURL, sectionType)
+		}
+		if ptauSections[sectionType] {
+			sections.Write(b[:12+sectionSize])
+			nbSections++
+		}
+		b = b[12+sectionSize:]
+	}
+	if nbSections != uint32(len(ptauSections)) {
+		return nil, fmt.Errorf("%s: missing τ sections", ptauURL)
+	}
+
+	res := bytes.Clone(ptau[:12])
+	binary.LittleEndian.PutUint32(res[8:], nbSections)
+	return append(res, sections.Bytes()...), nil
+}
+
+// fetchTranscript downloads the manifest, the first G₁ points and the G₂ points of the first
+// Ignition transcript, without the hundreds of MB of G₁ points in between
+func fetchTranscript() ([]byte, error) {
+	manifest, err := download(transcriptURL, fmt.Sprintf("0-%d", ignitionManifestSize-1))
+	if err != nil {
+		return nil, err
+	}
+	if len(manifest) != ignitionManifestSize {
+		return nil, fmt.Errorf("%s: truncated manifest", transcriptURL)
+	}
+	// transcript number, total transcripts, total G₁ points, total G₂ points,
+	// G₁ points, G₂ points, start from
+	nbG1Points := uint64(binary.BigEndian.Uint32(manifest[16:]))
+	nbG2Points := uint64(binary.BigEndian.Uint32(manifest[20:]))
+	if nbG1Points < nbIgnitionG1Points || nbG2Points == 0 {
+		return nil, fmt.Errorf("%s: unexpected manifest", transcriptURL)
+	}
+
+	g1, err := download(transcriptURL, fmt.Sprintf("%d-%d", ignitionManifestSize, ignitionManifestSize+nbIgnitionG1Points*ignitionG1Size-1))
+	if err != nil {
+		return nil, err
+	}
+	g2Offset := ignitionManifestSize + nbG1Points*ignitionG1Size
+	g2, err := download(transcriptURL, fmt.Sprintf("%d-%d", g2Offset, g2Offset+nbG2Points*ignitionG2Size-1))
+	if err != nil {
+		return nil, err
+	}
+	if len(g1) != nbIgnitionG1Points*ignitionG1Size || uint64(len(g2)) != nbG2Points*ignitionG2Size {
+		return nil, fmt.Errorf("%s: truncated points", transcriptURL)
+	}
+
+	// a single transcript with the kept points
+	binary.BigEndian.PutUint32(manifest[4:], 1)
+	binary.BigEndian.PutUint32(manifest[8:], nbIgnitionG1Points)
+	binary.BigEndian.PutUint32(manifest[16:], nbIgnitionG1Points)
+	res := append(manifest, g1...)
+	return append(res, g2...), nil
+}
@@ -0,0 +1,12 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package zeromorph provides a commitment scheme for multilinear polynomials (polynomial.MultiLin),
+// reduced to a univariate KZG opening, cf Zeromorph https://eprint.iacr.org/2023/917.pdf
+//
+// The multilinear polynomial f given by its evaluations (fᵢ) on the boolean hypercube is committed
+// as the univariate polynomial ∑ᵢfᵢXⁱ, with the kzg package ProvingKey. The VerifyingKey extends
+// the kzg one with powers of α in G₂ that bound the degrees of the quotients, see NewVerifyingKey.
+package zeromorph
@@ -93,6 +93,57 @@ func TestOpening(t *testing.T) {
 
 }
 
+func TestBatchVerifyProofs(t *testing.T) {
+
+	assert := require.New(t)
+
+	const nbProofs = 4
+	proofs := make([]OpeningProof, nbProofs)
+	digests := make([][]kzg.Digest, nbProofs)
+	points := make([][][]fr.Element, nbProofs)
+	hf := sha256.New()
+	for i := 0; i < nbProofs; i++ {
+		nbPolys := i + 1
+		polys := make([][]fr.Element, nbPolys)
+		digests[i] = make([]kzg.Digest, nbPolys)
+		points[i] = make([][]fr.Element, nbPolys)
+		for j := 0; j < nbPolys; j++ {
+			polys[j] = make([]fr.Element, 5+3*j)
+			fr.Vector(polys[j]).MustSetRandom()
+			var err error
+			digests[i][j], err = kzg.Commit(polys[j], testSrs.Pk)
+			assert.NoError(err)
+			points[i][j] = make([]fr.Element, j+1)
+			fr.Vector(points[i][j]).MustSetRandom()
+		}
+		var err error
+		proofs[i], err = BatchOpen(polys, digests[i], points[i], hf, testSrs.Pk, []byte("test"))
+		assert.NoError(err)
+	}
+
+	assert.NoError(BatchVerifyProofs(proofs, digests, points, hf, testSrs.Vk, []byte("test")))
+	assert.NoError(BatchVerifyProofs(proofs[2:3], digests[2:3], points[2:3], hf, testSrs.Vk, []byte("test")))
+
+	// wrong transcript
+	assert.ErrorIs(BatchVerifyProofs(proofs, digests, points, hf, testSrs.Vk), ErrVerifyOpeningProof)
+
+	// proofs of other openings
+	assert.ErrorIs(BatchVerifyProofs(proofs[1:], digests[:nbProofs-1], points[1:], hf, testSrs.Vk, []byte("test")), ErrInvalidNumberOfPoints)
+	proofs[0], proofs[1] = proofs[1], proofs[0]
+	digests[0], digests[1] = digests[1], digests[0]
+	assert.ErrorIs(BatchVerifyProofs(proofs, digests, points, hf, testSrs.Vk, []byte("test")), ErrInvalidNumberOfPoints)
+	points[0], points[1] = points[1], points[0]
+	assert.NoError(BatchVerifyProofs(proofs, digests, points, hf, testSrs.Vk, []byte("test")))
+
+	// tampered proof
+	proofs[3].ClaimedValues[2][0].MustSetRandom()
+	assert.ErrorIs(BatchVerifyProofs(proofs, digests, points, hf, testSrs.Vk, []byte("test")), ErrVerifyOpeningProof)
+
+	// invalid number of proofs
+	assert.ErrorIs(BatchVerifyProofs(nil, nil, nil, hf, testSrs.Vk), ErrInvalidNumberOfProofs)
+	assert.ErrorIs(BatchVerifyProofs(proofs, digests[1:], points, hf, testSrs.Vk), ErrInvalidNumberOfProofs)
+}
+
 func TestBuildZtMinusSi(t *testing.T) {
 
 	nbSi := 10
@@ -2247,310 +2247,6 @@ func (z *Element) linearComb(x *Element, xC int64, y *Element, yC int64) {
 	z.montReduceSigned(z, hi)
 }
 
-// montReduceSigned z = (xHi * r + x) * r⁻¹ using the SOS algorithm
-// Requires |xHi| < 2⁶³. Most significant bit of xHi is the sign bit.
-func (z *Element) montReduceSigned(x *Element, xHi uint64) {
-	const signBitRemover = ^signBitSelector
-	mustNeg := xHi&signBitSelector != 0
-	// the SOS implementation requires that most significant bit is 0
-	// Let X be xHi*r + x
-	// If X is negative we would have initially stored it as 2⁶⁴ r + X (à la 2's complement)
-	xHi &= signBitRemover
-	// with this a negative X is now represented as 2⁶³ r + X
-
-	var t [2*Limbs - 1]uint64
-	var C uint64
-
-	m := x[0] * qInvNeg
-
-	C = madd0(m, q0, x[0])
-	C, t[1] = madd2(m, q1, x[1], C)
-	C, t[2] = madd2(m, q2, x[2], C)
-	C, t[3] = madd2(m, q3, x[3], C)
-	C, t[4] = madd2(m, q4, x[4], C)
-	C, t[5] = madd2(m, q5, x[5], C)
-	C, t[6] = madd2(m, q6, x[6], C)
-	C, t[7] = madd2(m, q7, x[7], C)
-	C, t[8] = madd2(m, q8, x[8], C)
-	C, t[9] = madd2(m, q9, x[9], C)
-	C, t[10] = madd2(m, q10, x[10], C)
-	C, t[11] = madd2(m, q11, x[11], C)
-
-	// m * qElement[11] ≤ (2⁶⁴ - 1) * (2⁶³ - 1) = 2¹²⁷ - 2⁶⁴ - 2⁶³ + 1
-	// x[11] + C ≤ 2*(2⁶⁴ - 1) = 2⁶⁵ - 2
-	// On LHS, (C, t[11]) ≤ 2¹²⁷ - 2⁶⁴ - 2⁶³ + 1 + 2⁶⁵ - 2 = 2¹²⁷ + 2⁶³ - 1
-	// So on LHS, C ≤ 2⁶³
-	t[12] = xHi + C
-	// xHi + C < 2⁶³ + 2⁶³ = 2⁶⁴
-
-	// <standard SOS>
-	{
-		const i = 1
-		m = t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, t[i+1] = madd2(m, q1, t[i+1], C)
-		C, t[i+2] = madd2(m, q2, t[i+2], C)
-		C, t[i+3] = madd2(m, q3, t[i+3], C)
-		C, t[i+4] = madd2(m, q4, t[i+4], C)
-		C, t[i+5] = madd2(m, q5, t[i+5], C)
-		C, t[i+6] = madd2(m, q6, t[i+6], C)
-		C, t[i+7] = madd2(m, q7, t[i+7], C)
-		C, t[i+8] = madd2(m, q8, t[i+8], C)
-		C, t[i+9] = madd2(m, q9, t[i+9], C)
-		C, t[i+10] = madd2(m, q10, t[i+10], C)
-		C, t[i+11] = madd2(m, q11, t[i+11], C)
-
-		t[i+Limbs] += C
-	}
-	{
-		const i = 2
-		m = t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, t[i+1] = madd2(m, q1, t[i+1], C)
-		C, t[i+2] = madd2(m, q2, t[i+2], C)
-		C, t[i+3] = madd2(m, q3, t[i+3], C)
-		C, t[i+4] = madd2(m, q4, t[i+4], C)
-		C, t[i+5] = madd2(m, q5, t[i+5], C)
-		C, t[i+6] = madd2(m, q6, t[i+6], C)
-		C, t[i+7] = madd2(m, q7, t[i+7], C)
-		C, t[i+8] = madd2(m, q8, t[i+8], C)
-		C, t[i+9] = madd2(m, q9, t[i+9], C)
-		C, t[i+10] = madd2(m, q10, t[i+10], C)
-		C, t[i+11] = madd2(m, q11, t[i+11], C)
-
-		t[i+Limbs] += C
-	}
-	{
-		const i = 3
-		m = t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, t[i+1] = madd2(m, q1, t[i+1], C)
-		C, t[i+2] = madd2(m, q2, t[i+2], C)
-		C, t[i+3] = madd2(m, q3, t[i+3], C)
-		C, t[i+4] = madd2(m, q4, t[i+4], C)
-		C, t[i+5] = madd2(m, q5, t[i+5], C)
-		C, t[i+6] = madd2(m, q6, t[i+6], C)
-		C, t[i+7] = madd2(m, q7, t[i+7], C)
-		C, t[i+8] = madd2(m, q8, t[i+8], C)
-		C, t[i+9] = madd2(m, q9, t[i+9], C)
-		C, t[i+10] = madd2(m, q10, t[i+10], C)
-		C, t[i+11] = madd2(m, q11, t[i+11], C)
-
-		t[i+Limbs] += C
-	}
-	{
-		const i = 4
-		m = t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, t[i+1] = madd2(m, q1, t[i+1], C)
-		C, t[i+2] = madd2(m, q2, t[i+2], C)
-		C, t[i+3] = madd2(m, q3, t[i+3], C)
-		C, t[i+4] = madd2(m, q4, t[i+4], C)
-		C, t[i+5] = madd2(m, q5, t[i+5], C)
-		C, t[i+6] = madd2(m, q6, t[i+6], C)
-		C, t[i+7] = madd2(m, q7, t[i+7], C)
-		C, t[i+8] = madd2(m, q8, t[i+8], C)
-		C, t[i+9] = madd2(m, q9, t[i+9], C)
-		C, t[i+10] = madd2(m, q10, t[i+10], C)
-		C, t[i+11] = madd2(m, q11, t[i+11], C)
-
-		t[i+Limbs] += C
-	}
-	{
-		const i = 5
-		m = t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, t[i+1] = madd2(m, q1, t[i+1], C)
-		C, t[i+2] = madd2(m, q2, t[i+2], C)
-		C, t[i+3] = madd2(m, q3, t[i+3], C)
-		C, t[i+4] = madd2(m, q4, t[i+4], C)
-		C, t[i+5] = madd2(m, q5, t[i+5], C)
-		C, t[i+6] = madd2(m, q6, t[i+6], C)
-		C, t[i+7] = madd2(m, q7, t[i+7], C)
-		C, t[i+8] = madd2(m, q8, t[i+8], C)
-		C, t[i+9] = madd2(m, q9, t[i+9], C)
-		C, t[i+10] = madd2(m, q10, t[i+10], C)
-		C, t[i+11] = madd2(m, q11, t[i+11], C)
-
-		t[i+Limbs] += C
-	}
-	{
-		const i = 6
-		m = t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, t[i+1] = madd2(m, q1, t[i+1], C)
-		C, t[i+2] = madd2(m, q2, t[i+2], C)
-		C, t[i+3] = madd2(m, q3, t[i+3], C)
-		C, t[i+4] = madd2(m, q4, t[i+4], C)
-		C, t[i+5] = madd2(m, q5, t[i+5], C)
-		C, t[i+6] = madd2(m, q6, t[i+6], C)
-		C, t[i+7] = madd2(m, q7, t[i+7], C)
-		C, t[i+8] = madd2(m, q8, t[i+8], C)
-		C, t[i+9] = madd2(m, q9, t[i+9], C)
-		C, t[i+10] = madd2(m, q10, t[i+10], C)
-		C, t[i+11] = madd2(m, q11, t[i+11], C)
-
-		t[i+Limbs] += C
-	}
-	{
-		const i = 7
-		m = t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, t[i+1] = madd2(m, q1, t[i+1], C)
-		C, t[i+2] = madd2(m, q2, t[i+2], C)
-		C, t[i+3] = madd2(m, q3, t[i+3], C)
-		C, t[i+4] = madd2(m, q4, t[i+4], C)
-		C, t[i+5] = madd2(m, q5, t[i+5], C)
-		C, t[i+6] = madd2(m, q6, t[i+6], C)
-		C, t[i+7] = madd2(m, q7, t[i+7], C)
-		C, t[i+8] = madd2(m, q8, t[i+8], C)
-		C, t[i+9] = madd2(m, q9, t[i+9], C)
-		C, t[i+10] = madd2(m, q10, t[i+10], C)
-		C, t[i+11] = madd2(m, q11, t[i+11], C)
-
-		t[i+Limbs] += C
-	}
-	{
-		const i = 8
-		m = t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, t[i+1] = madd2(m, q1, t[i+1], C)
-		C, t[i+2] = madd2(m, q2, t[i+2], C)
-		C, t[i+3] = madd2(m, q3, t[i+3], C)
-		C, t[i+4] = madd2(m, q4, t[i+4], C)
-		C, t[i+5] = madd2(m, q5, t[i+5], C)
-		C, t[i+6] = madd2(m, q6, t[i+6], C)
-		C, t[i+7] = madd2(m, q7, t[i+7], C)
-		C, t[i+8] = madd2(m, q8, t[i+8], C)
-		C, t[i+9] = madd2(m, q9, t[i+9], C)
-		C, t[i+10] = madd2(m, q10, t[i+10], C)
-		C, t[i+11] = madd2(m, q11, t[i+11], C)
-
-		t[i+Limbs] += C
-	}
-	{
-		const i = 9
-		m = t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, t[i+1] = madd2(m, q1, t[i+1], C)
-		C, t[i+2] = madd2(m, q2, t[i+2], C)
-		C, t[i+3] = madd2(m, q3, t[i+3], C)
-		C, t[i+4] = madd2(m, q4, t[i+4], C)
-		C, t[i+5] = madd2(m, q5, t[i+5], C)
-		C, t[i+6] = madd2(m, q6, t[i+6], C)
-		C, t[i+7] = madd2(m, q7, t[i+7], C)
-		C, t[i+8] = madd2(m, q8, t[i+8], C)
-		C, t[i+9] = madd2(m, q9, t[i+9], C)
-		C, t[i+10] = madd2(m, q10, t[i+10], C)
-		C, t[i+11] = madd2(m, q11, t[i+11], C)
-
-		t[i+Limbs] += C
-	}
-	{
-		const i = 10
-		m = t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, t[i+1] = madd2(m, q1, t[i+1], C)
-		C, t[i+2] = madd2(m, q2, t[i+2], C)
-		C, t[i+3] = madd2(m, q3, t[i+3], C)
-		C, t[i+4] = madd2(m, q4, t[i+4], C)
-		C, t[i+5] = madd2(m, q5, t[i+5], C)
-		C, t[i+6] = madd2(m, q6, t[i+6], C)
-		C, t[i+7] = madd2(m, q7, t[i+7], C)
-		C, t[i+8] = madd2(m, q8, t[i+8], C)
-		C, t[i+9] = madd2(m, q9, t[i+9], C)
-		C, t[i+10] = madd2(m, q10, t[i+10], C)
-		C, t[i+11] = madd2(m, q11, t[i+11], C)
-
-		t[i+Limbs] += C
-	}
-	{
-		const i = 11
-		m := t[i] * qInvNeg
-
-		C = madd0(m, q0, t[i+0])
-		C, z[0] = madd2(m, q1, t[i+1], C)
-		C, z[1] = madd2(m, q2, t[i+2], C)
-		C, z[2] = madd2(m, q3, t[i+3], C)
-		C, z[3] = madd2(m, q4, t[i+4], C)
-		C, z[4] = madd2(m, q5, t[i+5], C)
-		C, z[5] = madd2(m, q6, t[i+6], C)
-		C, z[6] = madd2(m, q7, t[i+7], C)
-		C, z[7] = madd2(m, q8, t[i+8], C)
-		C, z[8] = madd2(m, q9, t[i+9], C)
-		C, z[9] = madd2(m, q10, t[i+10], C)
-		z[11], z[10] = madd2(m, q11, t[i+11], C)
-	}
-
-	// if z ⩾ q → z -= q
-	if !z.smallerThanModulus() {
-		var b uint64
-		z[0], b = bits.Sub64(z[0], q0, 0)
-		z[1], b = bits.Sub64(z[1], q1, b)
-		z[2], b = bits.Sub64(z[2], q2, b)
-		z[3], b = bits.Sub64(z[3], q3, b)
-		z[4], b = bits.Sub64(z[4], q4, b)
-		z[5], b = bits.Sub64(z[5], q5, b)
-		z[6], b = bits.Sub64(z[6], q6, b)
-		z[7], b = bits.Sub64(z[7], q7, b)
-		z[8], b = bits.Sub64(z[8], q8, b)
-		z[9], b = bits.Sub64(z[9], q9, b)
-		z[10], b = bits.Sub64(z[10], q10, b)
-		z[11], _ = bits.Sub64(z[11], q11, b)
-	}
-	// </standard SOS>
-
-	if mustNeg {
-		// We have computed ( 2⁶³ r + X ) r⁻¹ = 2⁶³ + X r⁻¹ instead
-		var b uint64
-		z[0], b = bits.Sub64(z[0], signBitSelector, 0)
-		z[1], b = bits.Sub64(z[1], 0, b)
-		z[2], b = bits.Sub64(z[2], 0, b)
-		z[3], b = bits.Sub64(z[3], 0, b)
-		z[4], b = bits.Sub64(z[4], 0, b)
-		z[5], b = bits.Sub64(z[5], 0, b)
-		z[6], b = bits.Sub64(z[6], 0, b)
-		z[7], b = bits.Sub64(z[7], 0, b)
-		z[8], b = bits.Sub64(z[8], 0, b)
-		z[9], b = bits.Sub64(z[9], 0, b)
-		z[10], b = bits.Sub64(z[10], 0, b)
-		z[11], b = bits.Sub64(z[11], 0, b)
-
-		// Occurs iff x == 0 && xHi < 0, i.e. X = rX' for -2⁶³ ≤ X' < 0
-
-		if b != 0 {
-			// z[11] = -1
-			// negative: add q
-			const neg1 = 0xFFFFFFFFFFFFFFFF
-
-			var carry uint64
-
-			z[0], carry = bits.Add64(z[0], q0, 0)
-			z[1], carry = bits.Add64(z[1], q1, carry)
-			z[2], carry = bits.Add64(z[2], q2, carry)
-			z[3], carry = bits.Add64(z[3], q3, carry)
-			z[4], carry = bits.Add64(z[4], q4, carry)
-			z[5], carry = bits.Add64(z[5], q5, carry)
-			z[6], carry = bits.Add64(z[6], q6, carry)
-			z[7], carry = bits.Add64(z[7], q7, carry)
-			z[8], carry = bits.Add64(z[8], q8, carry)
-			z[9], carry = bits.Add64(z[9], q9, carry)
-			z[10], carry = bits.Add64(z[10], q10, carry)
-			z[11], _ = bits.Add64(neg1, q11, carry)
-		}
-	}
-}
-
 const (
 	updateFactorsConversionBias    int64 = 0x7fffffff7fffffff // (2³¹ - 1)(2³² + 1)
 	updateFactorIdentityMatrixRow0       = 1
@@ -2586,34 +2282,6 @@ func negL(x *Element, xHi uint64) uint64 {
 	return xHi
 }
 
-// mulWNonModular multiplies by one word in non-montgomery, without reducing
-func (z *Element) mulWNonModular(x *Element, y int64) uint64 {
-
-	// w := abs(y)
-	m := y >> 63
-	w := uint64((y ^ m) - m)
-
-	var c uint64
-	c, z[0] = bits.Mul64(x[0], w)
-	c, z[1] = madd1(x[1], w, c)
-	c, z[2] = madd1(x[2], w, c)
-	c, z[3] = madd1(x[3], w, c)
-	c, z[4] = madd1(x[4], w, c)
-	c, z[5] = madd1(x[5], w, c)
-	c, z[6] = madd1(x[6], w, c)
-	c, z[7] = madd1(x[7], w, c)
-	c, z[8] = madd1(x[8], w, c)
-	c, z[9] = madd1(x[9], w, c)
-	c, z[10] = madd1(x[10], w, c)
-	c, z[11] = madd1(x[11], w, c)
-
-	if y < 0 {
-		c = negL(z, c)
-	}
-
-	return c
-}
-
 // linearCombNonModular computes a linear combination without modular reduction
 func (z *Element) linearCombNonModular(x *Element, xC int64, y *Element, yC int64) uint64 {
 	var yTimes Element
@@ -0,0 +1,161 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"math/bits"
+)
+
+var (
+	ErrInvalidConfig  = errors.New("invalid FRI configuration")
+	ErrConfigMismatch = errors.New("the proof was not built with the configuration of the verifier")
+	ErrGrinding       = errors.New("the proof of work nonce is invalid")
+	ErrProofShape     = errors.New("the proof does not have the expected shape")
+	ErrUnknownIOPP    = errors.New("iopp name is not recognized")
+)
+
+// maxGrindingBits maximum number of bits of proof of work
+const maxGrindingBits = 32
+
+// Config parameters of a FRI IOPP.
+type Config struct {
+
+	// BlowupFactor ρ⁻¹ = size_code_word/size_polynomial, in {2, 4, 8, 16}.
+	BlowupFactor int
+
+	// Arity number of evaluations folded at each step, in {2, 4, 8, 16}.
+	Arity int
+
+	// NbQueries number of positions queried by the verifier.
+	NbQueries int
+
+	// GrindingBits number of leading zero bits of the proof of work the prover
+	// computes before the queries are sampled. 0 disables grinding.
+	GrindingBits int
+}
+
+// DefaultConfig returns the configuration of the radix 2 FRI returned by New:
+// blowup factor 8, radix 2 folding, a single query and no grinding.
+func DefaultConfig() Config {
+	return Config{
+		BlowupFactor: rho,
+		Arity:        2,
+		NbQueries:    nbRounds,
+	}
+}
+
+// NewConfig returns the configuration reaching securityBits bits of conjectured security
+// with the given blowup factor, folding arity and proof of work.
+//
+// Under the usual conjecture on the soundness of FRI (cf ethSTARK documentation, §5.10),
+// each query brings log₂(blowupFactor) bits of security and the proof of work grindingBits
+// bits, so that NbQueries = ⌈(securityBits - grindingBits)/log₂(blowupFactor)⌉.
+func NewConfig(blowupFactor, arity, securityBits, grindingBits int) (Config, error) {
+	if !isSupportedPowerOfTwo(blowupFactor) {
+		return Config{}, fmt.Errorf("%w: blowup factor %d", ErrInvalidConfig, blowupFactor)
+	}
+	if securityBits <= grindingBits {
+		return Config{}, fmt.Errorf("%w: %d bits of security with %d bits of grinding", ErrInvalidConfig, securityBits, grindingBits)
+	}
+	logBlowup := bits.TrailingZeros(uint(blowupFactor))
+	res := Config{
+		BlowupFactor: blowupFactor,
+		Arity:        arity,
+		NbQueries:    (securityBits - grindingBits + logBlowup - 1) / logBlowup,
+		GrindingBits: grindingBits,
+	}
+	return res, res.check()
+}
+
+// check returns an error if the configuration is not supported
+func (cfg Config) check() error {
+	if !isSupportedPowerOfTwo(cfg.BlowupFactor) {
+		return fmt.Errorf("%w: blowup factor %d", ErrInvalidConfig, cfg.BlowupFactor)
+	}
+	if !isSupportedPowerOfTwo(cfg.Arity) {
+		return fmt.Errorf("%w: arity %d", ErrInvalidConfig, cfg.Arity)
+	}
+	if cfg.NbQueries < 1 {
+		return fmt.Errorf("%w: %d queries", ErrInvalidConfig, cfg.NbQueries)
+	}
+	if cfg.GrindingBits < 0 || cfg.GrindingBits > maxGrindingBits {
+		return fmt.Errorf("%w: %d bits of grinding", ErrInvalidConfig, cfg.GrindingBits)
+	}
+	return nil
+}
+
+// marshal returns the encoding of the configuration, bound to the Fiat Shamir transcript
+func (cfg Config) marshal() []byte {
+	res := make([]byte, 16)
+	binary.BigEndian.PutUint32(res[0:], uint32(cfg.BlowupFactor))
+	binary.BigEndian.PutUint32(res[4:], uint32(cfg.Arity))
+	binary.BigEndian.PutUint32(res[8:], uint32(cfg.NbQueries))
+	binary.BigEndian.PutUint32(res[12:], uint32(cfg.GrindingBits))
+	return res
+}
+
+// isSupportedPowerOfTwo returns true if n ∈ {2, 4, 8, 16}
+func isSupportedPowerOfTwo(n int) bool {
+	return n >= 2 && n <= 16 && n&(n-1) == 0
+}
+
+// NewWithConfig creates a new IOPP capable to handle degree(size) polynomials, with
+// the given parameters. With DefaultConfig, it returns the same IOPP as New.
+func (iopp IOPP) NewWithConfig(size uint64, h hash.Hash, cfg Config) (Iopp, error) {
+	if err := cfg.check(); err != nil {
+		return nil, err
+	}
+	switch iopp {
+	case RADIX_2_FRI:
+		if cfg == DefaultConfig() {
+			return newRadixTwoFri(size, h), nil
+		}
+		return newConfigurableFri(size, h, cfg)
+	default:
+		return nil, ErrUnknownIOPP
+	}
+}
+
+// checkGrinding returns true if H(seed ∥ nonce) starts with nbBits zero bits
+func checkGrinding(h hash.Hash, seed []byte, nonce uint64, nbBits int) bool {
+	var bNonce [8]byte
+	binary.BigEndian.PutUint64(bNonce[:], nonce)
+	h.Reset()
+	h.Write(seed)
+	h.Write(bNonce[:])
+	digest := h.Sum(nil)
+	h.Reset()
+
+	for i := 0; nbBits > 0; i++ {
+		if i >= len(digest) {
+			return false
+		}
+		if nbBits < 8 {
+			return bits.LeadingZeros8(digest[i]) >= nbBits
+		}
+		if digest[i] != 0 {
+			return false
+		}
+		nbBits -= 8
+	}
+	return true
+}
+
+// grind returns the smallest nonce such that H(seed ∥ nonce) starts with nbBits zero bits
+func grind(h hash.Hash, seed []byte, nbBits int) uint64 {
+	if nbBits == 0 {
+		return 0
+	}
+	nonce := uint64(0)
+	for !checkGrinding(h, seed, nonce, nbBits) {
+		nonce++
+	}
+	return nonce
+}
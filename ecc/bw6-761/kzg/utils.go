@@ -28,10 +28,7 @@ func ToLagrangeG1(coeffs []curve.G1Affine) ([]curve.G1Affine, error) {
 	}
 	size := len(coeffs)
 
-	numCPU := uint64(runtime.NumCPU())
-	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(numCPU)) << 1
-
-	twiddlesInv, err := computeTwiddlesInv(size)
+	twiddlesInv, err := computeTwiddles(size, true)
 	if err != nil {
 		return nil, err
 	}
@@ -42,10 +39,7 @@ func ToLagrangeG1(coeffs []curve.G1Affine) ([]curve.G1Affine, error) {
 		jCoeffs[i].FromAffine(&coeffs[i])
 	}
 
-	difFFTG1(jCoeffs, twiddlesInv, 0, maxSplits, nil)
-
-	// TODO @gbotrel generify the cobra bitreverse function, benchmark it and use it everywhere
-	bitReverse(jCoeffs)
+	fftG1(jCoeffs, twiddlesInv)
 
 	var invBigint big.Int
 	var frCardinality fr.Element
@@ -63,17 +57,38 @@ func ToLagrangeG1(coeffs []curve.G1Affine) ([]curve.G1Affine, error) {
 	return curve.BatchJacobianToAffineG1(jCoeffs), nil
 }
 
-func computeTwiddlesInv(cardinality int) ([]*big.Int, error) {
+// fftG1 computes in place the FFT over G₁ of a, in natural order, using the twiddles
+// returned by computeTwiddles. The result is not scaled by 1/len(a).
+func fftG1(a []curve.G1Jac, twiddles []*big.Int) {
+	if len(a) == 1 {
+		return
+	}
+	numCPU := uint64(runtime.NumCPU())
+	maxSplits := bits.TrailingZeros64(ecc.NextPowerOfTwo(numCPU)) << 1
+
+	difFFTG1(a, twiddles, 0, maxSplits, nil)
+
+	// TODO @gbotrel generify the cobra bitreverse function, benchmark it and use it everywhere
+	bitReverse(a)
+}
+
+// computeTwiddles returns the powers of the generator of order cardinality
+// used by difFFTG1, or of its inverse if inverse is set.
+func computeTwiddles(cardinality int, inverse bool) ([]*big.Int, error) {
 	generator, err := fr.Generator(uint64(cardinality))
 	if err != nil {
 		return nil, err
 	}
 
-	// inverse the generator
-	generator.Inverse(&generator)
+	if inverse {
+		generator.Inverse(&generator)
+	}
 
 	// nb fft stages
 	nbStages := uint64(bits.TrailingZeros64(uint64(cardinality)))
+	if nbStages == 0 {
+		return []*big.Int{new(big.Int).SetUint64(1)}, nil
+	}
 
 	r := make([]*big.Int, 1+(1<<(nbStages-1)))
 
@@ -0,0 +1,566 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecfft
+
+import (
+	"math/big"
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/ecc/grumpkin/fr"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// MaxLogSize is the base 2 logarithm of the size of the largest supported domain
+const MaxLogSize = 20
+
+// E: y² = x³ + A·x + B, G is a point of order 2^MaxLogSize of E and R is a point of E
+// such that 2R ∉ ⟨G⟩ and such that no point of the coset R + ⟨G⟩ has x-coordinate 0.
+const (
+	curveA = "5345898356124836417854137986892891506141698485931035146710647184426305283643"
+	curveB = "6382134949927946934497553935537177052696697626179525225938990228597696284086"
+	gX     = "9522076114175200913365826010455705249101634961941833020033041917788147605754"
+	gY     = "18229980359075651317107575004345288143797847589990209590597296051461268800887"
+	rX     = "1930019627613737044235858872457654827803142546894649182473853636379779062622"
+	rY     = "21365817140873897150956750241738441916203115294155180849309875249546251200650"
+)
+
+// Domain is an ECFFT evaluation domain of size n = 2ᵏ, the x-coordinates
+//
+//	L₀[j] = x(R + j·Gₙ), 0 ⩽ j < n
+//
+// where Gₙ = 2^(MaxLogSize-k)·G has order n. The evaluations on the domain are in this order.
+//
+// The 2-isogeny ψ₀ of kernel ⟨(n/2)·Gₙ⟩ acts on the x-coordinates as the rational
+// function ψ₀(x) = x + v₀/(x - t₀) of degree 2, and maps L₀ to L₁ of size n/2, with
+// ψ₀(L₀[j]) = ψ₀(L₀[j+n/2]) = L₁[j]. Iterating on the image curves gives the level
+// sets L₀, L₁, …, Lₖ. The polynomials are decomposed along these maps (see Extend).
+type Domain struct {
+	Cardinality uint64
+
+	// levels[i] = Lᵢ, of size n/2ⁱ
+	levels [][]fr.Element
+
+	// kernels[i] = tᵢ, the x-coordinate of the point of order 2 in the kernel of ψᵢ
+	kernels []fr.Element
+
+	// towers[s] is the domain of size n/2ˢ of points L₀[2ˢ·j], with the level sets Lᵢ[2ˢ·j];
+	// towers[s+1] is the half of towers[s] with the even indices.
+	towers []*tower
+}
+
+// NewDomain returns an ECFFT domain of size the smallest power of 2 ⩾ m.
+// It panics if m > 2^MaxLogSize.
+func NewDomain(m uint64) *Domain {
+	n := ecc.NextPowerOfTwo(m)
+	k := bits.TrailingZeros64(n)
+	if k > MaxLogSize {
+		panic("ecfft: m is too big")
+	}
+	d := &Domain{Cardinality: n}
+	d.computeLevels(k)
+
+	d.towers = make([]*tower, k+1)
+	for s := range d.towers {
+		d.towers[s] = &tower{domain: d, s: s}
+	}
+	// the tables of Extend are computed now, the others on first use
+	d.towers[0].init()
+
+	return d
+}
+
+// Points returns a copy of the points of the domain, in the order of the evaluations
+func (d *Domain) Points() []fr.Element {
+	res := make([]fr.Element, d.Cardinality)
+	copy(res, d.levels[0])
+	return res
+}
+
+// Enter sets a to the evaluations on the domain of the polynomial of coefficients a
+// (in the monomial basis, constant term first).
+// It panics if len(a) is not the size of the domain.
+func (d *Domain) Enter(a []fr.Element) {
+	d.checkSize(a, d.Cardinality)
+	d.towers[0].enter(a)
+}
+
+// Exit sets a to the coefficients of the polynomial of degree < n whose evaluations
+// on the domain are a. It is the inverse of Enter.
+// It panics if len(a) is not the size of the domain.
+func (d *Domain) Exit(a []fr.Element) {
+	d.checkSize(a, d.Cardinality)
+	d.towers[0].exit(a)
+}
+
+// Extend returns the evaluations on the odd points L₀[2j+1] of the polynomial of
+// degree < n/2 whose evaluations on the even points L₀[2j] are evals.
+// It panics if len(evals) is not n/2.
+func (d *Domain) Extend(evals []fr.Element) []fr.Element {
+	d.checkSize(evals, d.Cardinality/2)
+	res := make([]fr.Element, len(evals))
+	d.towers[0].extend(res, evals, 0, 0)
+	return res
+}
+
+// Reduce returns the evaluations on the even points L₀[2j] of P mod X^(n/2), where P is
+// the polynomial of degree < n whose evaluations on the domain are evals.
+// It panics if len(evals) is not the size of the domain.
+func (d *Domain) Reduce(evals []fr.Element) []fr.Element {
+	d.checkSize(evals, d.Cardinality)
+	if d.Cardinality == 1 {
+		// P mod X⁰ = 0
+		return make([]fr.Element, 1)
+	}
+	t := d.towers[0]
+	t.initReduce()
+	y0, y1 := deinterleave(evals)
+	return t.reduce(y0, y1)
+}
+
+func (d *Domain) checkSize(a []fr.Element, size uint64) {
+	if uint64(len(a)) != size || size == 0 {
+		panic("ecfft: invalid input size")
+	}
+}
+
+// computeLevels computes the level sets and the kernels of the isogenies, for n = 2ᵏ
+func (d *Domain) computeLevels(k int) {
+	var a fr.Element
+	var g, r affinePoint
+	setString(&a, curveA)
+	setString(&g.x, gX)
+	setString(&g.y, gY)
+	setString(&r.x, rX)
+	setString(&r.y, rY)
+
+	// Gₙ = 2^(MaxLogSize-k)·G
+	for i := k; i < MaxLogSize; i++ {
+		g.double(&g, &a)
+	}
+
+	// kernels[i] = x(2^(k-1-i)·Gₙ) on E, it is mapped to the point of order 2 of
+	// the i-th image curve by the isogenies ψ₀, …, ψᵢ₋₁ below.
+	d.kernels = make([]fr.Element, k)
+	p := g
+	for i := k - 1; i >= 0; i-- {
+		d.kernels[i] = p.x
+		if i > 0 {
+			p.double(&p, &a)
+		}
+	}
+
+	d.levels = make([][]fr.Element, k+1)
+	d.levels[0] = cosetAbscissas(&r, &g, int(d.Cardinality))
+
+	for i := 0; i < k; i++ {
+		t := d.kernels[i]
+
+		// ψᵢ(x) = x + v/(x - t), with v = 3t² + a
+		var v fr.Element
+		v.Square(&t)
+		fr.MulBy3(&v)
+		v.Add(&v, &a)
+
+		src := d.levels[i][:len(d.levels[i])/2]
+		dst := make([]fr.Element, len(src))
+		for j := range src {
+			dst[j].Sub(&src[j], &t)
+		}
+		dst = fr.BatchInvert(dst)
+		parallel.Execute(len(dst), func(start, end int) {
+			for j := start; j < end; j++ {
+				dst[j].Mul(&dst[j], &v).Add(&dst[j], &src[j])
+			}
+		}, nbTasks(len(dst)))
+		d.levels[i+1] = dst
+
+		for j := i + 1; j < k; j++ {
+			var den fr.Element
+			den.Sub(&d.kernels[j], &t).Inverse(&den)
+			den.Mul(&den, &v)
+			d.kernels[j].Add(&d.kernels[j], &den)
+		}
+
+		// the image curve is y² = x³ + (a - 5v)·x + b - 7t·v (Vélu)
+		fr.MulBy5(&v)
+		a.Sub(&a, &v)
+	}
+}
+
+// tower holds the precomputed tables of the domain of size L = n/2ˢ of points L₀[2ˢ·j]
+type tower struct {
+	domain *Domain
+	s      int
+
+	initOnce sync.Once
+	// levels[i] holds the tables of the level set Lᵢ[2ˢ·j] of size L/2ⁱ, for the sizes ⩾ 4
+	levels []level
+	// xN[j] = x^(L/2) for the points x of the tower
+	xN []fr.Element
+
+	reduceOnce sync.Once
+	// the tables of reduce, on S₀ (the even points) or S₁ (the odd points), with N = L/2:
+	// xNInv = 1/x^N on S₀, z0Inv = 1/Z₀ on S₁ where Z₀ is the vanishing polynomial of S₀,
+	// c = Z₀² mod X^N on S₀ (c0) and S₁ (c1).
+	xNInv, z0Inv, c0, c1 []fr.Element
+}
+
+// level holds the tables of a level set X of size m, whose points are mapped by ψ(x) = x + v/(x - t):
+// w[j] = (x[j] - t)^(m/4 - 1), wInv = 1/w and dInv[j] = 1/(x[j] - x[j + m/2]) for j < m/2.
+type level struct {
+	x, w, wInv, dInv []fr.Element
+}
+
+func (t *tower) size() int {
+	return int(t.domain.Cardinality >> t.s)
+}
+
+func (t *tower) next() *tower {
+	return t.domain.towers[t.s+1]
+}
+
+// init computes the tables of extend and enter
+func (t *tower) init() {
+	t.initOnce.Do(func() {
+		d := t.domain
+		L := t.size()
+		for i := 0; L>>i >= 4; i++ {
+			m := L >> i
+			var l level
+			l.x = make([]fr.Element, m)
+			l.w = make([]fr.Element, m)
+			for j := range l.x {
+				l.x[j] = d.levels[i][j<<t.s]
+			}
+			exponent := big.NewInt(int64(m/4 - 1))
+			parallel.Execute(m, func(start, end int) {
+				for j := start; j < end; j++ {
+					l.w[j].Sub(&l.x[j], &d.kernels[i])
+					l.w[j].Exp(l.w[j], exponent)
+				}
+			}, nbTasks(m))
+			l.wInv = fr.BatchInvert(l.w)
+			l.dInv = make([]fr.Element, m/2)
+			for j := range l.dInv {
+				l.dInv[j].Sub(&l.x[j], &l.x[j+m/2])
+			}
+			l.dInv = fr.BatchInvert(l.dInv)
+			t.levels = append(t.levels, l)
+		}
+
+		t.xN = make([]fr.Element, L)
+		logN := bits.TrailingZeros(uint(L)) - 1
+		for j := range t.xN {
+			t.xN[j] = d.levels[0][j<<t.s]
+			for range logN {
+				t.xN[j].Square(&t.xN[j])
+			}
+		}
+	})
+}
+
+// extend sets out to the evaluations on the half 1-h of the i-th level set X of the tower
+// of the polynomial of degree < len(in) whose evaluations on the half h are in.
+// The half h of X is the set of points X[2j+h].
+//
+// A polynomial P of degree < N = len(in) is written
+//
+//	P(X) = (P₀(ψ(X)) + X·P₁(ψ(X)))·(X - t)^(N/2 - 1)
+//
+// with P₀ and P₁ of degree < N/2. Since ψ(X[a]) = ψ(X[a+N]) = ψ(a), the values of P₀ and P₁
+// on the half h of the next level set follow from the ones of P on X[a] and X[a+N], for a = 2j+h.
+func (t *tower) extend(out, in []fr.Element, i, h int) {
+	N := len(in)
+	if N == 1 {
+		out[0] = in[0]
+		return
+	}
+	l := &t.levels[i]
+	q := N / 2
+
+	buf := make([]fr.Element, 2*N)
+	p0, p1 := buf[:q], buf[q:N]
+	r0, r1 := buf[N:N+q], buf[N+q:]
+
+	parallel.Execute(q, func(start, end int) {
+		var ya, yb fr.Element
+		for j := start; j < end; j++ {
+			a := 2*j + h
+			ya.Mul(&in[j], &l.wInv[a])
+			yb.Mul(&in[j+q], &l.wInv[a+N])
+			// P₀(ψ(a)) + X[a]·P₁(ψ(a)) = ya and P₀(ψ(a)) + X[a+N]·P₁(ψ(a)) = yb
+			p1[j].Sub(&ya, &yb).Mul(&p1[j], &l.dInv[a])
+			p0[j].Mul(&p1[j], &l.x[a])
+			p0[j].Sub(&ya, &p0[j])
+		}
+	}, nbTasks(q))
+
+	t.extend(r0, p0, i+1, h)
+	t.extend(r1, p1, i+1, h)
+
+	parallel.Execute(q, func(start, end int) {
+		for j := start; j < end; j++ {
+			a := 2*j + 1 - h
+			out[j].Mul(&r1[j], &l.x[a]).Add(&out[j], &r0[j]).Mul(&out[j], &l.w[a])
+			out[j+q].Mul(&r1[j], &l.x[a+N]).Add(&out[j+q], &r0[j]).Mul(&out[j+q], &l.w[a+N])
+		}
+	}, nbTasks(q))
+}
+
+// enter sets a to the evaluations on the tower of the polynomial of coefficients a.
+//
+// With P = U + X^N·V, U and V of degree < N = L/2, U and V are evaluated on S₀ (the next
+// tower) recursively, and extended to S₁.
+func (t *tower) enter(a []fr.Element) {
+	L := len(a)
+	if L == 1 {
+		return
+	}
+	t.init()
+	N := L / 2
+	u, v := a[:N], a[N:]
+	t.next().enter(u)
+	t.next().enter(v)
+
+	res := make([]fr.Element, L)
+	u1, v1 := res[:N], res[N:]
+	t.extend(u1, u, 0, 0)
+	t.extend(v1, v, 0, 0)
+	// the evaluations on S₀ are computed in place of u1 and v1
+	parallel.Execute(N, func(start, end int) {
+		for j := start; j < end; j++ {
+			var e, o fr.Element
+			e.Mul(&v[j], &t.xN[2*j]).Add(&e, &u[j])
+			o.Mul(&v1[j], &t.xN[2*j+1]).Add(&o, &u1[j])
+			u1[j], v1[j] = e, o
+		}
+	}, nbTasks(N))
+	for j := 0; j < N; j++ {
+		a[2*j], a[2*j+1] = u1[j], v1[j]
+	}
+}
+
+// exit sets a to the coefficients of the polynomial of degree < L whose evaluations on the tower are a.
+//
+// With P = U + X^N·V, U and V of degree < N = L/2, U = P mod X^N is evaluated on S₀ with reduce,
+// then V = (P - U)/X^N on S₀, and U and V are interpolated on S₀ (the next tower) recursively.
+func (t *tower) exit(a []fr.Element) {
+	L := len(a)
+	if L == 1 {
+		return
+	}
+	t.initReduce()
+	N := L / 2
+	y0, y1 := deinterleave(a)
+	u := t.reduce(y0, y1)
+	v := y1
+	parallel.Execute(N, func(start, end int) {
+		for j := start; j < end; j++ {
+			v[j].Sub(&y0[j], &u[j]).Mul(&v[j], &t.xNInv[j])
+		}
+	}, nbTasks(N))
+	t.next().exit(u)
+	t.next().exit(v)
+	copy(a[:N], u)
+	copy(a[N:], v)
+}
+
+// reduce returns the evaluations on S₀ of P mod X^N, N = L/2, where P of degree < L is given by
+// its evaluations y0 on S₀ and y1 on S₁. y0 and y1 are not modified.
+//
+// Let Z₀ be the vanishing polynomial of S₀ and P = Q·Z₀ + R₀ with deg R₀, deg Q < N.
+// Then P mod X^N = R₀ + M with M = Q·Z₀ mod X^N, and R₀ = P on S₀.
+// Writing M·Z₀ = Q·c + X^N·K with c = Z₀² mod X^N and deg K < N, we have K = -Q·c/X^N on S₀,
+// and M = (Q·c + X^N·K)/Z₀ on S₁. All the polynomials have degree < N, so that their
+// evaluations on S₀ and S₁ are related by extend.
+func (t *tower) reduce(y0, y1 []fr.Element) []fr.Element {
+	N := len(y0)
+	buf := make([]fr.Element, 3*N)
+	e, q0, q1 := buf[:N], buf[N:2*N], buf[2*N:]
+
+	// Q = (P - R₀)/Z₀ on S₁
+	t.extend(e, y0, 0, 0)
+	parallel.Execute(N, func(start, end int) {
+		for j := start; j < end; j++ {
+			q1[j].Sub(&y1[j], &e[j]).Mul(&q1[j], &t.z0Inv[j])
+		}
+	}, nbTasks(N))
+	t.extend(q0, q1, 0, 1)
+
+	// K = -Q·c/X^N on S₀, stored in q0
+	parallel.Execute(N, func(start, end int) {
+		for j := start; j < end; j++ {
+			q0[j].Mul(&q0[j], &t.c0[j]).Mul(&q0[j], &t.xNInv[j]).Neg(&q0[j])
+		}
+	}, nbTasks(N))
+	t.extend(e, q0, 0, 0)
+
+	// M = (Q·c + X^N·K)/Z₀ on S₁, stored in q1
+	parallel.Execute(N, func(start, end int) {
+		for j := start; j < end; j++ {
+			var xk fr.Element
+			xk.Mul(&e[j], &t.xN[2*j+1])
+			q1[j].Mul(&q1[j], &t.c1[j]).Add(&q1[j], &xk).Mul(&q1[j], &t.z0Inv[j])
+		}
+	}, nbTasks(N))
+
+	res := make([]fr.Element, N)
+	t.extend(res, q1, 0, 1)
+	for j := range res {
+		res[j].Add(&res[j], &y0[j])
+	}
+	return res
+}
+
+// initReduce computes the tables of reduce and exit
+func (t *tower) initReduce() {
+	t.reduceOnce.Do(func() {
+		t.init()
+		L := t.size()
+		N := L / 2
+		next := t.next()
+
+		// Z₀ = X^N + W with deg W < N, and W = -X^N on S₀
+		w := make([]fr.Element, N)
+		t.xNInv = make([]fr.Element, N)
+		for j := range w {
+			t.xNInv[j] = t.xN[2*j]
+			w[j].Neg(&t.xN[2*j])
+		}
+		t.xNInv = fr.BatchInvert(t.xNInv)
+
+		t.z0Inv = make([]fr.Element, N)
+		t.extend(t.z0Inv, w, 0, 0)
+		for j := range t.z0Inv {
+			t.z0Inv[j].Add(&t.z0Inv[j], &t.xN[2*j+1])
+		}
+		t.z0Inv = fr.BatchInvert(t.z0Inv)
+
+		// c = Z₀² mod X^N = W² mod X^N
+		next.exit(w)
+		t.c0 = next.lowProduct(w, w)
+		next.enter(t.c0)
+		t.c1 = make([]fr.Element, N)
+		t.extend(t.c1, t.c0, 0, 0)
+	})
+}
+
+// lowProduct returns the coefficients of f·g mod X^L, where f and g are given
+// by their coefficients and L = len(f) = len(g) is the size of the tower.
+//
+// With f = f₀ + X^H·f₁ and g = g₀ + X^H·g₁, H = L/2, we have
+// f·g mod X^L = f₀·g₀ + X^H·(f₀·g₁ + f₁·g₀ mod X^H), where deg f₀·g₀ < L.
+func (t *tower) lowProduct(f, g []fr.Element) []fr.Element {
+	L := len(f)
+	res := make([]fr.Element, L)
+	if L == 1 {
+		res[0].Mul(&f[0], &g[0])
+		return res
+	}
+	H := L / 2
+
+	ge := make([]fr.Element, L)
+	copy(res, f[:H])
+	copy(ge, g[:H])
+	t.enter(res)
+	t.enter(ge)
+	vRes := fr.Vector(res)
+	vRes.Mul(vRes, ge)
+	t.exit(res)
+
+	m0 := t.next().lowProduct(f[:H], g[H:])
+	m1 := t.next().lowProduct(f[H:], g[:H])
+	for j := 0; j < H; j++ {
+		res[H+j].Add(&res[H+j], &m0[j]).Add(&res[H+j], &m1[j])
+	}
+	return res
+}
+
+// deinterleave returns the entries of a with even and odd indices
+func deinterleave(a []fr.Element) (even, odd []fr.Element) {
+	N := len(a) / 2
+	buf := make([]fr.Element, 2*N)
+	even, odd = buf[:N], buf[N:]
+	for j := 0; j < N; j++ {
+		even[j], odd[j] = a[2*j], a[2*j+1]
+	}
+	return
+}
+
+// affinePoint is a point of a curve y² = x³ + a·x + b, different from the point at infinity
+type affinePoint struct {
+	x, y fr.Element
+}
+
+// double sets p = 2q; q must not be a point of order 2
+func (p *affinePoint) double(q *affinePoint, a *fr.Element) *affinePoint {
+	// λ = (3x² + a)/2y, x₃ = λ² - 2x, y₃ = λ·(x - x₃) - y
+	var lambda, den, x, y fr.Element
+	lambda.Square(&q.x)
+	fr.MulBy3(&lambda)
+	lambda.Add(&lambda, a)
+	den.Double(&q.y).Inverse(&den)
+	lambda.Mul(&lambda, &den)
+	x.Square(&lambda).Sub(&x, &q.x).Sub(&x, &q.x)
+	y.Sub(&q.x, &x).Mul(&y, &lambda).Sub(&y, &q.y)
+	p.x, p.y = x, y
+	return p
+}
+
+// cosetAbscissas returns x(r + j·g) for 0 ⩽ j < n; the points r + j·g must be different from
+// the point at infinity, g and -g. The points are accumulated in Jacobian coordinates.
+func cosetAbscissas(r, g *affinePoint, n int) []fr.Element {
+	X := make([]fr.Element, n)
+	Z := make([]fr.Element, n)
+	var x, y, z fr.Element
+	x.Set(&r.x)
+	y.Set(&r.y)
+	z.SetOne()
+	for j := 0; j < n; j++ {
+		X[j], Z[j] = x, z
+		if j == n-1 {
+			break
+		}
+		// (x, y, z) += g, madd-2007-bl
+		var zz, u2, s2, hh, h, i, jj, rr, v fr.Element
+		zz.Square(&z)
+		u2.Mul(&g.x, &zz)
+		s2.Mul(&g.y, &z).Mul(&s2, &zz)
+		h.Sub(&u2, &x)
+		hh.Square(&h)
+		i.Double(&hh).Double(&i)
+		jj.Mul(&h, &i)
+		rr.Sub(&s2, &y).Double(&rr)
+		v.Mul(&x, &i)
+		x.Square(&rr).Sub(&x, &jj).Sub(&x, &v).Sub(&x, &v)
+		jj.Mul(&jj, &y).Double(&jj)
+		y.Sub(&v, &x).Mul(&y, &rr).Sub(&y, &jj)
+		z.Add(&z, &h).Square(&z).Sub(&z, &zz).Sub(&z, &hh)
+	}
+
+	// x = X/Z²
+	Z = fr.BatchInvert(Z)
+	parallel.Execute(n, func(start, end int) {
+		for j := start; j < end; j++ {
+			Z[j].Square(&Z[j])
+			X[j].Mul(&X[j], &Z[j])
+		}
+	}, nbTasks(n))
+	return X
+}
+
+func setString(z *fr.Element, s string) {
+	if _, err := z.SetString(s); err != nil {
+		panic(err)
+	}
+}
+
+func nbTasks(n int) int {
+	return min(n/(1<<10)+1, 64)
+}
@@ -48,7 +48,8 @@ type PublicKey struct {
 // PrivateKey represents an ECDSA private key
 type PrivateKey struct {
 	PublicKey PublicKey
-	scalar    [sizeFr]byte // secret scalar, in big Endian
+	scalar    [sizeFr]byte     // secret scalar, in big Endian
+	nonceHash func() hash.Hash // if not nil, the nonces are deterministic (RFC 6979)
 }
 
 // Signature represents an ECDSA signature
@@ -205,6 +206,38 @@ func nonce(privateKey *PrivateKey, hash []byte) (csprng *cipher.StreamReader, er
 	return csprng, err
 }
 
+// nonces returns the generator of the nonces k for the signature of hash. The nonces are
+// derived with the HMAC-DRBG of RFC 6979 if it was selected with SetDeterministicNonces, and
+// are random otherwise.
+func (privKey *PrivateKey) nonces(hash []byte) func() (*big.Int, error) {
+	if privKey.nonceHash != nil {
+		x := new(big.Int).SetBytes(privKey.scalar[:sizeFr])
+		drbg := newHMACDRBG(privKey.nonceHash, order, x, hash)
+		return func() (*big.Int, error) {
+			return drbg.next(), nil
+		}
+	}
+	return func() (*big.Int, error) {
+		csprng, err := nonce(privKey, hash)
+		if err != nil {
+			return nil, err
+		}
+		return randFieldElement(csprng)
+	}
+}
+
+// hashMessage returns the hash of the message with hFunc, or the message if hFunc is nil
+func hashMessage(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc == nil {
+		return message, nil
+	}
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, err
+	}
+	return hFunc.Sum(nil), nil
+}
+
 // Equal compares 2 public keys
 func (pub *PublicKey) Equal(x signature.PublicKey) bool {
 	xx, ok := x.(*PublicKey)
@@ -225,7 +258,7 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 
 // SignForRecover performs the ECDSA signature and returns public key recovery information
 //
-// k ← 𝔽r (random)
+// k ← 𝔽r (random, or deterministic with SetDeterministicNonces)
 // P = k ⋅ g1Gen
 // r = x_P (mod order)
 // s = k⁻¹ . (m + sk ⋅ r)
@@ -233,17 +266,24 @@ func (privKey *PrivateKey) Public() signature.PublicKey {
 //
 // SEC 1, Version 2.0, Section 4.1.3
 func (privKey *PrivateKey) SignForRecover(message []byte, hFunc hash.Hash) (v uint, r, s *big.Int, err error) {
+	// compute the hash of the message as an integer
+	hramBin, err := hashMessage(message, hFunc)
+	if err != nil {
+		return 0, nil, nil, err
+	}
+	return privKey.signForRecover(HashToInt(hramBin), privKey.nonces(hramBin))
+}
+
+// signForRecover signs the hash m, with the nonces returned by nextNonce. v only depends on the
+// nonce of the signature, not on the candidates rejected before it.
+func (privKey *PrivateKey) signForRecover(m *big.Int, nextNonce func() (*big.Int, error)) (v uint, r, s *big.Int, err error) {
 	r, s = new(big.Int), new(big.Int)
 
 	scalar, kInv := new(big.Int), new(big.Int)
 	scalar.SetBytes(privKey.scalar[:sizeFr])
 	for {
 		for {
-			csprng, err := nonce(privKey, message)
-			if err != nil {
-				return 0, nil, nil, err
-			}
-			k, err := randFieldElement(csprng)
+			k, err := nextNonce()
 			if err != nil {
 				return 0, nil, nil, err
 			}
@@ -254,7 +294,7 @@ func (privKey *PrivateKey) SignForRecover(message []byte, hFunc hash.Hash) (v ui
 
 			P.X.BigInt(r)
 			// set how many times we overflow the scalar field
-			v |= (uint(new(big.Int).Div(r, order).Uint64())) << 1
+			v = (uint(new(big.Int).Div(r, order).Uint64())) << 1
 			// set if y is even or odd
 			v |= P.Y.BigInt(new(big.Int)).Bit(0)
 
@@ -265,22 +305,6 @@ func (privKey *PrivateKey) SignForRecover(message []byte, hFunc hash.Hash) (v ui
 		}
 		s.Mul(r, scalar)
 
-		var m *big.Int
-		if hFunc != nil {
-			// compute the hash of the message as an integer
-			dataToHash := make([]byte, len(message))
-			copy(dataToHash[:], message[:])
-			hFunc.Reset()
-			_, err := hFunc.Write(dataToHash[:])
-			if err != nil {
-				return 0, nil, nil, err
-			}
-			hramBin := hFunc.Sum(nil)
-			m = HashToInt(hramBin)
-		} else {
-			m = HashToInt(message)
-		}
-
 		s.Add(m, s).
 			Mul(kInv, s).
 			Mod(s, order) // order != 0
@@ -294,7 +318,7 @@ func (privKey *PrivateKey) SignForRecover(message []byte, hFunc hash.Hash) (v ui
 
 // Sign performs the ECDSA signature
 //
-// k ← 𝔽r (random)
+// k ← 𝔽r (random, or deterministic with SetDeterministicNonces)
 // P = k ⋅ g1Gen
 // r = x_P (mod order)
 // s = k⁻¹ . (m + sk ⋅ r)
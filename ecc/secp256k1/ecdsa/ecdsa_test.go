@@ -6,8 +6,12 @@
 package ecdsa
 
 import (
+	"bytes"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/sha256"
+	"encoding/hex"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
 	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
 	"math/big"
 	"testing"
@@ -51,8 +55,147 @@ func TestECDSA(t *testing.T) {
 		},
 	))
 
+	properties.Property("[SECP256K1] test the deterministic signing (RFC 6979)", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			privKey.SetDeterministicNonces(sha256.New)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing ECDSA")
+			hFunc := sha256.New()
+			sig1, _ := privKey.Sign(msg, hFunc)
+			sig2, _ := privKey.Sign(msg, hFunc)
+			flag, _ := publicKey.Verify(sig1, msg, hFunc)
+
+			// the signature of another message differs
+			sig3, _ := privKey.Sign([]byte("testing ECDSA again"), hFunc)
+
+			return flag && bytes.Equal(sig1, sig2) && !bytes.Equal(sig1, sig3)
+		},
+	))
+
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
+
+// RFC 6979, Appendix A.2: the nonces of the signatures of "sample" and "test" with SHA-256 on the
+// NIST curves, which only depend on the order of the curve. The orders are shorter and longer
+// than the hash, and not a multiple of 8 bits for P-521.
+func TestRFC6979(t *testing.T) {
+	p192, _ := new(big.Int).SetString("FFFFFFFFFFFFFFFFFFFFFFFF99DEF836146BC9B1B4D22831", 16)
+	for _, v := range []struct {
+		curve     string
+		q         *big.Int
+		x, msg, k string
+	}{
+		{"P-192", p192, "6FAB034934E4C0FC9AE67F5B5659A9D7D1FEFD187EE09FD4", "sample", "32B1B6D7D42A05CB449065727A84804FB1A3E34D8F261496"},
+		{"P-224", elliptic.P224().Params().N, "F220266E1105BFE3083E03EC7A3A654651F45E37167E88600BF257C1", "sample", "AD3029E0278F80643DE33917CE6908C70A8FF50A411F06E41DEDFCDC"},
+		{"P-256", elliptic.P256().Params().N, "C9AFA9D845BA75166B5C215767B1D6934E50C3DB36E89B127B8A622B120F6721", "sample", "A6E3C57DD01ABE90086538398355DD4C3B17AA873382B0F24D6129493D8AAD60"},
+		{"P-256", elliptic.P256().Params().N, "C9AFA9D845BA75166B5C215767B1D6934E50C3DB36E89B127B8A622B120F6721", "test", "D16B6AE827F17175E040871A1C7EC3500192C4C92677336EC2537ACAEE0008E0"},
+		{"P-384", elliptic.P384().Params().N, "6B9D3DAD2E1B8C1C05B19875B6659F4DE23C3B667BF297BA9AA47740787137D896D5724E4C70A825F872C9EA60D2EDF5", "sample", "180AE9F9AEC5438A44BC159A1FCB277C7BE54FA20E7CF404B490650A8ACC414E375572342863C899F9F2EDF9747A9B60"},
+		{"P-521", elliptic.P521().Params().N, "0FAD06DAA62BA3B25D2FB40133DA757205DE67F5BB0018FEE8C86E1B68C7E75CAA896EB32F1F47C70855836A6D16FCC1466F6D8FBEC67DB89EC0C08B0E996B83538", "sample", "0EDF38AFCAAECAB4383358B34D67C9F2216C8382AAEA44A3DAD5FDC9C32575761793FEF24EB0FC276DFC4F6E3EC476752F043CF01415387470BCBD8678ED2C7E1A0"},
+	} {
+		x, _ := new(big.Int).SetString(v.x, 16)
+		h := sha256.Sum256([]byte(v.msg))
+		k := newHMACDRBG(sha256.New, v.q, x, h[:]).next()
+		if expected, _ := new(big.Int).SetString(v.k, 16); k.Cmp(expected) != 0 {
+			t.Fatalf("%s, %s: wrong nonce %X", v.curve, v.msg, k)
+		}
+	}
+}
+
+// The nonce of a deterministic signature, k = s⁻¹ ⋅ (m + sk ⋅ r), is the first output of the
+// HMAC-DRBG with the order of the curve.
+func TestDeterministicNonce(t *testing.T) {
+	privKey, _ := GenerateKey(rand.Reader)
+	privKey.SetDeterministicNonces(sha256.New)
+	sigBin, err := privKey.Sign([]byte("sample"), sha256.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		t.Fatal(err)
+	}
+	r, s := new(big.Int).SetBytes(sig.R[:sizeFr]), new(big.Int).SetBytes(sig.S[:sizeFr])
+
+	sk := new(big.Int).SetBytes(privKey.scalar[:sizeFr])
+	h := sha256.Sum256([]byte("sample"))
+	k := new(big.Int).Mul(sk, r)
+	k.Add(k, HashToInt(h[:])).
+		Mul(k, s.ModInverse(s, order)).
+		Mod(k, order)
+	if k.Cmp(newHMACDRBG(sha256.New, order, sk, h[:]).next()) != 0 {
+		t.Fatal("the nonce isn't the output of the HMAC-DRBG")
+	}
+}
+
+// Deterministic secp256k1 signatures with SHA-256, as published with the RFC 6979 implementations
+// of Bitcoin wallets. The signatures are normalized to s ≤ order/2.
+func TestDeterministicVectors(t *testing.T) {
+	for _, v := range []struct {
+		sk, msg, sig string
+	}{
+		{
+			"1",
+			"Satoshi Nakamoto",
+			"934b1ea10a4b3c1757e2b0c017d0b6143ce3c9a7e6a4a49860d7a6ab210ee3d82442ce9d2b916064108014783e923ec36b49743e2ffa1c4496f01a512aafd9e5",
+		},
+		{
+			"1",
+			"All those moments will be lost in time, like tears in rain. Time to die...",
+			"8600dbd41e348fe5c9465ab92d23e3db8b98b873beecd930736488696438cb6b547fe64427496db33bf66019dacbf0039c04199abb0122918601db38a72cfc21",
+		},
+		{
+			"fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364140",
+			"Satoshi Nakamoto",
+			"fd567d121db66e382991534ada77a6bd3106f0a1098c231e47993447cd6af2d06b39cd0eb1bc8603e159ef5c20a5c8ad685a45b06ce9bebed3f153d10d93bed5",
+		},
+		{
+			"f8b8af8ce3c7cca5e300d33939540c10d45ce001b8f252bfbc57ba0342904181",
+			"Alan Turing",
+			"7063ae83e7f62bbb171798131b4a0564b956930092b33b07b395615d9ec7e15c58dfcc1e00a35e1572f366ffe34ba0fc47db1e7189759b9fb233c5b05ab388ea",
+		},
+		{
+			"69ec59eaa1f4f2e36b639716b7c30ca86d9a5375c7b38d8918bd9c0ebc80ba64",
+			"Computer science is no more about computers than astronomy is about telescopes.",
+			"7186363571d65e084e7f02b0b77c3ec44fb1b257dee26274c38c928986fea45d0de0b38e06807e46bda1f1e293f4f6323e854c86d58abdd00c46c16441085df6",
+		},
+		{
+			"7246174ab1e92e9149c6e446fe194d072637",
+			"...if you aren't, at any given time, scandalized by code you wrote five or even three years ago, you're not learning anywhere near enough",
+			"fbfe5076a15860ba8ed00e75e9bd22e05d230f02a936b653eb55b61c99dda4870e68880ebb0050fe4312b1b1eb0899e1b82da89baa5b895f612619edf34cbd37",
+		},
+		{
+			"56916d0f9b31dc9b637f3",
+			"The question of whether computers can think is like the question of whether submarines can swim.",
+			"cde1302d83f8dd835d89aef803c74a119f561fbaef3eb9129e45f30de86abbf906ce643f5049ee1f27890467b77a6a8e11ec4661cc38cd8badf90115fbd03cef",
+		},
+	} {
+		sk, _ := new(big.Int).SetString(v.sk, 16)
+		expected, err := hex.DecodeString(v.sig)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var privKey PrivateKey
+		sk.FillBytes(privKey.scalar[:])
+		privKey.PublicKey.A.ScalarMultiplicationBase(sk)
+		privKey.SetDeterministicNonces(sha256.New)
+
+		_, r, s, err := privKey.SignForRecover([]byte(v.msg), sha256.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if s.Cmp(new(big.Int).Rsh(order, 1)) > 0 {
+			s.Sub(order, s)
+		}
+		if !bytes.Equal(r.FillBytes(make([]byte, sizeFr)), expected[:sizeFr]) ||
+			!bytes.Equal(s.FillBytes(make([]byte, sizeFr)), expected[sizeFr:]) {
+			t.Fatalf("%q: wrong signature", v.msg)
+		}
+	}
+}
 func TestRecoverPublicKey(t *testing.T) {
 	t.Parallel()
 	parameters := gopter.DefaultTestParameters()
@@ -76,9 +219,78 @@ func TestRecoverPublicKey(t *testing.T) {
 			return pk.Equal(&recovered)
 		},
 	))
+	properties.Property("[SECP256K1] test public key recover with deterministic nonces", prop.ForAll(
+		func() bool {
+			sk, err := GenerateKey(rand.Reader)
+			if err != nil {
+				return false
+			}
+			sk.SetDeterministicNonces(sha256.New)
+			pk := sk.PublicKey
+			msg := []byte("test")
+			v, r, s, err := sk.SignForRecover(msg, nil)
+			if err != nil {
+				return false
+			}
+			v2, r2, s2, err := sk.SignForRecover(msg, nil)
+			if err != nil || v != v2 || r.Cmp(r2) != 0 || s.Cmp(s2) != 0 {
+				return false
+			}
+			var recovered PublicKey
+			if err = recovered.RecoverFrom(msg, v, r, s); err != nil {
+				return false
+			}
+			return pk.Equal(&recovered)
+		},
+	))
 	properties.TestingRun(t, gopter.ConsoleReporter(false))
 }
 
+// The first nonce leads to s = 0 and is rejected: v must only describe the second one.
+func TestSignForRecoverRetry(t *testing.T) {
+	privKey, _ := GenerateKey(rand.Reader)
+	sk := new(big.Int).SetBytes(privKey.scalar[:sizeFr])
+
+	// k1 ⋅ g1Gen has an odd y, and k2 ⋅ g1Gen an even y
+	var P1, P2 secp256k1.G1Affine
+	var k1, k2 *big.Int
+	for k1 == nil || k2 == nil {
+		k, err := randFieldElement(rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var P secp256k1.G1Affine
+		P.ScalarMultiplicationBase(k)
+		if P.Y.BigInt(new(big.Int)).Bit(0) == 1 {
+			k1, P1 = k, P
+		} else {
+			k2, P2 = k, P
+		}
+	}
+
+	// m = -sk ⋅ r1, so that s = k1⁻¹ ⋅ (m + sk ⋅ r1) = 0
+	r1 := P1.X.BigInt(new(big.Int))
+	m := new(big.Int).Mul(sk, r1)
+	m.Neg(m).Mod(m, order)
+
+	nonces := []*big.Int{k1, k2}
+	v, r, _, err := privKey.signForRecover(m, func() (*big.Int, error) {
+		k := nonces[0]
+		nonces = nonces[1:]
+		return k, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	x2 := P2.X.BigInt(new(big.Int))
+	if r.Cmp(new(big.Int).Mod(x2, order)) != 0 {
+		t.Fatal("the signature must use the second nonce")
+	}
+	if expected := uint(new(big.Int).Div(x2, order).Uint64()) << 1; v != expected {
+		t.Fatalf("v = %d, expected %d", v, expected)
+	}
+}
+
 func TestNonMalleability(t *testing.T) {
 
 	// buffer too big
@@ -0,0 +1,15 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package ecfft provides the ECFFT [BCKL21] over 𝔽ᵣ: fast polynomial arithmetic on
+// evaluation domains built from an elliptic curve and a chain of 2-isogenies, for fields
+// whose multiplicative group has no large subgroup of order 2ᵏ.
+//
+// A Domain of size n converts between the coefficients and the evaluations of the polynomials
+// of degree < n (Enter and Exit) in O(n·log²(n)), and provides the two building blocks of these
+// conversions: Extend and Reduce, in O(n·log(n)).
+//
+// [BCKL21]: https://arxiv.org/abs/2107.08473
+package ecfft
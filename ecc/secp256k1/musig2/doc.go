@@ -0,0 +1,19 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package musig2 provides n-of-n Schnorr multi-signatures on the secp256k1 curve, as specified
+// in BIP-327 (MuSig2). The aggregated signatures are BIP-340 signatures for the aggregated
+// public key, and can be verified with the schnorr package.
+//
+// A signing session goes as follows:
+//  1. the public keys (33 bytes compressed points) are aggregated with KeyAgg, and optionally tweaked;
+//  2. each signer generates a secret and a public nonce with NonceGen, and sends the public nonce;
+//  3. the public nonces are aggregated with NonceAgg, and the session is created with NewSession;
+//  4. each signer computes a partial signature with Session.Sign, and sends it;
+//  5. the partial signatures are checked with Session.PartialSigVerify and aggregated with Session.PartialSigAgg.
+//
+// A secret nonce must never be used twice: Session.Sign erases it.
+//
+// Documentation:
+// - BIP-327: https://github.com/bitcoin/bips/blob/master/bip-0327.mediawiki
+package musig2
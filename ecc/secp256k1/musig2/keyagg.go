@@ -0,0 +1,153 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package musig2
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/schnorr"
+)
+
+// KeyAggContext holds the aggregated public key Q, and the accumulated sign gacc and tweak tacc
+// of the tweaks applied to it.
+type KeyAggContext struct {
+	q          secp256k1.G1Affine
+	gacc, tacc fr.Element
+}
+
+// KeySort returns a copy of the public keys sorted in lexicographical order.
+func KeySort(pubKeys [][]byte) [][]byte {
+	sorted := make([][]byte, len(pubKeys))
+	copy(sorted, pubKeys)
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i], sorted[j]) < 0
+	})
+	return sorted
+}
+
+// KeyAgg aggregates the public keys, 33 bytes compressed points, in the given order
+//
+// Q = a₁ ⋅ P₁ + ... + aᵤ ⋅ Pᵤ
+//
+// with aᵢ = KeyAggCoeff(pubKeys, Pᵢ).
+func KeyAgg(pubKeys [][]byte) (*KeyAggContext, error) {
+	if len(pubKeys) == 0 {
+		return nil, errNoPublicKey
+	}
+	points := make([]secp256k1.G1Affine, len(pubKeys))
+	for i := range pubKeys {
+		var err error
+		if points[i], err = cpoint(pubKeys[i]); err != nil {
+			return nil, &InvalidContributionError{Signer: i, Contribution: "pubkey"}
+		}
+	}
+
+	l := hashKeys(pubKeys)
+	pk2 := secondKey(pubKeys)
+	var q secp256k1.G1Jac
+	var aBig big.Int
+	for i := range points {
+		a := keyAggCoeff(l, pk2, pubKeys[i])
+		var t secp256k1.G1Jac
+		t.FromAffine(&points[i])
+		t.ScalarMultiplication(&t, a.BigInt(&aBig))
+		q.AddAssign(&t)
+	}
+
+	ctx := new(KeyAggContext)
+	ctx.q.FromJacobian(&q)
+	if ctx.q.IsInfinity() {
+		return nil, errInfinity
+	}
+	ctx.gacc.SetOne()
+	return ctx, nil
+}
+
+// KeyAggCoeff returns the coefficient of the public key pk in the aggregation of pubKeys.
+func KeyAggCoeff(pubKeys [][]byte, pk []byte) fr.Element {
+	return keyAggCoeff(hashKeys(pubKeys), secondKey(pubKeys), pk)
+}
+
+func keyAggCoeff(l [32]byte, pk2, pk []byte) fr.Element {
+	var a fr.Element
+	if bytes.Equal(pk, pk2) {
+		a.SetOne()
+		return a
+	}
+	h := schnorr.TaggedHash("KeyAgg coefficient", l[:], pk)
+	a.SetBytes(h[:])
+	return a
+}
+
+// hashKeys returns hash_KeyAgg list(pk₁ || ... || pkᵤ)
+func hashKeys(pubKeys [][]byte) [32]byte {
+	return schnorr.TaggedHash("KeyAgg list", pubKeys...)
+}
+
+// secondKey returns the first public key different from pubKeys[0], or 33 zero bytes
+func secondKey(pubKeys [][]byte) []byte {
+	for _, pk := range pubKeys[1:] {
+		if !bytes.Equal(pk, pubKeys[0]) {
+			return pk
+		}
+	}
+	return make([]byte, sizePoint)
+}
+
+// ApplyTweak tweaks the aggregated public key with the 32 bytes tweak t
+//
+// Q' = g ⋅ Q + t ⋅ G
+//
+// with g = -1 if xOnly and Q has an odd y-coordinate, 1 otherwise. X-only tweaks are used for
+// BIP-341 Taproot outputs, plain tweaks for BIP-32 derivations.
+func (ctx *KeyAggContext) ApplyTweak(tweak []byte, xOnly bool) error {
+	if len(tweak) != sizeScalar {
+		return errInvalidTweak
+	}
+	var t fr.Element
+	if err := t.SetBytesCanonical(tweak); err != nil {
+		return errInvalidTweak
+	}
+
+	var q secp256k1.G1Affine
+	q.Set(&ctx.q)
+	negate := xOnly && isOdd(&ctx.q)
+	if negate {
+		q.Neg(&q)
+	}
+	var tBig big.Int
+	var res secp256k1.G1Jac
+	res.ScalarMultiplicationBase(t.BigInt(&tBig)).AddMixed(&q)
+	var r secp256k1.G1Affine
+	r.FromJacobian(&res)
+	if r.IsInfinity() {
+		return errInfinity
+	}
+
+	ctx.q = r
+	if negate {
+		ctx.gacc.Neg(&ctx.gacc)
+		ctx.tacc.Neg(&ctx.tacc)
+	}
+	ctx.tacc.Add(&ctx.tacc, &t)
+	return nil
+}
+
+// XOnlyPublicKey returns the aggregated public key as a BIP-340 x-only public key.
+func (ctx *KeyAggContext) XOnlyPublicKey() *schnorr.PublicKey {
+	var pub schnorr.PublicKey
+	if _, err := pub.SetBytes(xbytes(&ctx.q)); err != nil {
+		panic(err) // ctx.q is on the curve
+	}
+	return &pub
+}
+
+// PlainPublicKey returns the aggregated public key as a 33 bytes compressed point.
+func (ctx *KeyAggContext) PlainPublicKey() []byte {
+	return cbytes(&ctx.q)
+}
@@ -0,0 +1,313 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package musig2
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/schnorr"
+)
+
+const (
+	sizeScalar = fr.Bytes
+	sizePoint  = 1 + fp.Bytes
+)
+
+var (
+	errWrongSize      = errors.New("musig2: wrong size buffer")
+	errNoPublicKey    = errors.New("musig2: no public key to aggregate")
+	errInfinity       = errors.New("musig2: the aggregated public key is the point at infinity")
+	errInvalidTweak   = errors.New("musig2: the tweak must be a scalar")
+	errInvalidPoint   = errors.New("musig2: invalid compressed point")
+	errZeroNonce      = errors.New("musig2: zero nonce")
+	errUsedNonce      = errors.New("musig2: invalid or already used secret nonce")
+	errInvalidKey     = errors.New("musig2: the secret key must be in [1, n-1]")
+	errKeyMismatch    = errors.New("musig2: the secret nonce was generated for another public key")
+	errNotSigner      = errors.New("musig2: the public key is not part of the session")
+	errLengthMismatch = errors.New("musig2: the number of tweaks and of tweak modes differ")
+)
+
+// InvalidContributionError reports the signer that sent an invalid public key,
+// public nonce or partial signature.
+type InvalidContributionError struct {
+	Signer       int    // index of the signer
+	Contribution string // "pubkey", "pubnonce" or "psig"
+}
+
+func (e *InvalidContributionError) Error() string {
+	return fmt.Sprintf("musig2: invalid %s of signer %d", e.Contribution, e.Signer)
+}
+
+// PartialSignature is the 32 bytes big endian partial signature of a signer.
+type PartialSignature [sizeScalar]byte
+
+// SetBytes sets the partial signature from buf, checking that it is a scalar.
+// It returns the number of bytes read from buf.
+func (psig *PartialSignature) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizeScalar {
+		return 0, io.ErrShortBuffer
+	}
+	var s fr.Element
+	if err := s.SetBytesCanonical(buf[:sizeScalar]); err != nil {
+		return 0, err
+	}
+	copy(psig[:], buf)
+	return sizeScalar, nil
+}
+
+// Session holds the values shared by the signers of a message, derived from the aggregated nonce,
+// the public keys, the tweaks and the message.
+//
+// BIP-327, Session Context
+type Session struct {
+	pubKeys [][]byte
+	keyAgg  *KeyAggContext
+	msg     []byte
+
+	b fr.Element         // nonce coefficient
+	r secp256k1.G1Affine // final nonce R = R₁ + b ⋅ R₂
+	e fr.Element         // challenge
+}
+
+// NewSession creates the signing session of msg by pubKeys, with the aggregated nonce of the
+// signers. The tweaks are applied in order to the aggregated public key, as x-only tweaks if
+// isXOnly[i] is set.
+//
+// BIP-327, GetSessionValues
+func NewSession(aggNonce AggNonce, pubKeys, tweaks [][]byte, isXOnly []bool, msg []byte) (*Session, error) {
+	if len(tweaks) != len(isXOnly) {
+		return nil, errLengthMismatch
+	}
+	keyAgg, err := KeyAgg(pubKeys)
+	if err != nil {
+		return nil, err
+	}
+	for i := range tweaks {
+		if err := keyAgg.ApplyTweak(tweaks[i], isXOnly[i]); err != nil {
+			return nil, err
+		}
+	}
+	r1, r2, err := aggNonce.points()
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Session{pubKeys: pubKeys, keyAgg: keyAgg, msg: msg}
+	h := schnorr.TaggedHash("MuSig/noncecoef", aggNonce[:], xbytes(&keyAgg.q), msg)
+	s.b.SetBytes(h[:])
+
+	var R secp256k1.G1Jac
+	var bBig big.Int
+	R.JointScalarMultiplication(&r1, &r2, big.NewInt(1), s.b.BigInt(&bBig))
+	s.r.FromJacobian(&R)
+	if s.r.IsInfinity() {
+		_, s.r = secp256k1.Generators()
+	}
+
+	h = schnorr.TaggedHash("BIP0340/challenge", xbytes(&s.r), xbytes(&keyAgg.q), msg)
+	s.e.SetBytes(h[:])
+	return s, nil
+}
+
+// KeyAggContext returns the aggregated and tweaked public key of the session.
+func (s *Session) KeyAggContext() *KeyAggContext {
+	return s.keyAgg
+}
+
+// Sign computes the partial signature of the signer with secret key sk (32 bytes)
+//
+// k₁, k₂ negated if R has an odd y-coordinate
+// d = g ⋅ gacc ⋅ sk, with g = -1 if Q has an odd y-coordinate
+// s = k₁ + b ⋅ k₂ + e ⋅ a ⋅ d
+//
+// The secret nonce is erased, so that it can't be used again.
+//
+// BIP-327, Sign
+func (s *Session) Sign(secNonce *SecNonce, sk []byte) (PartialSignature, error) {
+	var psig PartialSignature
+	var k1, k2 fr.Element
+	err1 := k1.SetBytesCanonical(secNonce[:sizeScalar])
+	err2 := k2.SetBytesCanonical(secNonce[sizeScalar : 2*sizeScalar])
+	pk := secNonce[2*sizeScalar:]
+	// erase the secret nonce, before any other check
+	copy(secNonce[:2*sizeScalar], make([]byte, 2*sizeScalar))
+	if err1 != nil || err2 != nil || k1.IsZero() || k2.IsZero() {
+		return psig, errUsedNonce
+	}
+
+	if len(sk) != sizeScalar {
+		return psig, errWrongSize
+	}
+	var d fr.Element
+	if err := d.SetBytesCanonical(sk); err != nil || d.IsZero() {
+		return psig, errInvalidKey
+	}
+	var P secp256k1.G1Affine
+	var dBig big.Int
+	P.ScalarMultiplicationBase(d.BigInt(&dBig))
+	if !bytes.Equal(cbytes(&P), pk) {
+		return psig, errKeyMismatch
+	}
+	a, err := s.keyAggCoeff(pk)
+	if err != nil {
+		return psig, err
+	}
+
+	if isOdd(&s.r) {
+		k1.Neg(&k1)
+		k2.Neg(&k2)
+	}
+	d.Mul(&d, s.g())
+
+	var res fr.Element
+	res.Mul(&s.e, &a).Mul(&res, &d)
+	k2.Mul(&k2, &s.b)
+	res.Add(&res, &k1).Add(&res, &k2)
+	psig = res.Bytes()
+	return psig, nil
+}
+
+// PartialSigVerify checks the partial signature of the signer with public key pk and public
+// nonce pubNonce
+//
+// s ⋅ G ?= Re + e ⋅ a ⋅ g ⋅ gacc ⋅ P
+//
+// with Re = R₁ + b ⋅ R₂ from the public nonce, negated if R has an odd y-coordinate.
+//
+// BIP-327, PartialSigVerifyInternal
+func (s *Session) PartialSigVerify(psig PartialSignature, pubNonce PubNonce, pk []byte) (bool, error) {
+	var sig fr.Element
+	if err := sig.SetBytesCanonical(psig[:]); err != nil {
+		return false, nil
+	}
+	r1, r2, err := pubNonce.points()
+	if err != nil {
+		return false, err
+	}
+	P, err := cpoint(pk)
+	if err != nil {
+		return false, err
+	}
+	a, err := s.keyAggCoeff(pk)
+	if err != nil {
+		return false, err
+	}
+
+	var bBig, sBig, cBig big.Int
+	var Re secp256k1.G1Jac
+	Re.JointScalarMultiplication(&r1, &r2, big.NewInt(1), s.b.BigInt(&bBig))
+	if isOdd(&s.r) {
+		Re.Neg(&Re)
+	}
+
+	// s ⋅ G - (e ⋅ a ⋅ g ⋅ gacc) ⋅ P
+	var c fr.Element
+	c.Mul(&s.e, &a).Mul(&c, s.g()).Neg(&c)
+	var T secp256k1.G1Jac
+	T.JointScalarMultiplicationBase(&P, sig.BigInt(&sBig), c.BigInt(&cBig))
+	return T.Equal(&Re), nil
+}
+
+// PartialSigAgg aggregates the partial signatures of all the signers into a BIP-340 signature
+// of the message by the tweaked aggregated public key
+//
+// s = s₁ + ... + sᵤ + e ⋅ g ⋅ tacc
+//
+// BIP-327, PartialSigAgg
+func (s *Session) PartialSigAgg(psigs []PartialSignature) ([]byte, error) {
+	var sum fr.Element
+	for i := range psigs {
+		var si fr.Element
+		if err := si.SetBytesCanonical(psigs[i][:]); err != nil {
+			return nil, &InvalidContributionError{Signer: i, Contribution: "psig"}
+		}
+		sum.Add(&sum, &si)
+	}
+	var t fr.Element
+	t.Mul(&s.e, &s.keyAgg.tacc)
+	if isOdd(&s.keyAgg.q) {
+		t.Neg(&t)
+	}
+	sum.Add(&sum, &t)
+
+	sb := sum.Bytes()
+	return append(xbytes(&s.r), sb[:]...), nil
+}
+
+// g returns g ⋅ gacc, with g = -1 if Q has an odd y-coordinate, 1 otherwise
+func (s *Session) g() *fr.Element {
+	g := s.keyAgg.gacc
+	if isOdd(&s.keyAgg.q) {
+		g.Neg(&g)
+	}
+	return &g
+}
+
+// keyAggCoeff returns the aggregation coefficient of pk in the session
+func (s *Session) keyAggCoeff(pk []byte) (fr.Element, error) {
+	for _, p := range s.pubKeys {
+		if bytes.Equal(p, pk) {
+			return KeyAggCoeff(s.pubKeys, pk), nil
+		}
+	}
+	return fr.Element{}, errNotSigner
+}
+
+// cbytes returns the 33 bytes compressed encoding of p, 2 or 3 (parity of y) || x
+func cbytes(p *secp256k1.G1Affine) []byte {
+	res := make([]byte, 1, sizePoint)
+	res[0] = 2
+	if isOdd(p) {
+		res[0] = 3
+	}
+	return append(res, xbytes(p)...)
+}
+
+// cbytesExt returns cbytes(p), or 33 zero bytes if p is the point at infinity
+func cbytesExt(p *secp256k1.G1Affine) []byte {
+	if p.IsInfinity() {
+		return make([]byte, sizePoint)
+	}
+	return cbytes(p)
+}
+
+// cpoint decodes a 33 bytes compressed point
+func cpoint(buf []byte) (secp256k1.G1Affine, error) {
+	if len(buf) != sizePoint || (buf[0] != 2 && buf[0] != 3) {
+		return secp256k1.G1Affine{}, errInvalidPoint
+	}
+	var pub schnorr.PublicKey
+	if _, err := pub.SetBytes(buf[1:]); err != nil {
+		return secp256k1.G1Affine{}, errInvalidPoint
+	}
+	if buf[0] == 3 {
+		pub.A.Neg(&pub.A)
+	}
+	return pub.A, nil
+}
+
+// cpointExt decodes a 33 bytes compressed point, or the point at infinity encoded as zeros
+func cpointExt(buf []byte) (secp256k1.G1Affine, error) {
+	if bytes.Equal(buf, make([]byte, sizePoint)) {
+		return secp256k1.G1Affine{}, nil
+	}
+	return cpoint(buf)
+}
+
+func xbytes(p *secp256k1.G1Affine) []byte {
+	b := p.X.Bytes()
+	return b[:]
+}
+
+func isOdd(p *secp256k1.G1Affine) bool {
+	b := p.Y.Bytes()
+	return b[fp.Bytes-1]&1 == 1
+}
@@ -0,0 +1,554 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package musig2
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// vectorError is the expected error of a BIP-327 test vector
+type vectorError struct {
+	Type    string `json:"type"`
+	Signer  *int   `json:"signer"`
+	Contrib string `json:"contrib"`
+	Message string `json:"message"`
+}
+
+// check that err is the expected error, and blames the right signer for an invalid contribution
+func (e *vectorError) check(t *testing.T, err error, comment string) {
+	t.Helper()
+	if err == nil {
+		t.Fatalf("%s: expected an error", comment)
+	}
+	if e.Type != "invalid_contribution" || e.Signer == nil {
+		return
+	}
+	if c, ok := err.(*InvalidContributionError); !ok || c.Signer != *e.Signer || c.Contribution != e.Contrib {
+		t.Fatalf("%s: expected an invalid %s of signer %d, got %v", comment, e.Contrib, *e.Signer, err)
+	}
+}
+
+// readVectors decodes the BIP-327 test vectors file testdata/name into v
+func readVectors(t *testing.T, name string, v any) {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = json.Unmarshal(b, v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func decode(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// decodeOptional decodes s, or returns nil if s is null
+func decodeOptional(t *testing.T, s *string) []byte {
+	t.Helper()
+	if s == nil {
+		return nil
+	}
+	return append([]byte{}, decode(t, *s)...)
+}
+
+// decodeAll decodes the elements of s at the given indices, or all of them if none is given
+func decodeAll(t *testing.T, s []string, indices ...int) [][]byte {
+	t.Helper()
+	if indices == nil {
+		indices = make([]int, len(s))
+		for i := range indices {
+			indices[i] = i
+		}
+	}
+	res := make([][]byte, len(indices))
+	for i, j := range indices {
+		res[i] = decode(t, s[j])
+	}
+	return res
+}
+
+// decodePubNonces returns the public nonces at the given indices, without checking them
+func decodePubNonces(t *testing.T, s []string, indices []int) []PubNonce {
+	t.Helper()
+	res := make([]PubNonce, len(indices))
+	for i, j := range indices {
+		copy(res[i][:], decode(t, s[j]))
+	}
+	return res
+}
+
+// decodeTweaks returns the tweaks at the given indices, with their modes
+func decodeTweaks(t *testing.T, s []string, indices []int, isXOnly []bool) ([][]byte, []bool) {
+	t.Helper()
+	if len(indices) == 0 {
+		return nil, nil
+	}
+	return decodeAll(t, s, indices...), isXOnly
+}
+
+func TestKeySortVectors(t *testing.T) {
+	var vectors struct {
+		PubKeys       []string `json:"pubkeys"`
+		SortedPubKeys []string `json:"sorted_pubkeys"`
+	}
+	readVectors(t, "key_sort_vectors.json", &vectors)
+
+	sorted := KeySort(decodeAll(t, vectors.PubKeys))
+	expected := decodeAll(t, vectors.SortedPubKeys)
+	for i := range expected {
+		if !bytes.Equal(sorted[i], expected[i]) {
+			t.Fatalf("wrong public key at position %d", i)
+		}
+	}
+}
+
+func TestKeyAggVectors(t *testing.T) {
+	var vectors struct {
+		PubKeys []string `json:"pubkeys"`
+		Tweaks  []string `json:"tweaks"`
+		Valid   []struct {
+			KeyIndices []int  `json:"key_indices"`
+			Expected   string `json:"expected"`
+		} `json:"valid_test_cases"`
+		Error []struct {
+			KeyIndices   []int       `json:"key_indices"`
+			TweakIndices []int       `json:"tweak_indices"`
+			IsXOnly      []bool      `json:"is_xonly"`
+			Error        vectorError `json:"error"`
+			Comment      string      `json:"comment"`
+		} `json:"error_test_cases"`
+	}
+	readVectors(t, "key_agg_vectors.json", &vectors)
+
+	for _, v := range vectors.Valid {
+		ctx, err := KeyAgg(decodeAll(t, vectors.PubKeys, v.KeyIndices...))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.EqualFold(hex.EncodeToString(ctx.XOnlyPublicKey().Bytes()), v.Expected) {
+			t.Fatalf("keys %v: wrong aggregated key", v.KeyIndices)
+		}
+	}
+
+	for _, v := range vectors.Error {
+		ctx, err := KeyAgg(decodeAll(t, vectors.PubKeys, v.KeyIndices...))
+		for i := 0; err == nil && i < len(v.TweakIndices); i++ {
+			err = ctx.ApplyTweak(decode(t, vectors.Tweaks[v.TweakIndices[i]]), v.IsXOnly[i])
+		}
+		v.Error.check(t, err, v.Comment)
+	}
+}
+
+func TestNonceGenVectors(t *testing.T) {
+	var vectors struct {
+		TestCases []struct {
+			Rand             string  `json:"rand_"`
+			Sk               *string `json:"sk"`
+			Pk               string  `json:"pk"`
+			AggPk            *string `json:"aggpk"`
+			Msg              *string `json:"msg"`
+			ExtraIn          *string `json:"extra_in"`
+			ExpectedSecNonce string  `json:"expected_secnonce"`
+			ExpectedPubNonce string  `json:"expected_pubnonce"`
+		} `json:"test_cases"`
+	}
+	readVectors(t, "nonce_gen_vectors.json", &vectors)
+
+	for i, v := range vectors.TestCases {
+		secNonce, pubNonce, err := NonceGen(decodeOptional(t, v.Sk), decode(t, v.Pk), decodeOptional(t, v.AggPk),
+			decodeOptional(t, v.Msg), decodeOptional(t, v.ExtraIn), bytes.NewReader(decode(t, v.Rand)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.EqualFold(hex.EncodeToString(secNonce[:]), v.ExpectedSecNonce) {
+			t.Fatalf("vector %d: wrong secret nonce", i)
+		}
+		if !strings.EqualFold(hex.EncodeToString(pubNonce[:]), v.ExpectedPubNonce) {
+			t.Fatalf("vector %d: wrong public nonce", i)
+		}
+	}
+}
+
+func TestNonceAggVectors(t *testing.T) {
+	var vectors struct {
+		PubNonces []string `json:"pnonces"`
+		Valid     []struct {
+			PubNonceIndices []int  `json:"pnonce_indices"`
+			Expected        string `json:"expected"`
+		} `json:"valid_test_cases"`
+		Error []struct {
+			PubNonceIndices []int       `json:"pnonce_indices"`
+			Error           vectorError `json:"error"`
+			Comment         string      `json:"comment"`
+		} `json:"error_test_cases"`
+	}
+	readVectors(t, "nonce_agg_vectors.json", &vectors)
+
+	for _, v := range vectors.Valid {
+		aggNonce, err := NonceAgg(decodePubNonces(t, vectors.PubNonces, v.PubNonceIndices))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.EqualFold(hex.EncodeToString(aggNonce[:]), v.Expected) {
+			t.Fatalf("nonces %v: wrong aggregated nonce", v.PubNonceIndices)
+		}
+		var decoded AggNonce
+		if _, err := decoded.SetBytes(aggNonce[:]); err != nil || decoded != aggNonce {
+			t.Fatalf("nonces %v: aggregated nonce not decoded", v.PubNonceIndices)
+		}
+	}
+
+	for _, v := range vectors.Error {
+		_, err := NonceAgg(decodePubNonces(t, vectors.PubNonces, v.PubNonceIndices))
+		v.Error.check(t, err, v.Comment)
+	}
+}
+
+func TestSignVerifyVectors(t *testing.T) {
+	var vectors struct {
+		Sk        string   `json:"sk"`
+		PubKeys   []string `json:"pubkeys"`
+		SecNonces []string `json:"secnonces"`
+		PubNonces []string `json:"pnonces"`
+		AggNonces []string `json:"aggnonces"`
+		Msgs      []string `json:"msgs"`
+		Valid     []struct {
+			KeyIndices    []int  `json:"key_indices"`
+			NonceIndices  []int  `json:"nonce_indices"`
+			AggNonceIndex int    `json:"aggnonce_index"`
+			MsgIndex      int    `json:"msg_index"`
+			SignerIndex   int    `json:"signer_index"`
+			Expected      string `json:"expected"`
+		} `json:"valid_test_cases"`
+		SignError []struct {
+			KeyIndices    []int       `json:"key_indices"`
+			AggNonceIndex int         `json:"aggnonce_index"`
+			MsgIndex      int         `json:"msg_index"`
+			SecNonceIndex int         `json:"secnonce_index"`
+			Error         vectorError `json:"error"`
+			Comment       string      `json:"comment"`
+		} `json:"sign_error_test_cases"`
+		VerifyFail []struct {
+			Sig          string `json:"sig"`
+			KeyIndices   []int  `json:"key_indices"`
+			NonceIndices []int  `json:"nonce_indices"`
+			MsgIndex     int    `json:"msg_index"`
+			SignerIndex  int    `json:"signer_index"`
+			Comment      string `json:"comment"`
+		} `json:"verify_fail_test_cases"`
+		VerifyError []struct {
+			Sig          string      `json:"sig"`
+			KeyIndices   []int       `json:"key_indices"`
+			NonceIndices []int       `json:"nonce_indices"`
+			MsgIndex     int         `json:"msg_index"`
+			SignerIndex  int         `json:"signer_index"`
+			Error        vectorError `json:"error"`
+			Comment      string      `json:"comment"`
+		} `json:"verify_error_test_cases"`
+	}
+	readVectors(t, "sign_verify_vectors.json", &vectors)
+	sk := decode(t, vectors.Sk)
+	secNonce := func(i int) *SecNonce {
+		var res SecNonce
+		copy(res[:], decode(t, vectors.SecNonces[i]))
+		return &res
+	}
+
+	for _, v := range vectors.Valid {
+		pubKeys := decodeAll(t, vectors.PubKeys, v.KeyIndices...)
+		pubNonces := decodePubNonces(t, vectors.PubNonces, v.NonceIndices)
+		msg := decode(t, vectors.Msgs[v.MsgIndex])
+
+		aggNonce, err := NonceAgg(pubNonces)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.EqualFold(hex.EncodeToString(aggNonce[:]), vectors.AggNonces[v.AggNonceIndex]) {
+			t.Fatalf("keys %v: wrong aggregated nonce", v.KeyIndices)
+		}
+		session, err := NewSession(aggNonce, pubKeys, nil, nil, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sn := secNonce(0)
+		psig, err := session.Sign(sn, sk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.EqualFold(hex.EncodeToString(psig[:]), v.Expected) {
+			t.Fatalf("keys %v: wrong partial signature", v.KeyIndices)
+		}
+		if ok, err := session.PartialSigVerify(psig, pubNonces[v.SignerIndex], pubKeys[v.SignerIndex]); !ok || err != nil {
+			t.Fatalf("keys %v: valid partial signature rejected", v.KeyIndices)
+		}
+		if _, err := session.Sign(sn, sk); err == nil {
+			t.Fatal("a secret nonce must not be used twice")
+		}
+	}
+
+	for _, v := range vectors.SignError {
+		var aggNonce AggNonce
+		copy(aggNonce[:], decode(t, vectors.AggNonces[v.AggNonceIndex]))
+		session, err := NewSession(aggNonce, decodeAll(t, vectors.PubKeys, v.KeyIndices...), nil, nil, decode(t, vectors.Msgs[v.MsgIndex]))
+		if err == nil {
+			_, err = session.Sign(secNonce(v.SecNonceIndex), sk)
+		}
+		v.Error.check(t, err, v.Comment)
+	}
+
+	for _, v := range vectors.VerifyFail {
+		pubKeys := decodeAll(t, vectors.PubKeys, v.KeyIndices...)
+		pubNonces := decodePubNonces(t, vectors.PubNonces, v.NonceIndices)
+		aggNonce, err := NonceAgg(pubNonces)
+		if err != nil {
+			t.Fatal(err)
+		}
+		session, err := NewSession(aggNonce, pubKeys, nil, nil, decode(t, vectors.Msgs[v.MsgIndex]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var psig PartialSignature
+		copy(psig[:], decode(t, v.Sig))
+		if ok, _ := session.PartialSigVerify(psig, pubNonces[v.SignerIndex], pubKeys[v.SignerIndex]); ok {
+			t.Fatalf("%s: invalid partial signature accepted", v.Comment)
+		}
+	}
+
+	for _, v := range vectors.VerifyError {
+		pubKeys := decodeAll(t, vectors.PubKeys, v.KeyIndices...)
+		pubNonces := decodePubNonces(t, vectors.PubNonces, v.NonceIndices)
+		aggNonce, err := NonceAgg(pubNonces)
+		if err == nil {
+			var session *Session
+			if session, err = NewSession(aggNonce, pubKeys, nil, nil, decode(t, vectors.Msgs[v.MsgIndex])); err == nil {
+				var psig PartialSignature
+				copy(psig[:], decode(t, v.Sig))
+				_, err = session.PartialSigVerify(psig, pubNonces[v.SignerIndex], pubKeys[v.SignerIndex])
+			}
+		}
+		v.Error.check(t, err, v.Comment)
+	}
+}
+
+func TestTweakVectors(t *testing.T) {
+	type testCase struct {
+		KeyIndices   []int       `json:"key_indices"`
+		NonceIndices []int       `json:"nonce_indices"`
+		TweakIndices []int       `json:"tweak_indices"`
+		IsXOnly      []bool      `json:"is_xonly"`
+		SignerIndex  int         `json:"signer_index"`
+		Expected     string      `json:"expected"`
+		Error        vectorError `json:"error"`
+		Comment      string      `json:"comment"`
+	}
+	var vectors struct {
+		Sk        string     `json:"sk"`
+		PubKeys   []string   `json:"pubkeys"`
+		SecNonce  string     `json:"secnonce"`
+		PubNonces []string   `json:"pnonces"`
+		AggNonce  string     `json:"aggnonce"`
+		Tweaks    []string   `json:"tweaks"`
+		Msg       string     `json:"msg"`
+		Valid     []testCase `json:"valid_test_cases"`
+		Error     []testCase `json:"error_test_cases"`
+	}
+	readVectors(t, "tweak_vectors.json", &vectors)
+	sk := decode(t, vectors.Sk)
+	msg := decode(t, vectors.Msg)
+
+	for _, v := range vectors.Valid {
+		pubKeys := decodeAll(t, vectors.PubKeys, v.KeyIndices...)
+		pubNonces := decodePubNonces(t, vectors.PubNonces, v.NonceIndices)
+		tweaks, isXOnly := decodeTweaks(t, vectors.Tweaks, v.TweakIndices, v.IsXOnly)
+
+		aggNonce, err := NonceAgg(pubNonces)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.EqualFold(hex.EncodeToString(aggNonce[:]), vectors.AggNonce) {
+			t.Fatalf("%s: wrong aggregated nonce", v.Comment)
+		}
+		session, err := NewSession(aggNonce, pubKeys, tweaks, isXOnly, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var secNonce SecNonce
+		copy(secNonce[:], decode(t, vectors.SecNonce))
+		psig, err := session.Sign(&secNonce, sk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.EqualFold(hex.EncodeToString(psig[:]), v.Expected) {
+			t.Fatalf("%s: wrong partial signature", v.Comment)
+		}
+		if ok, err := session.PartialSigVerify(psig, pubNonces[v.SignerIndex], pubKeys[v.SignerIndex]); !ok || err != nil {
+			t.Fatalf("%s: valid partial signature rejected", v.Comment)
+		}
+	}
+
+	for _, v := range vectors.Error {
+		var aggNonce AggNonce
+		copy(aggNonce[:], decode(t, vectors.AggNonce))
+		tweaks, isXOnly := decodeTweaks(t, vectors.Tweaks, v.TweakIndices, v.IsXOnly)
+		_, err := NewSession(aggNonce, decodeAll(t, vectors.PubKeys, v.KeyIndices...), tweaks, isXOnly, msg)
+		v.Error.check(t, err, v.Comment)
+	}
+}
+
+func TestSigAggVectors(t *testing.T) {
+	type testCase struct {
+		AggNonce     string      `json:"aggnonce"`
+		NonceIndices []int       `json:"nonce_indices"`
+		KeyIndices   []int       `json:"key_indices"`
+		TweakIndices []int       `json:"tweak_indices"`
+		IsXOnly      []bool      `json:"is_xonly"`
+		PsigIndices  []int       `json:"psig_indices"`
+		Expected     string      `json:"expected"`
+		Error        vectorError `json:"error"`
+		Comment      string      `json:"comment"`
+	}
+	var vectors struct {
+		PubKeys   []string   `json:"pubkeys"`
+		PubNonces []string   `json:"pnonces"`
+		Tweaks    []string   `json:"tweaks"`
+		Psigs     []string   `json:"psigs"`
+		Msg       string     `json:"msg"`
+		Valid     []testCase `json:"valid_test_cases"`
+		Error     []testCase `json:"error_test_cases"`
+	}
+	readVectors(t, "sig_agg_vectors.json", &vectors)
+	msg := decode(t, vectors.Msg)
+
+	run := func(v testCase) (*Session, []byte, error) {
+		aggNonce, err := NonceAgg(decodePubNonces(t, vectors.PubNonces, v.NonceIndices))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.EqualFold(hex.EncodeToString(aggNonce[:]), v.AggNonce) {
+			t.Fatalf("keys %v: wrong aggregated nonce", v.KeyIndices)
+		}
+		tweaks, isXOnly := decodeTweaks(t, vectors.Tweaks, v.TweakIndices, v.IsXOnly)
+		session, err := NewSession(aggNonce, decodeAll(t, vectors.PubKeys, v.KeyIndices...), tweaks, isXOnly, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		psigs := make([]PartialSignature, len(v.PsigIndices))
+		for i, j := range v.PsigIndices {
+			copy(psigs[i][:], decode(t, vectors.Psigs[j]))
+		}
+		sig, err := session.PartialSigAgg(psigs)
+		return session, sig, err
+	}
+
+	for _, v := range vectors.Valid {
+		session, sig, err := run(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.EqualFold(hex.EncodeToString(sig), v.Expected) {
+			t.Fatalf("keys %v: wrong signature", v.KeyIndices)
+		}
+		if ok, err := session.KeyAggContext().XOnlyPublicKey().Verify(sig, msg, nil); !ok || err != nil {
+			t.Fatalf("keys %v: signature rejected by BIP-340 verification", v.KeyIndices)
+		}
+	}
+
+	for _, v := range vectors.Error {
+		_, _, err := run(v)
+		v.Error.check(t, err, v.Comment)
+	}
+}
+
+func TestMuSig2(t *testing.T) {
+	const n = 4
+	sks := make([][]byte, n)
+	signerKeys := make([][]byte, n)
+	for i := range sks {
+		sks[i] = make([]byte, 32)
+		if _, err := rand.Read(sks[i]); err != nil {
+			t.Fatal(err)
+		}
+		signerKeys[i] = plainPublicKey(sks[i])
+	}
+	pubKeys := KeySort(signerKeys)
+
+	msg := []byte("testing MuSig2")
+	tweaks := [][]byte{make([]byte, 32), make([]byte, 32)}
+	rand.Read(tweaks[0])
+	rand.Read(tweaks[1])
+	isXOnly := []bool{false, true}
+
+	keyAgg, err := KeyAgg(pubKeys)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// round 1
+	secNonces := make([]*SecNonce, n)
+	pubNonces := make([]PubNonce, n)
+	for i := range secNonces {
+		secNonces[i], pubNonces[i], err = NonceGen(sks[i], signerKeys[i], keyAgg.XOnlyPublicKey().Bytes(), msg, nil, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	aggNonce, err := NonceAgg(pubNonces)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// round 2
+	session, err := NewSession(aggNonce, pubKeys, tweaks, isXOnly, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	psigs := make([]PartialSignature, n)
+	for i := range psigs {
+		if psigs[i], err = session.Sign(secNonces[i], sks[i]); err != nil {
+			t.Fatal(err)
+		}
+		if ok, err := session.PartialSigVerify(psigs[i], pubNonces[i], signerKeys[i]); !ok || err != nil {
+			t.Fatal("valid partial signature rejected")
+		}
+		if ok, _ := session.PartialSigVerify(psigs[i], pubNonces[(i+1)%n], signerKeys[i]); ok {
+			t.Fatal("partial signature accepted with another nonce")
+		}
+	}
+	sig, err := session.PartialSigAgg(psigs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ok, err := session.KeyAggContext().XOnlyPublicKey().Verify(sig, msg, nil); !ok || err != nil {
+		t.Fatal("aggregated signature rejected by BIP-340 verification")
+	}
+}
+
+// plainPublicKey returns cbytes(sk ⋅ G)
+func plainPublicKey(sk []byte) []byte {
+	var d fr.Element
+	var dBig big.Int
+	d.SetBytes(sk)
+	var P secp256k1.G1Affine
+	P.ScalarMultiplicationBase(d.BigInt(&dBig))
+	return cbytes(&P)
+}
@@ -0,0 +1,153 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package musig2
+
+import (
+	"encoding/binary"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/schnorr"
+)
+
+// SecNonce is the secret nonce of a signer, k₁ || k₂ || pk. It must be kept secret and
+// used in a single session.
+type SecNonce [2*sizeScalar + sizePoint]byte
+
+// PubNonce is the public nonce of a signer, cbytes(k₁ ⋅ G) || cbytes(k₂ ⋅ G).
+type PubNonce [2 * sizePoint]byte
+
+// AggNonce is the aggregation of the public nonces of all the signers, R₁ || R₂ where each
+// point may be the point at infinity, encoded as 33 zero bytes.
+type AggNonce [2 * sizePoint]byte
+
+// NonceGen generates a secret and a public nonce for the signer with public key pk, from 32 bytes
+// read from rand. The secret key sk, the x-only aggregated public key aggPk, the message msg and
+// the extra input extraIn are optional (nil) and only strengthen the nonce against a bad rand;
+// a nil msg differs from an empty message.
+//
+// BIP-327, NonceGen
+func NonceGen(sk, pk, aggPk, msg, extraIn []byte, rand io.Reader) (*SecNonce, PubNonce, error) {
+	var pubNonce PubNonce
+	if len(pk) != sizePoint {
+		return nil, pubNonce, errWrongSize
+	}
+	if sk != nil && len(sk) != sizeScalar || aggPk != nil && len(aggPk) != sizeScalar {
+		return nil, pubNonce, errWrongSize
+	}
+
+	r := make([]byte, 32)
+	if _, err := io.ReadFull(rand, r); err != nil {
+		return nil, pubNonce, err
+	}
+	if sk != nil {
+		h := schnorr.TaggedHash("MuSig/aux", r)
+		for i := range r {
+			r[i] = sk[i] ^ h[i]
+		}
+	}
+
+	var msgPrefixed []byte
+	if msg == nil {
+		msgPrefixed = []byte{0}
+	} else {
+		msgPrefixed = make([]byte, 9, 9+len(msg))
+		msgPrefixed[0] = 1
+		binary.BigEndian.PutUint64(msgPrefixed[1:], uint64(len(msg)))
+		msgPrefixed = append(msgPrefixed, msg...)
+	}
+	var extraLen [4]byte
+	binary.BigEndian.PutUint32(extraLen[:], uint32(len(extraIn)))
+
+	secNonce := new(SecNonce)
+	var kBig big.Int
+	for i := 0; i < 2; i++ {
+		h := schnorr.TaggedHash("MuSig/nonce", r, []byte{byte(len(pk))}, pk, []byte{byte(len(aggPk))}, aggPk,
+			msgPrefixed, extraLen[:], extraIn, []byte{byte(i)})
+		var k fr.Element
+		k.SetBytes(h[:])
+		if k.IsZero() {
+			return nil, pubNonce, errZeroNonce
+		}
+		kb := k.Bytes()
+		copy(secNonce[i*sizeScalar:], kb[:])
+
+		var R secp256k1.G1Affine
+		R.ScalarMultiplicationBase(k.BigInt(&kBig))
+		copy(pubNonce[i*sizePoint:], cbytes(&R))
+	}
+	copy(secNonce[2*sizeScalar:], pk)
+	return secNonce, pubNonce, nil
+}
+
+// NonceAgg aggregates the public nonces of the signers.
+//
+// BIP-327, NonceAgg
+func NonceAgg(pubNonces []PubNonce) (AggNonce, error) {
+	var aggNonce AggNonce
+	for j := 0; j < 2; j++ {
+		var R secp256k1.G1Jac
+		for i := range pubNonces {
+			p, err := cpoint(pubNonces[i][j*sizePoint : (j+1)*sizePoint])
+			if err != nil {
+				return aggNonce, &InvalidContributionError{Signer: i, Contribution: "pubnonce"}
+			}
+			R.AddMixed(&p)
+		}
+		var r secp256k1.G1Affine
+		r.FromJacobian(&R)
+		copy(aggNonce[j*sizePoint:], cbytesExt(&r))
+	}
+	return aggNonce, nil
+}
+
+// points decodes the public nonce
+func (pubNonce *PubNonce) points() (r1, r2 secp256k1.G1Affine, err error) {
+	if r1, err = cpoint(pubNonce[:sizePoint]); err != nil {
+		return
+	}
+	r2, err = cpoint(pubNonce[sizePoint:])
+	return
+}
+
+// points decodes the aggregated nonce
+func (aggNonce *AggNonce) points() (r1, r2 secp256k1.G1Affine, err error) {
+	if r1, err = cpointExt(aggNonce[:sizePoint]); err != nil {
+		return
+	}
+	r2, err = cpointExt(aggNonce[sizePoint:])
+	return
+}
+
+// SetBytes sets the public nonce from buf, checking that it encodes two points.
+// It returns the number of bytes read from buf.
+func (pubNonce *PubNonce) SetBytes(buf []byte) (int, error) {
+	var p PubNonce
+	if len(buf) < len(p) {
+		return 0, io.ErrShortBuffer
+	}
+	copy(p[:], buf)
+	if _, _, err := p.points(); err != nil {
+		return 0, err
+	}
+	*pubNonce = p
+	return len(p), nil
+}
+
+// SetBytes sets the aggregated nonce from buf, checking that it encodes two points.
+// It returns the number of bytes read from buf.
+func (aggNonce *AggNonce) SetBytes(buf []byte) (int, error) {
+	var a AggNonce
+	if len(buf) < len(a) {
+		return 0, io.ErrShortBuffer
+	}
+	copy(a[:], buf)
+	if _, _, err := a.points(); err != nil {
+		return 0, err
+	}
+	*aggNonce = a
+	return len(a), nil
+}
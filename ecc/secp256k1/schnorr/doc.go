@@ -0,0 +1,13 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package schnorr provides Schnorr signatures on the secp256k1 curve, as specified in BIP-340,
+// and the Taproot key tweaking of BIP-341.
+//
+// Public keys are x-only: they are encoded as the 32 bytes of the x-coordinate of the point
+// with an even y-coordinate. Signatures are 64 bytes R.x || s.
+//
+// Documentation:
+// - BIP-340: https://github.com/bitcoin/bips/blob/master/bip-0340.mediawiki
+// - BIP-341: https://github.com/bitcoin/bips/blob/master/bip-0341.mediawiki
+package schnorr
@@ -0,0 +1,106 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package schnorr
+
+import (
+	"crypto/subtle"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+var errWrongSize = errors.New("wrong size buffer")
+
+// Bytes returns the binary representation of the public key
+// as the 32 bytes of its x-coordinate in big endian (BIP-340 bytes(P)).
+func (pk *PublicKey) Bytes() []byte {
+	b := pk.A.X.Bytes()
+	return b[:]
+}
+
+// SetBytes sets pk from its x-only binary representation in buf (BIP-340 lift_x).
+// It returns the number of bytes read from the buffer.
+func (pk *PublicKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePublicKey {
+		return 0, io.ErrShortBuffer
+	}
+	p, err := liftX(buf[:sizePublicKey])
+	if err != nil {
+		return 0, err
+	}
+	pk.A = p
+	return sizePublicKey, nil
+}
+
+// Bytes returns the binary representation of pk,
+// as byte array publicKey||scalar
+// where publicKey is as publicKey.Bytes(), and
+// scalar is in big endian, of size sizeFr.
+func (privKey *PrivateKey) Bytes() []byte {
+	var res [sizePrivateKey]byte
+	pubkBin := privKey.PublicKey.A.X.Bytes()
+	subtle.ConstantTimeCopy(1, res[:sizePublicKey], pubkBin[:])
+	subtle.ConstantTimeCopy(1, res[sizePublicKey:sizePrivateKey], privKey.scalar[:])
+	return res[:]
+}
+
+// SetBytes sets pk from buf, where buf is interpreted
+// as  publicKey||scalar
+// where publicKey is as publicKey.Bytes(), and
+// scalar is in big endian, of size sizeFr.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	if len(buf) < sizePrivateKey {
+		return 0, io.ErrShortBuffer
+	}
+	if _, err := privKey.PublicKey.SetBytes(buf[:sizePublicKey]); err != nil {
+		return 0, err
+	}
+	sk := new(big.Int).SetBytes(buf[sizePublicKey:sizePrivateKey])
+	if sk.Sign() == 0 || sk.Cmp(fr.Modulus()) >= 0 {
+		return 0, errInvalidScalar
+	}
+	subtle.ConstantTimeCopy(1, privKey.scalar[:], buf[sizePublicKey:sizePrivateKey])
+	return sizePrivateKey, nil
+}
+
+// SetScalar sets privKey from a BIP-340 secret key, the 32 bytes big endian encoding
+// of an integer in [1, n-1].
+func (privKey *PrivateKey) SetScalar(buf []byte) error {
+	if len(buf) != sizeFr {
+		return errWrongSize
+	}
+	sk := new(big.Int).SetBytes(buf)
+	if sk.Sign() == 0 || sk.Cmp(fr.Modulus()) >= 0 {
+		return errInvalidScalar
+	}
+	privKey.PublicKey.A.ScalarMultiplicationBase(sk)
+	privKey.PublicKey.A.Y = evenY(&privKey.PublicKey.A.Y)
+	copy(privKey.scalar[:], buf)
+	return nil
+}
+
+// Bytes returns the binary representation of sig
+// as a byte array of size sizeFp+sizeFr r||s
+func (sig *Signature) Bytes() []byte {
+	var res [sizeSignature]byte
+	subtle.ConstantTimeCopy(1, res[:sizeFp], sig.R[:])
+	subtle.ConstantTimeCopy(1, res[sizeFp:], sig.S[:])
+	return res[:]
+}
+
+// SetBytes sets sig from a buffer in binary.
+// buf is read interpreted as r||s; the range of r and s is checked at verification,
+// as specified in BIP-340.
+// It returns the number of bytes read from buf.
+func (sig *Signature) SetBytes(buf []byte) (int, error) {
+	if len(buf) != sizeSignature {
+		return 0, errWrongSize
+	}
+	copy(sig.R[:], buf[:sizeFp])
+	copy(sig.S[:], buf[sizeFp:])
+	return sizeSignature, nil
+}
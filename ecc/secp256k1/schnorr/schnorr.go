@@ -0,0 +1,338 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package schnorr
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+const (
+	sizeFr         = fr.Bytes
+	sizeFp         = fp.Bytes
+	sizePublicKey  = sizeFp
+	sizePrivateKey = sizeFr + sizePublicKey
+	sizeSignature  = sizeFp + sizeFr
+)
+
+var (
+	errInvalidPublicKey = errors.New("schnorr: the public key is not the x-coordinate of a point on the curve")
+	errInvalidScalar    = errors.New("schnorr: the secret key must be in [1, n-1]")
+	errLengthMismatch   = errors.New("schnorr: the number of public keys, messages and signatures differ")
+	errInvalidTweak     = errors.New("schnorr: invalid tweak")
+)
+
+// PublicKey represents a BIP-340 x-only public key, A has an even y-coordinate.
+type PublicKey struct {
+	A secp256k1.G1Affine
+}
+
+// PrivateKey represents a BIP-340 private key
+type PrivateKey struct {
+	PublicKey PublicKey
+	scalar    [sizeFr]byte // secret scalar, in big Endian
+}
+
+// Signature represents a BIP-340 signature
+type Signature struct {
+	R [sizeFp]byte // x-coordinate of the nonce point
+	S [sizeFr]byte
+}
+
+// GenerateKey generates a public and private key pair.
+func GenerateKey(rand io.Reader) (*PrivateKey, error) {
+	b := make([]byte, fr.Bits/8+8)
+	if _, err := io.ReadFull(rand, b); err != nil {
+		return nil, err
+	}
+	k := new(big.Int).SetBytes(b)
+	n := new(big.Int).Sub(fr.Modulus(), big.NewInt(1))
+	k.Mod(k, n).Add(k, big.NewInt(1))
+
+	privateKey := new(PrivateKey)
+	k.FillBytes(privateKey.scalar[:])
+	privateKey.PublicKey.A.ScalarMultiplicationBase(k)
+	privateKey.PublicKey.A.Y = evenY(&privateKey.PublicKey.A.Y)
+	return privateKey, nil
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	bpk := pub.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	var pub PublicKey
+	pub.A.Set(&privKey.PublicKey.A)
+	return &pub
+}
+
+// TaggedHash returns SHA256(SHA256(tag) || SHA256(tag) || msg₀ || msg₁ || ...).
+//
+// BIP-340, Design
+func TaggedHash(tag string, msgs ...[]byte) [32]byte {
+	t := sha256.Sum256([]byte(tag))
+	h := sha256.New()
+	h.Write(t[:])
+	h.Write(t[:])
+	for _, m := range msgs {
+		h.Write(m)
+	}
+	var res [32]byte
+	h.Sum(res[:0])
+	return res
+}
+
+// Sign performs the BIP-340 signature with 32 bytes of auxiliary randomness read from crypto/rand.
+// If hFunc is provided, the message is first hashed with it.
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	var aux [32]byte
+	if _, err := io.ReadFull(rand.Reader, aux[:]); err != nil {
+		return nil, err
+	}
+	return privKey.SignWithAuxRand(message, aux[:], hFunc)
+}
+
+// SignWithAuxRand performs the BIP-340 signature
+//
+// d = sk if P = sk ⋅ G has an even y-coordinate, -sk otherwise
+// t = d xor hash_BIP0340/aux(a)
+// k = hash_BIP0340/nonce(t || bytes(P) || m), negated if R = k ⋅ G has an odd y-coordinate
+// e = hash_BIP0340/challenge(bytes(R) || bytes(P) || m)
+// signature = {bytes(R), k + e ⋅ d}
+//
+// The nonce is derived deterministically from the key, the message and the auxiliary data aux,
+// which should be 32 fresh random bytes; with a constant aux (e.g. zeros) the signature is
+// deterministic. If hFunc is provided, the message is first hashed with it.
+//
+// BIP-340, Default Signing
+func (privKey *PrivateKey) SignWithAuxRand(message, aux []byte, hFunc hash.Hash) ([]byte, error) {
+	message, err := prehash(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	sk := new(big.Int).SetBytes(privKey.scalar[:])
+	var P secp256k1.G1Affine
+	P.ScalarMultiplicationBase(sk)
+	var d fr.Element
+	d.SetBigInt(sk)
+	if d.IsZero() {
+		return nil, errInvalidScalar
+	}
+	if isOdd(&P.Y) {
+		d.Neg(&d)
+	}
+	dBytes := d.Bytes()
+	pBytes := P.X.Bytes()
+
+	t := TaggedHash("BIP0340/aux", aux)
+	for i := range t {
+		t[i] ^= dBytes[i]
+	}
+	rand := TaggedHash("BIP0340/nonce", t[:], pBytes[:], message)
+	var k fr.Element
+	k.SetBytes(rand[:])
+	if k.IsZero() {
+		return nil, errors.New("schnorr: zero nonce")
+	}
+
+	var R secp256k1.G1Affine
+	var kBig big.Int
+	R.ScalarMultiplicationBase(k.BigInt(&kBig))
+	if isOdd(&R.Y) {
+		k.Neg(&k)
+	}
+	rBytes := R.X.Bytes()
+
+	e := challenge(rBytes[:], pBytes[:], message)
+	var s fr.Element
+	s.Mul(&e, &d).Add(&s, &k)
+
+	var sig Signature
+	sig.R = rBytes
+	sig.S = s.Bytes()
+	return sig.Bytes(), nil
+}
+
+// Verify validates the BIP-340 signature
+//
+// e = hash_BIP0340/challenge(bytes(R) || bytes(P) || m)
+// R ?= s ⋅ G - e ⋅ P, with an even y-coordinate
+//
+// If hFunc is provided, the message is first hashed with it.
+//
+// BIP-340, Verification
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	message, err := prehash(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+	var sig Signature
+	if _, err := sig.SetBytes(sigBin); err != nil {
+		return false, err
+	}
+	var rx fp.Element
+	if err := rx.SetBytesCanonical(sig.R[:]); err != nil {
+		return false, nil
+	}
+	var s fr.Element
+	if err := s.SetBytesCanonical(sig.S[:]); err != nil {
+		return false, nil
+	}
+
+	pBytes := pub.A.X.Bytes()
+	e := challenge(sig.R[:], pBytes[:], message)
+	e.Neg(&e)
+
+	var R secp256k1.G1Jac
+	var sBig, eBig big.Int
+	R.JointScalarMultiplicationBase(&pub.A, s.BigInt(&sBig), e.BigInt(&eBig))
+	var r secp256k1.G1Affine
+	r.FromJacobian(&R)
+	if r.IsInfinity() || isOdd(&r.Y) {
+		return false, nil
+	}
+	return r.X.Equal(&rx), nil
+}
+
+// BatchVerify validates the signatures[i] of messages[i] by publicKeys[i] at once, with a single
+// multi-scalar multiplication. It returns true if and only if all the signatures are valid, except
+// with negligible probability over the randomness read from rand.
+//
+// (s₁ + a₂s₂ + ... + aᵤsᵤ) ⋅ G ?= R₁ + a₂ ⋅ R₂ + ... + aᵤ ⋅ Rᵤ + e₁ ⋅ P₁ + (a₂e₂) ⋅ P₂ + ... + (aᵤeᵤ) ⋅ Pᵤ
+//
+// BIP-340, Batch Verification
+func BatchVerify(publicKeys []*PublicKey, messages, signatures [][]byte, rand io.Reader) (bool, error) {
+	u := len(publicKeys)
+	if len(messages) != u || len(signatures) != u {
+		return false, errLengthMismatch
+	}
+	if u == 0 {
+		return true, nil
+	}
+
+	// points = [G, R₁, ..., Rᵤ, P₁, ..., Pᵤ]
+	points := make([]secp256k1.G1Affine, 2*u+1)
+	scalars := make([]fr.Element, 2*u+1)
+	_, points[0] = secp256k1.Generators()
+
+	var buf [sizeFr]byte
+	for i := 0; i < u; i++ {
+		var sig Signature
+		if _, err := sig.SetBytes(signatures[i]); err != nil {
+			return false, err
+		}
+		R, err := liftX(sig.R[:])
+		if err != nil {
+			return false, nil
+		}
+		var s fr.Element
+		if err := s.SetBytesCanonical(sig.S[:]); err != nil {
+			return false, nil
+		}
+
+		// a₁ = 1, aᵢ random in [1, n-1]
+		var a fr.Element
+		a.SetOne()
+		if i > 0 {
+			for a.IsZero() {
+				if _, err := io.ReadFull(rand, buf[:]); err != nil {
+					return false, err
+				}
+				a.SetBytes(buf[:])
+			}
+		}
+
+		pBytes := publicKeys[i].A.X.Bytes()
+		e := challenge(sig.R[:], pBytes[:], messages[i])
+
+		s.Mul(&s, &a)
+		scalars[0].Add(&scalars[0], &s)
+		points[1+i] = R
+		scalars[1+i].Neg(&a)
+		points[1+u+i] = publicKeys[i].A
+		scalars[1+u+i].Mul(&a, &e).Neg(&scalars[1+u+i])
+	}
+
+	var res secp256k1.G1Jac
+	if _, err := res.MultiExp(points, scalars, ecc.MultiExpConfig{}); err != nil {
+		return false, err
+	}
+	return res.Z.IsZero(), nil
+}
+
+// challenge returns hash_BIP0340/challenge(r || p || m) mod n
+func challenge(r, p, message []byte) fr.Element {
+	h := TaggedHash("BIP0340/challenge", r, p, message)
+	var e fr.Element
+	e.SetBytes(h[:])
+	return e
+}
+
+// liftX returns the point with the x-coordinate encoded in buf and an even y-coordinate.
+//
+// BIP-340, lift_x
+func liftX(buf []byte) (secp256k1.G1Affine, error) {
+	var p secp256k1.G1Affine
+	if len(buf) != sizeFp {
+		return p, errInvalidPublicKey
+	}
+	if err := p.X.SetBytesCanonical(buf); err != nil {
+		return p, errInvalidPublicKey
+	}
+	// y² = x³ + 7
+	var y2 fp.Element
+	_, b := secp256k1.CurveCoefficients()
+	y2.Square(&p.X).Mul(&y2, &p.X).Add(&y2, &b)
+	if p.Y.Sqrt(&y2) == nil {
+		return p, errInvalidPublicKey
+	}
+	p.Y = evenY(&p.Y)
+	return p, nil
+}
+
+// evenY returns y or -y, whichever is even
+func evenY(y *fp.Element) fp.Element {
+	res := *y
+	if isOdd(y) {
+		res.Neg(y)
+	}
+	return res
+}
+
+func isOdd(y *fp.Element) bool {
+	b := y.Bytes()
+	return b[sizeFp-1]&1 == 1
+}
+
+// prehash returns hFunc(message), or message if hFunc is nil
+func prehash(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc == nil {
+		return message, nil
+	}
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, err
+	}
+	return hFunc.Sum(nil), nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package schnorr
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+// TestVectors runs the BIP-340 test vectors
+// https://github.com/bitcoin/bips/blob/master/bip-0340/test-vectors.csv
+func TestVectors(t *testing.T) {
+	f, err := os.Open("testdata/test-vectors.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range records[1:] {
+		index, secretKey, publicKey, auxRand, message, sig, result := r[0], r[1], r[2], r[3], r[4], r[5], r[6] == "TRUE"
+		decode := func(s string) []byte {
+			b, err := hex.DecodeString(s)
+			if err != nil {
+				t.Fatalf("vector %s: %v", index, err)
+			}
+			return b
+		}
+		msg := decode(message)
+
+		if secretKey != "" {
+			var privKey PrivateKey
+			if err := privKey.SetScalar(decode(secretKey)); err != nil {
+				t.Fatalf("vector %s: %v", index, err)
+			}
+			if !strings.EqualFold(hex.EncodeToString(privKey.PublicKey.Bytes()), publicKey) {
+				t.Fatalf("vector %s: wrong public key", index)
+			}
+			s, err := privKey.SignWithAuxRand(msg, decode(auxRand), nil)
+			if err != nil {
+				t.Fatalf("vector %s: %v", index, err)
+			}
+			if !strings.EqualFold(hex.EncodeToString(s), sig) {
+				t.Fatalf("vector %s: wrong signature", index)
+			}
+		}
+
+		var pub PublicKey
+		ok := false
+		if _, err := pub.SetBytes(decode(publicKey)); err == nil {
+			ok, _ = pub.Verify(decode(sig), msg, nil)
+			if batch, _ := BatchVerify([]*PublicKey{&pub}, [][]byte{msg}, [][]byte{decode(sig)}, rand.Reader); batch != ok {
+				t.Fatalf("vector %s: batch and single verification differ", index)
+			}
+		}
+		if ok != result {
+			t.Fatalf("vector %s: expected verification %v", index, result)
+		}
+	}
+}
+
+func TestSchnorr(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	properties := gopter.NewProperties(parameters)
+
+	properties.Property("[SECP256K1] test the signing and verification", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing Schnorr")
+			hFunc := sha256.New()
+			sig, _ := privKey.Sign(msg, hFunc)
+			flag, _ := publicKey.Verify(sig, msg, hFunc)
+
+			return flag
+		},
+	))
+
+	properties.Property("[SECP256K1] test the signing and verification (pre-hashed)", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			publicKey := privKey.PublicKey
+
+			msg := []byte("testing Schnorr")
+			sig, _ := privKey.Sign(msg, nil)
+			flag, _ := publicKey.Verify(sig, msg, nil)
+
+			return flag
+		},
+	))
+
+	properties.Property("[SECP256K1] test the serialization round trip", prop.ForAll(
+		func() bool {
+
+			privKey, _ := GenerateKey(rand.Reader)
+			var priv PrivateKey
+			if _, err := priv.SetBytes(privKey.Bytes()); err != nil {
+				return false
+			}
+			return priv.scalar == privKey.scalar && privKey.Public().Equal(&priv.PublicKey)
+		},
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestBatchVerify(t *testing.T) {
+	const n = 8
+	publicKeys := make([]*PublicKey, n)
+	messages := make([][]byte, n)
+	signatures := make([][]byte, n)
+	for i := range publicKeys {
+		privKey, _ := GenerateKey(rand.Reader)
+		publicKeys[i] = &privKey.PublicKey
+		messages[i] = []byte{byte(i)}
+		signatures[i], _ = privKey.Sign(messages[i], nil)
+	}
+
+	if ok, err := BatchVerify(publicKeys, messages, signatures, rand.Reader); !ok || err != nil {
+		t.Fatal("valid signatures rejected")
+	}
+	messages[n-1][0] ^= 1
+	if ok, _ := BatchVerify(publicKeys, messages, signatures, rand.Reader); ok {
+		t.Fatal("invalid signature accepted")
+	}
+	if _, err := BatchVerify(publicKeys, messages[1:], signatures, rand.Reader); err == nil {
+		t.Fatal("expected an error for mismatched lengths")
+	}
+}
+
+// BIP-341 wallet test vectors, scriptPubKey
+// https://github.com/bitcoin/bips/blob/master/bip-0341/wallet-test-vectors.json
+func TestTweak(t *testing.T) {
+	vectors := []struct {
+		internalKey, merkleRoot, tweakedKey string
+	}{
+		{"d6889cb081036e0faefa3a35157ad71086b123b2b144b649798b494c300a961d", "", "53a1f6e454df1aa2776a2814a721372d6258050de330b3c6d10ee8f4e0dda343"},
+		{"187791b6f712a8ea41c8ecdd0ee77fab3e85263b37e1ec18a3651926b3a6cf27", "5b75adecf53548f3ec6ad7d78383bf84cc57b55a3127c72b9a2481752dd88b21", "147c9c57132f6e7ecddba9800bb0c4449251c92a1e60371ee77557b6620f3ea3"},
+	}
+	for i, v := range vectors {
+		internalKey, _ := hex.DecodeString(v.internalKey)
+		merkleRoot, _ := hex.DecodeString(v.merkleRoot)
+		var pub PublicKey
+		if _, err := pub.SetBytes(internalKey); err != nil {
+			t.Fatal(err)
+		}
+		q, _, err := TweakPublicKey(&pub, merkleRoot)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(q.Bytes()) != v.tweakedKey {
+			t.Fatalf("vector %d: wrong tweaked key", i)
+		}
+	}
+
+	// a key path spend is signed by the tweaked private key
+	privKey, _ := GenerateKey(rand.Reader)
+	merkleRoot := make([]byte, 32)
+	tweaked, err := TweakPrivateKey(privKey, merkleRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, _, err := TweakPublicKey(&privKey.PublicKey, merkleRoot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.Equal(&tweaked.PublicKey) {
+		t.Fatal("the tweaked keys don't match")
+	}
+	msg := []byte("key path spend")
+	sig, _ := tweaked.Sign(msg, nil)
+	if ok, _ := q.Verify(sig, msg, nil); !ok {
+		t.Fatal("key path signature rejected")
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	privKey, _ := GenerateKey(rand.Reader)
+	msg := []byte("benchmarking Schnorr")
+	sig, _ := privKey.Sign(msg, nil)
+	b.ResetTimer()
+	for j := 0; j < b.N; j++ {
+		privKey.PublicKey.Verify(sig, msg, nil)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package schnorr
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// TapTweak returns the BIP-341 tweak of the internal key pub,
+// t = hash_TapTweak(bytes(P) || merkleRoot). merkleRoot is empty for a key path only output.
+func (pub *PublicKey) TapTweak(merkleRoot []byte) (fr.Element, error) {
+	h := TaggedHash("TapTweak", pub.Bytes(), merkleRoot)
+	var t fr.Element
+	if err := t.SetBytesCanonical(h[:]); err != nil {
+		return t, errInvalidTweak
+	}
+	return t, nil
+}
+
+// TweakPublicKey returns the BIP-341 output key Q = P + t ⋅ G of the internal key pub, with
+// t = TapTweak(merkleRoot), and the parity of the y-coordinate of Q (needed in the control
+// blocks of script path spends).
+//
+// BIP-341, taproot_tweak_pubkey
+func TweakPublicKey(pub *PublicKey, merkleRoot []byte) (*PublicKey, bool, error) {
+	t, err := pub.TapTweak(merkleRoot)
+	if err != nil {
+		return nil, false, err
+	}
+	var tBig big.Int
+	var Q secp256k1.G1Jac
+	Q.ScalarMultiplicationBase(t.BigInt(&tBig)).AddMixed(&pub.A)
+
+	var q PublicKey
+	q.A.FromJacobian(&Q)
+	if q.A.IsInfinity() {
+		return nil, false, errInvalidTweak
+	}
+	odd := isOdd(&q.A.Y)
+	q.A.Y = evenY(&q.A.Y)
+	return &q, odd, nil
+}
+
+// TweakPrivateKey returns the private key of the output key TweakPublicKey(privKey.PublicKey, merkleRoot),
+// to sign key path spends.
+//
+// BIP-341, taproot_tweak_seckey
+func TweakPrivateKey(privKey *PrivateKey, merkleRoot []byte) (*PrivateKey, error) {
+	t, err := privKey.PublicKey.TapTweak(merkleRoot)
+	if err != nil {
+		return nil, err
+	}
+
+	var d fr.Element
+	d.SetBytes(privKey.scalar[:])
+	var P secp256k1.G1Affine
+	var dBig big.Int
+	P.ScalarMultiplicationBase(d.BigInt(&dBig))
+	if isOdd(&P.Y) {
+		d.Neg(&d)
+	}
+	d.Add(&d, &t)
+	if d.IsZero() {
+		return nil, errInvalidTweak
+	}
+
+	tweaked := new(PrivateKey)
+	b := d.Bytes()
+	if err := tweaked.SetScalar(b[:]); err != nil {
+		return nil, err
+	}
+	return tweaked, nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecdsa
+
+import (
+	"crypto/hmac"
+	"hash"
+	"math/big"
+)
+
+// SetDeterministicNonces selects how the signer generates the nonces k. If h is not nil, the
+// nonces are derived deterministically from the private key and the hash of the message, with
+// the HMAC-DRBG of RFC 6979 instantiated with h (which should be the hash function of the
+// messages, e.g. sha256.New). If h is nil, the nonces are random (default).
+//
+// RFC 6979, Section 3.2
+func (privKey *PrivateKey) SetDeterministicNonces(h func() hash.Hash) {
+	privKey.nonceHash = h
+}
+
+// hmacDRBG is the HMAC-DRBG of RFC 6979, Section 3.2, which generates the candidates for the
+// nonce k in [1, q-1]
+type hmacDRBG struct {
+	h    func() hash.Hash
+	k, v []byte
+	q    *big.Int
+}
+
+// newHMACDRBG returns the generator of the nonces for the private key x and the hash h1 of the
+// message (steps a. to g.)
+func newHMACDRBG(h func() hash.Hash, q, x *big.Int, h1 []byte) *hmacDRBG {
+	d := &hmacDRBG{h: h, q: q}
+	hlen := h().Size()
+	d.v = make([]byte, hlen)
+	for i := range d.v {
+		d.v[i] = 0x01
+	}
+	d.k = make([]byte, hlen)
+
+	// int2octets(x) || bits2octets(h1)
+	seed := append(d.int2octets(x), d.bits2octets(h1)...)
+
+	d.k = d.mac(d.v, []byte{0x00}, seed)
+	d.v = d.mac(d.v)
+	d.k = d.mac(d.v, []byte{0x01}, seed)
+	d.v = d.mac(d.v)
+	return d
+}
+
+// next returns the next candidate k in [1, q-1] (step h.). Calling it again generates another
+// candidate, if the previous one led to r = 0 or s = 0.
+func (d *hmacDRBG) next() *big.Int {
+	qlen := d.q.BitLen()
+	for {
+		var t []byte
+		for len(t)*8 < qlen {
+			d.v = d.mac(d.v)
+			t = append(t, d.v...)
+		}
+		k := d.bits2int(t)
+
+		// update the state for the next candidate
+		d.k = d.mac(d.v, []byte{0x00})
+		d.v = d.mac(d.v)
+		if k.Sign() > 0 && k.Cmp(d.q) < 0 {
+			return k
+		}
+	}
+}
+
+// mac returns HMAC_K(msgs[0] || msgs[1] || ...)
+func (d *hmacDRBG) mac(msgs ...[]byte) []byte {
+	m := hmac.New(d.h, d.k)
+	for _, msg := range msgs {
+		m.Write(msg)
+	}
+	return m.Sum(nil)
+}
+
+// bits2int keeps the qlen leftmost bits of b (RFC 6979, Section 2.3.2)
+func (d *hmacDRBG) bits2int(b []byte) *big.Int {
+	res := new(big.Int).SetBytes(b)
+	if excess := len(b)*8 - d.q.BitLen(); excess > 0 {
+		res.Rsh(res, uint(excess))
+	}
+	return res
+}
+
+// int2octets returns the big endian encoding of x on rlen = ⌈qlen/8⌉ bytes (RFC 6979, Section 2.3.3)
+func (d *hmacDRBG) int2octets(x *big.Int) []byte {
+	return x.FillBytes(make([]byte, (d.q.BitLen()+7)/8))
+}
+
+// bits2octets returns int2octets(bits2int(b) mod q) (RFC 6979, Section 2.3.4)
+func (d *hmacDRBG) bits2octets(b []byte) []byte {
+	z := d.bits2int(b)
+	if z.Cmp(d.q) >= 0 {
+		z.Sub(z, d.q)
+	}
+	return d.int2octets(z)
+}
@@ -0,0 +1,192 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package ecfft
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/stark-curve/fr"
+)
+
+const maxTestLogSize = min(8, MaxLogSize)
+
+func TestCurveParameters(t *testing.T) {
+	var a, b fr.Element
+	var g, r affinePoint
+	setString(&a, curveA)
+	setString(&b, curveB)
+	setString(&g.x, gX)
+	setString(&g.y, gY)
+	setString(&r.x, rX)
+	setString(&r.y, rY)
+
+	isOnCurve := func(p *affinePoint) bool {
+		var lhs, rhs fr.Element
+		lhs.Square(&p.y)
+		rhs.Square(&p.x).Add(&rhs, &a).Mul(&rhs, &p.x).Add(&rhs, &b)
+		return lhs.Equal(&rhs)
+	}
+	if !isOnCurve(&g) || !isOnCurve(&r) {
+		t.Fatal("G and R should be on the curve")
+	}
+
+	// 2^(MaxLogSize-1)·G has order 2
+	for i := 0; i < MaxLogSize-1; i++ {
+		if g.y.IsZero() {
+			t.Fatal("G should have order 2^MaxLogSize")
+		}
+		g.double(&g, &a)
+		if !isOnCurve(&g) {
+			t.Fatal("2ⁱ·G should be on the curve")
+		}
+	}
+	if !g.y.IsZero() {
+		t.Fatal("G should have order 2^MaxLogSize")
+	}
+}
+
+func TestPoints(t *testing.T) {
+	domain := NewDomain(1 << maxTestLogSize)
+	points := domain.Points()
+	seen := make(map[fr.Element]bool)
+	for i := range points {
+		if points[i].IsZero() {
+			t.Fatal("the points should be non zero")
+		}
+		if seen[points[i]] {
+			t.Fatal("the points should be distinct")
+		}
+		seen[points[i]] = true
+	}
+
+	// the smaller domains are the subsets of points L₀[2ˢ·j]
+	for logSize := 0; logSize < maxTestLogSize; logSize++ {
+		small := NewDomain(1 << logSize).Points()
+		for j := range small {
+			if !small[j].Equal(&points[j<<(maxTestLogSize-logSize)]) {
+				t.Fatalf("size %d: point %d doesn't match", 1<<logSize, j)
+			}
+		}
+	}
+}
+
+func TestEnterExit(t *testing.T) {
+	for logSize := 0; logSize <= maxTestLogSize; logSize++ {
+		domain := NewDomain(1 << logSize)
+		n := int(domain.Cardinality)
+		t.Run(fmt.Sprintf("%d", n), func(t *testing.T) {
+			pol := randomPolynomial(n)
+			points := domain.Points()
+
+			v := make([]fr.Element, n)
+			copy(v, pol)
+			domain.Enter(v)
+			for i := range points {
+				expected := evaluatePolynomial(pol, &points[i])
+				if !v[i].Equal(&expected) {
+					t.Fatalf("Enter: wrong evaluation at index %d", i)
+				}
+			}
+
+			domain.Exit(v)
+			for i := range pol {
+				if !v[i].Equal(&pol[i]) {
+					t.Fatalf("Exit(Enter(p)) != p at index %d", i)
+				}
+			}
+		})
+	}
+}
+
+func TestExtend(t *testing.T) {
+	for logSize := 1; logSize <= maxTestLogSize; logSize++ {
+		domain := NewDomain(1 << logSize)
+		n := int(domain.Cardinality)
+		pol := randomPolynomial(n / 2)
+		points := domain.Points()
+
+		even := make([]fr.Element, n/2)
+		for j := range even {
+			even[j] = evaluatePolynomial(pol, &points[2*j])
+		}
+		odd := domain.Extend(even)
+		for j := range odd {
+			expected := evaluatePolynomial(pol, &points[2*j+1])
+			if !odd[j].Equal(&expected) {
+				t.Fatalf("size %d: wrong evaluation at index %d", n, 2*j+1)
+			}
+		}
+	}
+}
+
+func TestReduce(t *testing.T) {
+	for logSize := 1; logSize <= maxTestLogSize; logSize++ {
+		domain := NewDomain(1 << logSize)
+		n := int(domain.Cardinality)
+		pol := randomPolynomial(n)
+		points := domain.Points()
+
+		evals := make([]fr.Element, n)
+		for j := range evals {
+			evals[j] = evaluatePolynomial(pol, &points[j])
+		}
+		reduced := domain.Reduce(evals)
+
+		// P mod X^(n/2) is made of the first n/2 coefficients of P
+		for j := range reduced {
+			expected := evaluatePolynomial(pol[:n/2], &points[2*j])
+			if !reduced[j].Equal(&expected) {
+				t.Fatalf("size %d: wrong evaluation at index %d", n, 2*j)
+			}
+		}
+	}
+}
+
+func randomPolynomial(n int) []fr.Element {
+	pol := make([]fr.Element, n)
+	for i := range pol {
+		pol[i].MustSetRandom()
+	}
+	return pol
+}
+
+func evaluatePolynomial(pol []fr.Element, x *fr.Element) fr.Element {
+	var res fr.Element
+	for i := len(pol) - 1; i >= 0; i-- {
+		res.Mul(&res, x).Add(&res, &pol[i])
+	}
+	return res
+}
+
+func BenchmarkECFFT(b *testing.B) {
+	const logSize = min(14, MaxLogSize)
+	domain := NewDomain(1 << logSize)
+	pol := randomPolynomial(int(domain.Cardinality))
+	// precompute the tables
+	domain.Exit(make([]fr.Element, domain.Cardinality))
+
+	b.Run("Enter", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			domain.Enter(pol)
+		}
+	})
+	b.Run("Exit", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			domain.Exit(pol)
+		}
+	})
+	b.Run("Extend", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			domain.Extend(pol[:domain.Cardinality/2])
+		}
+	})
+	b.Run("Reduce", func(b *testing.B) {
+		for j := 0; j < b.N; j++ {
+			domain.Reduce(pol)
+		}
+	})
+}
@@ -0,0 +1,213 @@
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+#define LOAD_GOLDILOCKS(in0, in1, in2) \
+	MOVQ         $0xffffffff00000001, AX            \
+	VPBROADCASTQ AX, in0                            \
+	MOVQ         $0x00000000ffffffff, AX            \
+	VPBROADCASTQ AX, in1                            \
+	VPTERNLOGD   $0x00000000000000ff, in2, in2, in2 \
+	MOVQ         $0x0000000000005555, AX            \
+	KMOVD        AX, K3                             \
+
+#define ADD_G(in0, in1, in2, in3, in4, in5, in6) \
+	VPADDQ  in0, in1, in3     \
+	VPCMPUQ $1, in1, in3, K4  \
+	VPADDQ  in6, in3, K4, in3 \
+	VPSUBQ  in5, in3, in4     \
+	VPMINUQ in3, in4, in2     \
+
+#define SUB_G(in0, in1, in2, in3) \
+	VPCMPUQ $1, in1, in0, K4  \
+	VPSUBQ  in1, in0, in2     \
+	VPADDQ  in3, in2, K4, in2 \
+
+#define MUL_G(in0, in1, in2, in3, in4, in5, in6, in7, in8, in9, in10, in11, in12) \
+	VPSRLQ    $32, in0, in3      \
+	VPSRLQ    $32, in1, in4      \
+	VPMULUDQ  in0, in1, in5      \
+	VPMULUDQ  in0, in4, in6      \
+	VPMULUDQ  in3, in1, in7      \
+	VPMULUDQ  in3, in4, in8      \
+	VPSRLQ    $32, in5, in9      \
+	VPADDQ    in9, in7, in7      \
+	VPANDQ    in11, in7, in9     \
+	VPADDQ    in9, in6, in6      \
+	VPSRLQ    $32, in7, in9      \
+	VPADDQ    in9, in8, in8      \
+	VPSRLQ    $32, in6, in9      \
+	VPADDQ    in9, in8, in8      \
+	VPSLLQ    $32, in6, in9      \
+	VPBLENDMD in5, in9, K3, in5  \
+	VPSLLQ    $32, in5, in9      \
+	VPADDQ    in9, in5, in3      \
+	VPCMPUQ   $1, in5, in3, K4   \
+	VPSRLQ    $32, in3, in9      \
+	VPSUBQ    in9, in3, in3      \
+	VPADDQ    in12, in3, K4, in3 \
+	VPCMPUQ   $1, in3, in8, K4   \
+	VPSUBQ    in3, in8, in2      \
+	VPADDQ    in10, in2, K4, in2 \
+
+// addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·addVec(SB), NOSPLIT, $0-32
+	LOAD_GOLDILOCKS(Z0, Z1, Z2)
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), R15
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+
+loop_1:
+	TESTQ     BX, BX
+	JEQ       done_2
+	DECQ      BX
+	VMOVDQU64 0(R15), Z10
+	VMOVDQU64 0(DX), Z11
+	ADD_G(Z10, Z11, Z10, Z8, Z9, Z0, Z1)
+	VMOVDQU64 Z10, 0(CX)  // res = a + b
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	ADDQ $64, DX
+	ADDQ $64, CX
+	JMP  loop_1
+
+done_2:
+	RET
+
+// subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·subVec(SB), NOSPLIT, $0-32
+	LOAD_GOLDILOCKS(Z0, Z1, Z2)
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), R15
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+
+loop_3:
+	TESTQ     BX, BX
+	JEQ       done_4
+	DECQ      BX
+	VMOVDQU64 0(R15), Z10
+	VMOVDQU64 0(DX), Z11
+	SUB_G(Z10, Z11, Z10, Z0)
+	VMOVDQU64 Z10, 0(CX)  // res = a - b
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	ADDQ $64, DX
+	ADDQ $64, CX
+	JMP  loop_3
+
+done_4:
+	RET
+
+// sumVec(t *Element, a *Element, n uint64) t[0...8] = sum(a[0...n])
+// n is the number of blocks of 8 elements to process
+// the caller needs to add the 8 reduced accumulators
+TEXT ·sumVec(SB), NOSPLIT, $0-24
+	LOAD_GOLDILOCKS(Z0, Z1, Z2)
+	MOVQ   t+0(FP), DX
+	MOVQ   a+8(FP), R15
+	MOVQ   n+16(FP), CX
+	VPXORQ Z11, Z11, Z11 // acc = 0
+
+loop_5:
+	TESTQ     CX, CX
+	JEQ       done_6
+	DECQ      CX
+	VMOVDQU64 0(R15), Z10
+	ADD_G(Z11, Z10, Z11, Z8, Z9, Z0, Z1)
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	JMP  loop_5
+
+done_6:
+	VMOVDQU64 Z11, 0(DX) // t = acc
+	RET
+
+// mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]
+// n is the number of blocks of 8 elements to process
+TEXT ·mulVec(SB), NOSPLIT, $0-32
+	LOAD_GOLDILOCKS(Z0, Z1, Z2)
+	MOVQ res+0(FP), CX
+	MOVQ a+8(FP), R15
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), BX
+
+loop_7:
+	TESTQ     BX, BX
+	JEQ       done_8
+	DECQ      BX
+	VMOVDQU64 0(R15), Z10
+	VMOVDQU64 0(DX), Z11
+	MUL_G(Z10, Z11, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	VMOVDQU64 Z10, 0(CX)  // res = a * b
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	ADDQ $64, DX
+	ADDQ $64, CX
+	JMP  loop_7
+
+done_8:
+	RET
+
+// scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b
+// n is the number of blocks of 8 elements to process
+TEXT ·scalarMulVec(SB), NOSPLIT, $0-32
+	LOAD_GOLDILOCKS(Z0, Z1, Z2)
+	MOVQ         res+0(FP), CX
+	MOVQ         a+8(FP), R15
+	MOVQ         b+16(FP), DX
+	MOVQ         n+24(FP), BX
+	VPBROADCASTQ 0(DX), Z11
+
+loop_9:
+	TESTQ     BX, BX
+	JEQ       done_10
+	DECQ      BX
+	VMOVDQU64 0(R15), Z10
+	MUL_G(Z10, Z11, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	VMOVDQU64 Z10, 0(CX)  // res = a * b
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	ADDQ $64, CX
+	JMP  loop_9
+
+done_10:
+	RET
+
+// innerProdVec(t *Element, a, b *Element, n uint64) t[0...8] = sum(a[0...n] * b[0...n])
+// n is the number of blocks of 8 elements to process
+// the caller needs to add the 8 reduced accumulators
+TEXT ·innerProdVec(SB), NOSPLIT, $0-32
+	LOAD_GOLDILOCKS(Z0, Z1, Z2)
+	MOVQ   t+0(FP), CX
+	MOVQ   a+8(FP), R15
+	MOVQ   b+16(FP), DX
+	MOVQ   n+24(FP), BX
+	VPXORQ Z12, Z12, Z12 // acc = 0
+
+loop_11:
+	TESTQ     BX, BX
+	JEQ       done_12
+	DECQ      BX
+	VMOVDQU64 0(R15), Z10
+	VMOVDQU64 0(DX), Z11
+	MUL_G(Z10, Z11, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	ADD_G(Z12, Z10, Z12, Z8, Z9, Z0, Z1)
+
+	// increment pointers to visit next element
+	ADDQ $64, R15
+	ADDQ $64, DX
+	JMP  loop_11
+
+done_12:
+	VMOVDQU64 Z12, 0(CX) // t = acc
+	RET
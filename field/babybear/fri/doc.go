@@ -0,0 +1,20 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// Package fri implements a batched FRI low degree test over the babybear field.
+//
+// The polynomials are committed in the base field, with Poseidon2 Merkle trees
+// whose leaves store the codewords, while the folding challenges are sampled in
+// the degree 4 extension 𝔽r⁴ in which all the folded codewords live.
+//
+// Polynomials of differing sizes are batched by injecting each of them in the
+// folding round whose domain matches its size: the codeword of a polynomial
+// p of size n injected in a round of size D contributes (α²ʲ + α²ʲ⁺¹·Xᴰ⁻ⁿ)·p
+// to the folded codeword, where α ∈ 𝔽r⁴ is a random challenge, so that a
+// single proof shows that each polynomial has the declared size.
+//
+// The number of queries, the blowup factor, the folding arity and the proof of
+// work are set through a Config.
+package fri
@@ -0,0 +1,710 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"math/big"
+
+	fiatshamir "github.com/consensys/gnark-crypto/fiat-shamir"
+	fr "github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/consensys/gnark-crypto/field/babybear/extensions"
+	"github.com/consensys/gnark-crypto/field/babybear/fft"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var (
+	ErrNoPolynomial  = errors.New("at least one polynomial is required")
+	ErrInvalidSize   = errors.New("the size of a polynomial should be positive")
+	ErrTooLarge      = errors.New("the codewords do not fit in a subgroup of the field")
+	ErrLayerMismatch = errors.New("a folded value does not match the committed codeword")
+	ErrLowDegree     = errors.New("the fully folded codeword does not match the final polynomial")
+)
+
+// InputOpening opening of a leaf of the commitment to the codewords of the
+// polynomials injected in a given round.
+type InputOpening struct {
+
+	// Values evaluations of the polynomials on the fiber of the queried position:
+	// Values[t·m+j] is the evaluation of the j-th polynomial of the round at the t-th
+	// point of the fiber, m being the number of polynomials injected in the round.
+	Values []fr.Element
+
+	// Path authentication path of the leaf.
+	Path MerkleProof
+}
+
+// LayerOpening opening of a leaf of the commitment to a folded codeword.
+type LayerOpening struct {
+
+	// Values evaluations of the folded codeword on the fiber of the queried position.
+	Values []extensions.E4
+
+	// Path authentication path of the leaf.
+	Path MerkleProof
+}
+
+// QueryProof openings answering one query of the verifier.
+type QueryProof struct {
+
+	// Inputs openings of the input codewords, one per round in which polynomials are injected.
+	Inputs []InputOpening
+
+	// Layers openings of the folded codewords, one per round but the first one.
+	Layers []LayerOpening
+}
+
+// Proof proof of proximity of a batch of polynomials to Reed Solomon codes
+// of the declared sizes.
+type Proof struct {
+
+	// InputRoots roots of the commitments to the input codewords, one per round in
+	// which polynomials are injected.
+	InputRoots []Hash
+
+	// LayerRoots roots of the commitments to the folded codewords, one per round but
+	// the first one.
+	LayerRoots []Hash
+
+	// FinalPolynomial coefficients of the fully folded polynomial.
+	FinalPolynomial []extensions.E4
+
+	// Nonce proof of work.
+	Nonce uint64
+
+	// Queries answers to the queries of the verifier.
+	Queries []QueryProof
+}
+
+// layout describes the rounds of the protocol for a list of sizes.
+//
+// In round i, the codeword of size lengths[i] = degrees[i]·BlowupFactor is folded
+// with a challenge βᵢ, after the codewords of the polynomials rounds[i] have been
+// injected. The fully folded codeword is of size lengths[nbRounds] and encodes a
+// polynomial of size degrees[nbRounds].
+type layout struct {
+	arity   int
+	sizes   []int
+	degrees []int
+	lengths []int
+	rounds  [][]int
+}
+
+func newLayout(sizes []int, cfg Config) (layout, error) {
+	if len(sizes) == 0 {
+		return layout{}, ErrNoPolynomial
+	}
+	maxSize := 0
+	for i, s := range sizes {
+		if s < 1 {
+			return layout{}, fmt.Errorf("%w: polynomial %d has size %d", ErrInvalidSize, i, s)
+		}
+		maxSize = max(maxSize, s)
+	}
+
+	res := layout{arity: cfg.Arity, sizes: sizes}
+	d := cfg.Arity
+	for d < maxSize {
+		d *= 2
+	}
+	res.degrees = append(res.degrees, d)
+	for d >= cfg.Arity {
+		d /= cfg.Arity
+		res.degrees = append(res.degrees, d)
+	}
+	for _, d := range res.degrees {
+		res.lengths = append(res.lengths, d*cfg.BlowupFactor)
+	}
+	if _, err := fft.Generator(uint64(res.lengths[0])); err != nil {
+		return layout{}, fmt.Errorf("%w: %w", ErrTooLarge, err)
+	}
+
+	// each polynomial is injected in the last round in which it fits, the
+	// final polynomial being sent in the clear.
+	res.rounds = make([][]int, res.nbRounds())
+	for j, s := range sizes {
+		i := 0
+		for i+1 < res.nbRounds() && res.degrees[i+1] >= s {
+			i++
+		}
+		res.rounds[i] = append(res.rounds[i], j)
+	}
+	return res, nil
+}
+
+// nbRounds returns the number of folding rounds
+func (l *layout) nbRounds() int {
+	return len(l.degrees) - 1
+}
+
+// nbInputs returns the number of rounds in which polynomials are injected
+func (l *layout) nbInputs() int {
+	res := 0
+	for _, r := range l.rounds {
+		if len(r) > 0 {
+			res++
+		}
+	}
+	return res
+}
+
+// challengeNames returns the names of the Fiat Shamir challenges: α, the
+// folding challenges βᵢ, the seed of the proof of work and the seed of the queries.
+func (l *layout) challengeNames() []string {
+	res := []string{"alpha"}
+	for i := 0; i < l.nbRounds(); i++ {
+		res = append(res, fmt.Sprintf("beta_%d", i))
+	}
+	return append(res, "final", "queries")
+}
+
+// bindSetup binds the configuration and the sizes of the polynomials to α
+func (l *layout) bindSetup(fs *fiatshamir.Transcript, cfg Config) error {
+	if err := fs.Bind("alpha", cfg.marshal()); err != nil {
+		return err
+	}
+	bSizes := make([]byte, 4*len(l.sizes))
+	for i, s := range l.sizes {
+		binary.BigEndian.PutUint32(bSizes[4*i:], uint32(s))
+	}
+	return fs.Bind("alpha", bSizes)
+}
+
+// Prove returns a proof that each polynomial, given by its coefficients in
+// the canonical basis, is of degree less than its length.
+//
+// h is used for the Fiat Shamir transform and the proof of work, and should
+// output at least 32 bytes.
+func Prove(polynomials [][]fr.Element, cfg Config, h hash.Hash) (Proof, error) {
+	sizes := make([]int, len(polynomials))
+	for i := range polynomials {
+		sizes[i] = len(polynomials[i])
+	}
+	return prove(polynomials, sizes, cfg, h)
+}
+
+func prove(polynomials [][]fr.Element, sizes []int, cfg Config, h hash.Hash) (Proof, error) {
+	var proof Proof
+	if err := cfg.check(); err != nil {
+		return proof, err
+	}
+	if h.Size() < 32 {
+		return proof, ErrHashSize
+	}
+	l, err := newLayout(sizes, cfg)
+	if err != nil {
+		return proof, err
+	}
+	k := l.arity
+
+	fs := fiatshamir.NewTranscript(h, l.challengeNames()...)
+	if err := l.bindSetup(fs, cfg); err != nil {
+		return proof, err
+	}
+
+	// commit to the codewords of the polynomials, grouped by round
+	domains := make([]*fft.Domain, len(l.lengths))
+	for i := range domains {
+		domains[i] = fft.NewDomain(uint64(l.lengths[i]))
+	}
+	inputCodewords := make([][][]fr.Element, l.nbRounds())
+	inputTrees := make([]*merkleTree, l.nbRounds())
+	for i, round := range l.rounds {
+		if len(round) == 0 {
+			continue
+		}
+		inputCodewords[i] = make([][]fr.Element, len(round))
+		for jj, j := range round {
+			inputCodewords[i][jj] = evaluate(polynomials[j], domains[i])
+		}
+		leaves := make([]Hash, l.lengths[i]/k)
+		parallel.Execute(len(leaves), func(start, end int) {
+			for r := start; r < end; r++ {
+				leaves[r] = hashLeaf(inputLeaf(inputCodewords[i], r, k))
+			}
+		})
+		inputTrees[i] = newMerkleTree(leaves)
+		root := inputTrees[i].root()
+		proof.InputRoots = append(proof.InputRoots, root)
+		if err := fs.Bind("alpha", marshalElements(root[:]...)); err != nil {
+			return proof, err
+		}
+	}
+	alpha, err := deriveE4(fs, "alpha")
+	if err != nil {
+		return proof, err
+	}
+	alphaPowers := powersE4(alpha, 2*len(sizes))
+
+	// fold the codewords, injecting the input codewords at each round
+	codeword := make([]extensions.E4, l.lengths[0])
+	layers := make([][]extensions.E4, l.nbRounds())
+	layerTrees := make([]*merkleTree, l.nbRounds())
+	for i := 0; i < l.nbRounds(); i++ {
+		betaName := fmt.Sprintf("beta_%d", i)
+		if i > 0 {
+			layers[i] = make([]extensions.E4, len(codeword))
+			copy(layers[i], codeword)
+			leaves := make([]Hash, len(codeword)/k)
+			parallel.Execute(len(leaves), func(start, end int) {
+				for r := start; r < end; r++ {
+					leaves[r] = hashLeaf(flattenE4(layerLeaf(layers[i], r, k)))
+				}
+			})
+			layerTrees[i] = newMerkleTree(leaves)
+			root := layerTrees[i].root()
+			proof.LayerRoots = append(proof.LayerRoots, root)
+			if err := fs.Bind(betaName, marshalElements(root[:]...)); err != nil {
+				return proof, err
+			}
+		}
+		for jj, j := range l.rounds[i] {
+			inject(codeword, inputCodewords[i][jj], &alphaPowers[2*j], &alphaPowers[2*j+1], l.degrees[i]-sizes[j], domains[i].Generator)
+		}
+		beta, err := deriveE4(fs, betaName)
+		if err != nil {
+			return proof, err
+		}
+		codeword = fold(codeword, &beta, k, domains[i].Generator)
+	}
+
+	// send the fully folded polynomial
+	proof.FinalPolynomial = interpolate(codeword, domains[l.nbRounds()])[:l.degrees[l.nbRounds()]]
+	if err := fs.Bind("final", marshalE4(proof.FinalPolynomial)); err != nil {
+		return proof, err
+	}
+	seed, err := fs.ComputeChallenge("final")
+	if err != nil {
+		return proof, err
+	}
+	proof.Nonce = grind(h, seed, cfg.GrindingBits)
+
+	// answer the queries
+	positions, err := deriveQueries(fs, h, proof.Nonce, cfg.NbQueries, l.lengths[0])
+	if err != nil {
+		return proof, err
+	}
+	proof.Queries = make([]QueryProof, len(positions))
+	for q, pos := range positions {
+		for i := 0; i < l.nbRounds(); i++ {
+			r := pos % (l.lengths[i] / k)
+			if inputTrees[i] != nil {
+				proof.Queries[q].Inputs = append(proof.Queries[q].Inputs, InputOpening{
+					Values: inputLeaf(inputCodewords[i], r, k),
+					Path:   inputTrees[i].open(r),
+				})
+			}
+			if i > 0 {
+				proof.Queries[q].Layers = append(proof.Queries[q].Layers, LayerOpening{
+					Values: layerLeaf(layers[i], r, k),
+					Path:   layerTrees[i].open(r),
+				})
+			}
+			pos = r
+		}
+	}
+
+	return proof, nil
+}
+
+// Verify checks that proof shows that the polynomials committed in proof.InputRoots
+// are of degree less than sizes.
+func Verify(sizes []int, proof *Proof, cfg Config, h hash.Hash) error {
+	if err := cfg.check(); err != nil {
+		return err
+	}
+	if h.Size() < 32 {
+		return ErrHashSize
+	}
+	l, err := newLayout(sizes, cfg)
+	if err != nil {
+		return err
+	}
+	if err := l.checkShape(proof, cfg); err != nil {
+		return err
+	}
+	k := l.arity
+
+	// replay the transcript
+	fs := fiatshamir.NewTranscript(h, l.challengeNames()...)
+	if err := l.bindSetup(fs, cfg); err != nil {
+		return err
+	}
+	for _, root := range proof.InputRoots {
+		if err := fs.Bind("alpha", marshalElements(root[:]...)); err != nil {
+			return err
+		}
+	}
+	alpha, err := deriveE4(fs, "alpha")
+	if err != nil {
+		return err
+	}
+	alphaPowers := powersE4(alpha, 2*len(sizes))
+	betas := make([]extensions.E4, l.nbRounds())
+	for i := range betas {
+		betaName := fmt.Sprintf("beta_%d", i)
+		if i > 0 {
+			if err := fs.Bind(betaName, marshalElements(proof.LayerRoots[i-1][:]...)); err != nil {
+				return err
+			}
+		}
+		if betas[i], err = deriveE4(fs, betaName); err != nil {
+			return err
+		}
+	}
+	if err := fs.Bind("final", marshalE4(proof.FinalPolynomial)); err != nil {
+		return err
+	}
+	seed, err := fs.ComputeChallenge("final")
+	if err != nil {
+		return err
+	}
+	if !checkGrinding(h, seed, proof.Nonce, cfg.GrindingBits) {
+		return ErrGrinding
+	}
+	positions, err := deriveQueries(fs, h, proof.Nonce, cfg.NbQueries, l.lengths[0])
+	if err != nil {
+		return err
+	}
+
+	// generators of the domains, and of the subgroups of order k
+	generators := make([]fr.Element, len(l.lengths))
+	generatorsInv := make([]fr.Element, len(l.lengths))
+	omegas := make([]fr.Element, len(l.lengths))
+	omegasInv := make([]fr.Element, len(l.lengths))
+	for i := range generators {
+		if generators[i], err = fft.Generator(uint64(l.lengths[i])); err != nil {
+			return err
+		}
+		generatorsInv[i].Inverse(&generators[i])
+		exp := big.NewInt(int64(l.lengths[i] / k))
+		omegas[i].Exp(generators[i], exp)
+		omegasInv[i].Exp(generatorsInv[i], exp)
+	}
+	var kInv fr.Element
+	kInv.SetUint64(uint64(k)).Inverse(&kInv)
+
+	fiber := make([]extensions.E4, k)
+	for q, pos := range positions {
+		var folded extensions.E4
+		input := 0
+		for i := 0; i < l.nbRounds(); i++ {
+			nbLeaves := l.lengths[i] / k
+			r, t := pos%nbLeaves, pos/nbLeaves
+
+			// committed part of the codeword
+			if i == 0 {
+				for u := range fiber {
+					fiber[u].SetZero()
+				}
+			} else {
+				o := &proof.Queries[q].Layers[i-1]
+				if err := o.Path.verify(r, hashLeaf(flattenE4(o.Values)), proof.LayerRoots[i-1]); err != nil {
+					return fmt.Errorf("query %d, layer %d: %w", q, i, err)
+				}
+				if !o.Values[t].Equal(&folded) {
+					return fmt.Errorf("query %d, layer %d: %w", q, i, ErrLayerMismatch)
+				}
+				copy(fiber, o.Values)
+			}
+
+			// injected codewords
+			if round := l.rounds[i]; len(round) > 0 {
+				o := &proof.Queries[q].Inputs[input]
+				if err := o.Path.verify(r, hashLeaf(o.Values), proof.InputRoots[input]); err != nil {
+					return fmt.Errorf("query %d, input %d: %w", q, input, err)
+				}
+				input++
+				var x, xe fr.Element
+				x.Exp(generators[i], big.NewInt(int64(r)))
+				for u := range fiber {
+					for jj, j := range round {
+						xe.Exp(x, big.NewInt(int64(l.degrees[i]-sizes[j])))
+						accumulate(&fiber[u], &alphaPowers[2*j], &alphaPowers[2*j+1], &o.Values[u*len(round)+jj], &xe)
+					}
+					x.Mul(&x, &omegas[i])
+				}
+			}
+
+			var xInv fr.Element
+			xInv.Exp(generatorsInv[i], big.NewInt(int64(r)))
+			folded = foldFiber(fiber, &betas[i], xInv, omegasInv[i], kInv)
+			pos = r
+		}
+
+		var x fr.Element
+		x.Exp(generators[l.nbRounds()], big.NewInt(int64(pos)))
+		final := evalE4(proof.FinalPolynomial, &x)
+		if !final.Equal(&folded) {
+			return fmt.Errorf("query %d: %w", q, ErrLowDegree)
+		}
+	}
+
+	return nil
+}
+
+// checkShape returns an error if the dimensions of the proof do not match the layout
+func (l *layout) checkShape(proof *Proof, cfg Config) error {
+	nbInputs := l.nbInputs()
+	if len(proof.InputRoots) != nbInputs ||
+		len(proof.LayerRoots) != l.nbRounds()-1 ||
+		len(proof.FinalPolynomial) != l.degrees[l.nbRounds()] ||
+		len(proof.Queries) != cfg.NbQueries {
+		return ErrProofShape
+	}
+	for _, q := range proof.Queries {
+		if len(q.Inputs) != nbInputs || len(q.Layers) != l.nbRounds()-1 {
+			return ErrProofShape
+		}
+		input := 0
+		for i := 0; i < l.nbRounds(); i++ {
+			depth := log2(l.lengths[i] / l.arity)
+			if len(l.rounds[i]) > 0 {
+				o := q.Inputs[input]
+				if len(o.Values) != l.arity*len(l.rounds[i]) || len(o.Path) != depth {
+					return ErrProofShape
+				}
+				input++
+			}
+			if i > 0 {
+				o := q.Layers[i-1]
+				if len(o.Values) != l.arity || len(o.Path) != depth {
+					return ErrProofShape
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// evaluate returns the evaluations of p on the domain, in natural order
+func evaluate(p []fr.Element, domain *fft.Domain) []fr.Element {
+	res := make([]fr.Element, domain.Cardinality)
+	copy(res, p)
+	domain.FFT(res, fft.DIF)
+	fft.BitReverse(res)
+	return res
+}
+
+// interpolate returns the coefficients of the polynomial whose evaluations on the
+// domain, in natural order, are codeword.
+func interpolate(codeword []extensions.E4, domain *fft.Domain) []extensions.E4 {
+	coordinates := make([][]fr.Element, 4)
+	for c := range coordinates {
+		coordinates[c] = make([]fr.Element, len(codeword))
+	}
+	for i := range codeword {
+		coordinates[0][i] = codeword[i].B0.A0
+		coordinates[1][i] = codeword[i].B0.A1
+		coordinates[2][i] = codeword[i].B1.A0
+		coordinates[3][i] = codeword[i].B1.A1
+	}
+	for c := range coordinates {
+		domain.FFTInverse(coordinates[c], fft.DIF)
+		fft.BitReverse(coordinates[c])
+	}
+	res := make([]extensions.E4, len(codeword))
+	for i := range res {
+		res[i].B0.A0 = coordinates[0][i]
+		res[i].B0.A1 = coordinates[1][i]
+		res[i].B1.A0 = coordinates[2][i]
+		res[i].B1.A1 = coordinates[3][i]
+	}
+	return res
+}
+
+// evalE4 returns p(x)
+func evalE4(p []extensions.E4, x *fr.Element) extensions.E4 {
+	var res extensions.E4
+	for i := len(p) - 1; i >= 0; i-- {
+		res.MulByElement(&res, x)
+		res.Add(&res, &p[i])
+	}
+	return res
+}
+
+// inject adds (a + b·xᵉ)·c(x) to the codeword, for x ranging over the domain
+// generated by g.
+func inject(codeword []extensions.E4, c []fr.Element, a, b *extensions.E4, e int, g fr.Element) {
+	var ge fr.Element
+	ge.Exp(g, big.NewInt(int64(e)))
+	parallel.Execute(len(codeword), func(start, end int) {
+		var xe fr.Element
+		xe.Exp(ge, big.NewInt(int64(start)))
+		for r := start; r < end; r++ {
+			accumulate(&codeword[r], a, b, &c[r], &xe)
+			xe.Mul(&xe, &ge)
+		}
+	})
+}
+
+// accumulate sets acc += (a + b·xe)·c
+func accumulate(acc, a, b *extensions.E4, c, xe *fr.Element) {
+	var t extensions.E4
+	var cx fr.Element
+	t.MulByElement(a, c)
+	acc.Add(acc, &t)
+	cx.Mul(c, xe)
+	t.MulByElement(b, &cx)
+	acc.Add(acc, &t)
+}
+
+// fold returns the codeword folded with β: the evaluation at xᵏ of the folded
+// polynomial is computed from the evaluations on the fiber x·⟨ω⟩, where the
+// codeword is evaluated on the domain generated by g and ω is of order k.
+func fold(codeword []extensions.E4, beta *extensions.E4, k int, g fr.Element) []extensions.E4 {
+	n := len(codeword) / k
+	res := make([]extensions.E4, n)
+	var gInv, omegaInv, kInv fr.Element
+	gInv.Inverse(&g)
+	omegaInv.Exp(gInv, big.NewInt(int64(n)))
+	kInv.SetUint64(uint64(k)).Inverse(&kInv)
+	parallel.Execute(n, func(start, end int) {
+		var xInv fr.Element
+		xInv.Exp(gInv, big.NewInt(int64(start)))
+		for r := start; r < end; r++ {
+			res[r] = foldFiber(layerLeaf(codeword, r, k), beta, xInv, omegaInv, kInv)
+			xInv.Mul(&xInv, &gInv)
+		}
+	})
+	return res
+}
+
+// foldFiber returns Σᵣ cᵣ·(β·x⁻¹)ʳ where cᵣ = k⁻¹·Σₜ vₜ·ω⁻ᵗʳ. If P(X) = Σᵣ Xʳ·Pᵣ(Xᵏ) is
+// such that vₜ = P(x·ωᵗ), then cᵣ = xʳ·Pᵣ(xᵏ) and the result is Σᵣ βʳ·Pᵣ(xᵏ).
+func foldFiber(v []extensions.E4, beta *extensions.E4, xInv, omegaInv, kInv fr.Element) extensions.E4 {
+	var res, c, t, acc, betaXInv extensions.E4
+	var w, wr fr.Element
+	betaXInv.MulByElement(beta, &xInv)
+	acc.SetOne()
+	wr.SetOne()
+	for range v {
+		c.SetZero()
+		w.SetOne()
+		for i := range v {
+			t.MulByElement(&v[i], &w)
+			c.Add(&c, &t)
+			w.Mul(&w, &wr)
+		}
+		c.MulByElement(&c, &kInv)
+		t.Mul(&c, &acc)
+		res.Add(&res, &t)
+		acc.Mul(&acc, &betaXInv)
+		wr.Mul(&wr, &omegaInv)
+	}
+	return res
+}
+
+// inputLeaf returns the values of the r-th leaf of the commitment to the codewords,
+// i.e. the evaluations of the codewords on the fiber of r.
+func inputLeaf(codewords [][]fr.Element, r, k int) []fr.Element {
+	n := len(codewords[0]) / k
+	res := make([]fr.Element, 0, k*len(codewords))
+	for t := 0; t < k; t++ {
+		for j := range codewords {
+			res = append(res, codewords[j][r+t*n])
+		}
+	}
+	return res
+}
+
+// layerLeaf returns the values of the r-th leaf of the commitment to the codeword,
+// i.e. its evaluations on the fiber of r.
+func layerLeaf(codeword []extensions.E4, r, k int) []extensions.E4 {
+	n := len(codeword) / k
+	res := make([]extensions.E4, k)
+	for t := range res {
+		res[t] = codeword[r+t*n]
+	}
+	return res
+}
+
+// deriveE4 computes the challenge name and maps it to 𝔽r⁴, each coordinate being
+// a 64 bits chunk of the challenge reduced modulo r.
+func deriveE4(fs *fiatshamir.Transcript, name string) (extensions.E4, error) {
+	var res extensions.E4
+	b, err := fs.ComputeChallenge(name)
+	if err != nil {
+		return res, err
+	}
+	res.B0.A0.SetUint64(binary.BigEndian.Uint64(b[0:8]))
+	res.B0.A1.SetUint64(binary.BigEndian.Uint64(b[8:16]))
+	res.B1.A0.SetUint64(binary.BigEndian.Uint64(b[16:24]))
+	res.B1.A1.SetUint64(binary.BigEndian.Uint64(b[24:32]))
+	return res, nil
+}
+
+// deriveQueries binds the nonce, and derives nbQueries positions in [0, length)
+// from the challenge queries: the i-th position is H(queries ∥ i) mod length.
+func deriveQueries(fs *fiatshamir.Transcript, h hash.Hash, nonce uint64, nbQueries, length int) ([]int, error) {
+	var bNonce [8]byte
+	binary.BigEndian.PutUint64(bNonce[:], nonce)
+	if err := fs.Bind("queries", bNonce[:]); err != nil {
+		return nil, err
+	}
+	seed, err := fs.ComputeChallenge("queries")
+	if err != nil {
+		return nil, err
+	}
+	res := make([]int, nbQueries)
+	var bIndex [4]byte
+	for i := range res {
+		binary.BigEndian.PutUint32(bIndex[:], uint32(i))
+		h.Reset()
+		h.Write(seed)
+		h.Write(bIndex[:])
+		digest := h.Sum(nil)
+		res[i] = int(binary.BigEndian.Uint64(digest[:8]) % uint64(length))
+	}
+	h.Reset()
+	return res, nil
+}
+
+// powersE4 returns [1, x, .., xⁿ⁻¹]
+func powersE4(x extensions.E4, n int) []extensions.E4 {
+	res := make([]extensions.E4, n)
+	res[0].SetOne()
+	for i := 1; i < n; i++ {
+		res[i].Mul(&res[i-1], &x)
+	}
+	return res
+}
+
+// flattenE4 returns the coordinates of the elements of v
+func flattenE4(v []extensions.E4) []fr.Element {
+	res := make([]fr.Element, 0, 4*len(v))
+	for i := range v {
+		res = append(res, v[i].B0.A0, v[i].B0.A1, v[i].B1.A0, v[i].B1.A1)
+	}
+	return res
+}
+
+// marshalE4 returns the big endian encoding of the coordinates of the elements of v
+func marshalE4(v []extensions.E4) []byte {
+	return marshalElements(flattenE4(v)...)
+}
+
+// marshalElements returns the big endian encoding of the elements of v
+func marshalElements(v ...fr.Element) []byte {
+	res := make([]byte, 0, len(v)*fr.Bytes)
+	for i := range v {
+		b := v[i].Bytes()
+		res = append(res, b[:]...)
+	}
+	return res
+}
+
+// log2 returns log₂(n) for n a power of two
+func log2(n int) int {
+	res := 0
+	for (1 << res) < n {
+		res++
+	}
+	return res
+}
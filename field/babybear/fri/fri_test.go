@@ -0,0 +1,175 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	fr "github.com/consensys/gnark-crypto/field/babybear"
+	"github.com/stretchr/testify/require"
+)
+
+// randomPolynomials returns random polynomials of the given sizes
+func randomPolynomials(sizes ...int) [][]fr.Element {
+	res := make([][]fr.Element, len(sizes))
+	for i, s := range sizes {
+		res[i] = make([]fr.Element, s)
+		for j := range res[i] {
+			res[i][j].MustSetRandom()
+		}
+	}
+	return res
+}
+
+func TestFRI(t *testing.T) {
+	sizes := []int{64, 37, 16, 5, 1, 64, 9}
+	polynomials := randomPolynomials(sizes...)
+
+	for _, blowup := range []int{2, 4, 8, 16} {
+		for _, arity := range []int{2, 4, 8, 16} {
+			t.Run(fmt.Sprintf("blowup=%d/arity=%d", blowup, arity), func(t *testing.T) {
+				assert := require.New(t)
+				cfg := Config{BlowupFactor: blowup, Arity: arity, NbQueries: 8, GrindingBits: 4}
+
+				proof, err := Prove(polynomials, cfg, sha256.New())
+				assert.NoError(err)
+				assert.NoError(Verify(sizes, &proof, cfg, sha256.New()))
+			})
+		}
+	}
+}
+
+func TestFRISinglePolynomial(t *testing.T) {
+	assert := require.New(t)
+	cfg, err := NewConfig(4, 2, 40, 8)
+	assert.NoError(err)
+
+	for _, size := range []int{1, 2, 3, 100} {
+		polynomials := randomPolynomials(size)
+		proof, err := Prove(polynomials, cfg, sha256.New())
+		assert.NoError(err)
+		assert.NoError(Verify([]int{size}, &proof, cfg, sha256.New()))
+	}
+}
+
+func TestFRITampered(t *testing.T) {
+	sizes := []int{64, 20, 3}
+	polynomials := randomPolynomials(sizes...)
+	cfg := Config{BlowupFactor: 4, Arity: 4, NbQueries: 4}
+
+	tamper := map[string]func(proof *Proof){
+		"input root": func(proof *Proof) {
+			proof.InputRoots[0][0].SetOne()
+		},
+		"layer root": func(proof *Proof) {
+			proof.LayerRoots[0][0].SetOne()
+		},
+		"final polynomial": func(proof *Proof) {
+			proof.FinalPolynomial[0].B0.A0.SetOne()
+		},
+		"input value": func(proof *Proof) {
+			proof.Queries[1].Inputs[0].Values[2].SetOne()
+		},
+		"layer value": func(proof *Proof) {
+			proof.Queries[2].Layers[0].Values[1].B1.A1.SetOne()
+		},
+		"missing query": func(proof *Proof) {
+			proof.Queries = proof.Queries[1:]
+		},
+	}
+	for name, f := range tamper {
+		t.Run(name, func(t *testing.T) {
+			assert := require.New(t)
+			proof, err := Prove(polynomials, cfg, sha256.New())
+			assert.NoError(err)
+			assert.NoError(Verify(sizes, &proof, cfg, sha256.New()))
+			f(&proof)
+			assert.Error(Verify(sizes, &proof, cfg, sha256.New()))
+		})
+	}
+
+	t.Run("sizes", func(t *testing.T) {
+		assert := require.New(t)
+		proof, err := Prove(polynomials, cfg, sha256.New())
+		assert.NoError(err)
+		assert.Error(Verify([]int{64, 21, 3}, &proof, cfg, sha256.New()))
+	})
+
+	t.Run("grinding", func(t *testing.T) {
+		assert := require.New(t)
+		cfg := cfg
+		cfg.GrindingBits = 12
+		proof, err := Prove(polynomials, cfg, sha256.New())
+		assert.NoError(err)
+		assert.NoError(Verify(sizes, &proof, cfg, sha256.New()))
+		proof.Nonce++
+		assert.Error(Verify(sizes, &proof, cfg, sha256.New()))
+	})
+}
+
+func TestFRIDegreeTooLarge(t *testing.T) {
+	cfg := Config{BlowupFactor: 2, Arity: 2, NbQueries: 16}
+
+	for _, tc := range []struct {
+		actual, declared []int
+	}{
+		{[]int{64, 40, 3}, []int{64, 20, 3}},
+		{[]int{64, 20, 3}, []int{32, 20, 3}},
+		{[]int{64, 20, 4}, []int{64, 20, 3}},
+	} {
+		assert := require.New(t)
+		polynomials := randomPolynomials(tc.actual...)
+		proof, err := prove(polynomials, tc.declared, cfg, sha256.New())
+		assert.NoError(err)
+		// the layers are honestly folded, the excess degree shows in the final polynomial
+		assert.ErrorIs(Verify(tc.declared, &proof, cfg, sha256.New()), ErrLowDegree)
+	}
+}
+
+func TestNewConfig(t *testing.T) {
+	assert := require.New(t)
+
+	cfg, err := NewConfig(16, 4, 100, 20)
+	assert.NoError(err)
+	assert.Equal(Config{BlowupFactor: 16, Arity: 4, NbQueries: 20, GrindingBits: 20}, cfg)
+
+	_, err = NewConfig(3, 2, 100, 0)
+	assert.ErrorIs(err, ErrInvalidConfig)
+	_, err = NewConfig(2, 32, 100, 0)
+	assert.ErrorIs(err, ErrInvalidConfig)
+	_, err = NewConfig(2, 2, 20, 20)
+	assert.ErrorIs(err, ErrInvalidConfig)
+
+	polynomials := randomPolynomials(4)
+	_, err = Prove(polynomials, Config{BlowupFactor: 2, Arity: 2}, sha256.New())
+	assert.ErrorIs(err, ErrInvalidConfig)
+	_, err = Prove(nil, Config{BlowupFactor: 2, Arity: 2, NbQueries: 1}, sha256.New())
+	assert.ErrorIs(err, ErrNoPolynomial)
+}
+
+func TestMerkleTree(t *testing.T) {
+	assert := require.New(t)
+
+	leaves := make([]Hash, 16)
+	for i := range leaves {
+		values := make([]fr.Element, 3*i+1)
+		for j := range values {
+			values[j].MustSetRandom()
+		}
+		leaves[i] = hashLeaf(values)
+	}
+	tree := newMerkleTree(leaves)
+	root := tree.root()
+	for i := range leaves {
+		path := tree.open(i)
+		assert.Len(path, 4)
+		assert.NoError(path.verify(i, leaves[i], root))
+		assert.ErrorIs(path.verify(i^1, leaves[i], root), ErrMerklePath)
+		assert.ErrorIs(path.verify(i, leaves[i^1], root), ErrMerklePath)
+	}
+}
@@ -0,0 +1,229 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+// Level k of the tower is implemented on top of level k-1, writing
+// a = a₀ + a₁·Xₖ and using Xₖ² = Xₖ₋₁·Xₖ + 1:
+//
+//	a·b = (a₀b₀ + a₁b₁) + (a₀b₁ + a₁b₀ + a₁b₁·Xₖ₋₁)·Xₖ
+//	a·Xₖ = a₁ + (a₀ + a₁·Xₖ₋₁)·Xₖ
+//	a² = (a₀² + a₁²) + a₁²·Xₖ₋₁·Xₖ
+//	a⁻¹ = (a₀ + a₁·Xₖ₋₁ + a₁·Xₖ) / (a₀·(a₀ + a₁·Xₖ₋₁) + a₁²)
+//
+// The middle term of the product is computed with Karatsuba.
+// T₃ = 𝔽₂⁸ uses precomputed tables.
+
+var (
+	mul8Table  [256][256]uint8
+	inv8Table  [256]uint8
+	sq8Table   [256]uint8
+	mulX8Table [256]uint8
+)
+
+func init() {
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			mul8Table[a][b] = mul8Slow(uint8(a), uint8(b))
+		}
+		sq8Table[a] = mul8Table[a][a]
+		mulX8Table[a] = mulX8Slow(uint8(a))
+	}
+	for a := 1; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			if mul8Table[a][b] == 1 {
+				inv8Table[a] = uint8(b)
+				break
+			}
+		}
+	}
+}
+
+// T₀
+
+func mul1(a, b uint8) uint8  { return a & b }
+func square1(a uint8) uint8  { return a }
+func inverse1(a uint8) uint8 { return a }
+
+// T₁, X₀ = 1
+
+func mul2(a, b uint8) uint8 {
+	a0, a1 := a&1, a>>1
+	b0, b1 := b&1, b>>1
+	z0 := a0 & b0
+	z2 := a1 & b1
+	z1 := ((a0 ^ a1) & (b0 ^ b1)) ^ z0 ^ z2
+	return (z0 ^ z2) | (z1^z2)<<1
+}
+
+func mulX2(a uint8) uint8 {
+	a0, a1 := a&1, a>>1
+	return a1 | (a0^a1)<<1
+}
+
+func square2(a uint8) uint8 {
+	a0, a1 := a&1, a>>1
+	return (a0 ^ a1) | a1<<1
+}
+
+func inverse2(a uint8) uint8 {
+	// the non zero elements of T₁ form a group of order 3
+	return square2(a)
+}
+
+// T₂
+
+func mul4(a, b uint8) uint8 {
+	a0, a1 := a&3, a>>2
+	b0, b1 := b&3, b>>2
+	z0 := mul2(a0, b0)
+	z2 := mul2(a1, b1)
+	z1 := mul2(a0^a1, b0^b1) ^ z0 ^ z2
+	return (z0 ^ z2) | (z1^mulX2(z2))<<2
+}
+
+func mulX4(a uint8) uint8 {
+	a0, a1 := a&3, a>>2
+	return a1 | (a0^mulX2(a1))<<2
+}
+
+func square4(a uint8) uint8 {
+	a0, a1 := square2(a&3), square2(a>>2)
+	return (a0 ^ a1) | mulX2(a1)<<2
+}
+
+func inverse4(a uint8) uint8 {
+	a0, a1 := a&3, a>>2
+	t := a0 ^ mulX2(a1)
+	d := inverse2(mul2(a0, t) ^ square2(a1))
+	return mul2(t, d) | mul2(a1, d)<<2
+}
+
+// T₃
+
+func mul8Slow(a, b uint8) uint8 {
+	a0, a1 := a&15, a>>4
+	b0, b1 := b&15, b>>4
+	z0 := mul4(a0, b0)
+	z2 := mul4(a1, b1)
+	z1 := mul4(a0^a1, b0^b1) ^ z0 ^ z2
+	return (z0 ^ z2) | (z1^mulX4(z2))<<4
+}
+
+func mulX8Slow(a uint8) uint8 {
+	a0, a1 := a&15, a>>4
+	return a1 | (a0^mulX4(a1))<<4
+}
+
+func mul8(a, b uint8) uint8  { return mul8Table[a][b] }
+func mulX8(a uint8) uint8    { return mulX8Table[a] }
+func square8(a uint8) uint8  { return sq8Table[a] }
+func inverse8(a uint8) uint8 { return inv8Table[a] }
+
+// T₄
+
+func mul16(a, b uint16) uint16 {
+	a0, a1 := uint8(a), uint8(a>>8)
+	b0, b1 := uint8(b), uint8(b>>8)
+	z0 := mul8(a0, b0)
+	z2 := mul8(a1, b1)
+	z1 := mul8(a0^a1, b0^b1) ^ z0 ^ z2
+	return uint16(z0^z2) | uint16(z1^mulX8(z2))<<8
+}
+
+func mulX16(a uint16) uint16 {
+	a0, a1 := uint8(a), uint8(a>>8)
+	return uint16(a1) | uint16(a0^mulX8(a1))<<8
+}
+
+func square16(a uint16) uint16 {
+	a0, a1 := square8(uint8(a)), square8(uint8(a>>8))
+	return uint16(a0^a1) | uint16(mulX8(a1))<<8
+}
+
+func inverse16(a uint16) uint16 {
+	a0, a1 := uint8(a), uint8(a>>8)
+	t := a0 ^ mulX8(a1)
+	d := inverse8(mul8(a0, t) ^ square8(a1))
+	return uint16(mul8(t, d)) | uint16(mul8(a1, d))<<8
+}
+
+// T₅
+
+func mul32(a, b uint32) uint32 {
+	a0, a1 := uint16(a), uint16(a>>16)
+	b0, b1 := uint16(b), uint16(b>>16)
+	z0 := mul16(a0, b0)
+	z2 := mul16(a1, b1)
+	z1 := mul16(a0^a1, b0^b1) ^ z0 ^ z2
+	return uint32(z0^z2) | uint32(z1^mulX16(z2))<<16
+}
+
+func mulX32(a uint32) uint32 {
+	a0, a1 := uint16(a), uint16(a>>16)
+	return uint32(a1) | uint32(a0^mulX16(a1))<<16
+}
+
+func square32(a uint32) uint32 {
+	a0, a1 := square16(uint16(a)), square16(uint16(a>>16))
+	return uint32(a0^a1) | uint32(mulX16(a1))<<16
+}
+
+func inverse32(a uint32) uint32 {
+	a0, a1 := uint16(a), uint16(a>>16)
+	t := a0 ^ mulX16(a1)
+	d := inverse16(mul16(a0, t) ^ square16(a1))
+	return uint32(mul16(t, d)) | uint32(mul16(a1, d))<<16
+}
+
+// T₆
+
+func mul64(a, b uint64) uint64 {
+	a0, a1 := uint32(a), uint32(a>>32)
+	b0, b1 := uint32(b), uint32(b>>32)
+	z0 := mul32(a0, b0)
+	z2 := mul32(a1, b1)
+	z1 := mul32(a0^a1, b0^b1) ^ z0 ^ z2
+	return uint64(z0^z2) | uint64(z1^mulX32(z2))<<32
+}
+
+func mulX64(a uint64) uint64 {
+	a0, a1 := uint32(a), uint32(a>>32)
+	return uint64(a1) | uint64(a0^mulX32(a1))<<32
+}
+
+func square64(a uint64) uint64 {
+	a0, a1 := square32(uint32(a)), square32(uint32(a>>32))
+	return uint64(a0^a1) | uint64(mulX32(a1))<<32
+}
+
+func inverse64(a uint64) uint64 {
+	a0, a1 := uint32(a), uint32(a>>32)
+	t := a0 ^ mulX32(a1)
+	d := inverse32(mul32(a0, t) ^ square32(a1))
+	return uint64(mul32(t, d)) | uint64(mul32(a1, d))<<32
+}
+
+// T₇
+
+func mul128(a, b B128) B128 {
+	z0 := mul64(a.Lo, b.Lo)
+	z2 := mul64(a.Hi, b.Hi)
+	z1 := mul64(a.Lo^a.Hi, b.Lo^b.Hi) ^ z0 ^ z2
+	return B128{Lo: z0 ^ z2, Hi: z1 ^ mulX64(z2)}
+}
+
+func mulX128(a B128) B128 {
+	return B128{Lo: a.Hi, Hi: a.Lo ^ mulX64(a.Hi)}
+}
+
+func square128(a B128) B128 {
+	a0, a1 := square64(a.Lo), square64(a.Hi)
+	return B128{Lo: a0 ^ a1, Hi: mulX64(a1)}
+}
+
+func inverse128(a B128) B128 {
+	t := a.Lo ^ mulX64(a.Hi)
+	d := inverse64(mul64(a.Lo, t) ^ square64(a.Hi))
+	return B128{Lo: mul64(t, d), Hi: mul64(a.Hi, d)}
+}
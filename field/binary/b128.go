@@ -0,0 +1,151 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// B128 is an element of T₇ = 𝔽₂¹²⁸, a₀ + a₁·X₇ with a₀ = Lo and a₁ = Hi in T₆
+type B128 struct {
+	Lo, Hi uint64
+}
+
+// Bytes128 is the size in bytes of a B128 element
+const Bytes128 = 16
+
+// SetZero sets z to 0 and returns z
+func (z *B128) SetZero() *B128 {
+	*z = B128{}
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *B128) SetOne() *B128 {
+	*z = B128{Lo: 1}
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *B128) Set(x *B128) *B128 {
+	*z = *x
+	return z
+}
+
+// SetUint64 sets z to v, an element of T₆ = 𝔽₂⁶⁴, and returns z
+func (z *B128) SetUint64(v uint64) *B128 {
+	*z = B128{Lo: v}
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *B128) IsZero() bool {
+	return (z.Lo | z.Hi) == 0
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *B128) IsOne() bool {
+	return z.Lo == 1 && z.Hi == 0
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *B128) Equal(x *B128) bool {
+	return z.Lo == x.Lo && z.Hi == x.Hi
+}
+
+// Add sets z = x + y (XOR) and returns z
+func (z *B128) Add(x, y *B128) *B128 {
+	z.Lo = x.Lo ^ y.Lo
+	z.Hi = x.Hi ^ y.Hi
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *B128) Mul(x, y *B128) *B128 {
+	*z = mul128(*x, *y)
+	return z
+}
+
+// MulByB64 sets z = x·y where y is in the subfield T₆, and returns z
+func (z *B128) MulByB64(x *B128, y uint64) *B128 {
+	z.Lo = mul64(x.Lo, y)
+	z.Hi = mul64(x.Hi, y)
+	return z
+}
+
+// MulByGenerator sets z = x·X₇ and returns z, where X₇ generates T₇ over T₆
+func (z *B128) MulByGenerator(x *B128) *B128 {
+	*z = mulX128(*x)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *B128) Square(x *B128) *B128 {
+	*z = square128(*x)
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; the inverse of 0 is 0
+func (z *B128) Inverse(x *B128) *B128 {
+	*z = inverse128(*x)
+	return z
+}
+
+// Exp sets z = xᵏ and returns z
+func (z *B128) Exp(x B128, k uint64) *B128 {
+	z.SetOne()
+	for k != 0 {
+		if k&1 == 1 {
+			z.Mul(z, &x)
+		}
+		x.Square(&x)
+		k >>= 1
+	}
+	return z
+}
+
+// SetRandom sets z to a uniformly random element
+func (z *B128) SetRandom() (*B128, error) {
+	var b [Bytes128]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	z.Lo = binary.LittleEndian.Uint64(b[:8])
+	z.Hi = binary.LittleEndian.Uint64(b[8:])
+	return z, nil
+}
+
+// MustSetRandom sets z to a uniformly random element.
+// It panics if reading from crypto/rand fails.
+func (z *B128) MustSetRandom() *B128 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// Bytes returns the little-endian encoding of z, Lo first
+func (z *B128) Bytes() (res [Bytes128]byte) {
+	binary.LittleEndian.PutUint64(res[:8], z.Lo)
+	binary.LittleEndian.PutUint64(res[8:], z.Hi)
+	return
+}
+
+// SetBytes sets z from its little-endian encoding
+func (z *B128) SetBytes(e []byte) error {
+	if len(e) != Bytes128 {
+		return errors.New("invalid buffer size")
+	}
+	z.Lo = binary.LittleEndian.Uint64(e[:8])
+	z.Hi = binary.LittleEndian.Uint64(e[8:])
+	return nil
+}
+
+// String returns the hexadecimal representation of z
+func (z B128) String() string {
+	return fmt.Sprintf("0x%016x%016x", z.Hi, z.Lo)
+}
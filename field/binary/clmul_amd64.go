@@ -0,0 +1,42 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+//go:build !purego
+
+package binary
+
+import "github.com/consensys/gnark-crypto/utils/cpu"
+
+// mulVecPoly128 sets res[i] = a[i]·b[i] for i < n, using PCLMULQDQ
+//
+//go:noescape
+func mulVecPoly128(res, a, b *Poly128, n uint64)
+
+// scalarMulVecPoly128 sets res[i] = a[i]·b for i < n, using PCLMULQDQ
+//
+//go:noescape
+func scalarMulVecPoly128(res, a, b *Poly128, n uint64)
+
+func mulPoly128(z, x, y *Poly128) {
+	if cpu.SupportPCLMULQDQ {
+		mulVecPoly128(z, x, y, 1)
+		return
+	}
+	*z = mulPoly128Generic(x, y)
+}
+
+func mulVec(res, a, b []Poly128) {
+	if cpu.SupportPCLMULQDQ && len(res) != 0 {
+		mulVecPoly128(&res[0], &a[0], &b[0], uint64(len(res)))
+		return
+	}
+	mulVecGeneric(res, a, b)
+}
+
+func scalarMulVec(res, a []Poly128, b *Poly128) {
+	if cpu.SupportPCLMULQDQ && len(res) != 0 {
+		scalarMulVecPoly128(&res[0], &a[0], b, uint64(len(res)))
+		return
+	}
+	scalarMulVecGeneric(res, a, b)
+}
@@ -0,0 +1,85 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+//go:build !purego
+
+#include "textflag.h"
+
+// MUL_POLY128 sets X2 = X0·X1 in 𝔽₂¹²⁸ modulo x¹²⁸ + x⁷ + x² + x + 1.
+// X7 must hold the reduction constant 0x87 in its low quadword.
+// X0, X3, X4 and X5 are clobbered.
+//
+// The 256-bit product [p₀, p₁, p₂, p₃] is computed with 4 carry-less multiplications,
+// then p₃ and p₂ are folded using x¹²⁸ = 0x87.
+#define MUL_POLY128 \
+	MOVOU     X0, X2        \
+	PCLMULQDQ $0x00, X1, X2 \
+	MOVOU     X0, X3        \
+	PCLMULQDQ $0x11, X1, X3 \
+	MOVOU     X0, X4        \
+	PCLMULQDQ $0x01, X1, X4 \
+	PCLMULQDQ $0x10, X1, X0 \
+	PXOR      X4, X0        \
+	MOVOU     X0, X4        \
+	PSLLDQ    $8, X4        \
+	PSRLDQ    $8, X0        \
+	PXOR      X4, X2        \
+	PXOR      X0, X3        \
+	MOVOU     X3, X4        \
+	PCLMULQDQ $0x01, X7, X4 \
+	MOVOU     X4, X5        \
+	PSLLDQ    $8, X5        \
+	PSRLDQ    $8, X4        \
+	PXOR      X5, X2        \
+	PXOR      X4, X3        \
+	PCLMULQDQ $0x00, X7, X3 \
+	PXOR      X3, X2
+
+// func mulVecPoly128(res, a, b *Poly128, n uint64)
+TEXT ·mulVecPoly128(SB), NOSPLIT, $0-32
+	MOVQ res+0(FP), DI
+	MOVQ a+8(FP), SI
+	MOVQ b+16(FP), DX
+	MOVQ n+24(FP), CX
+	MOVQ $0x87, AX
+	MOVQ AX, X7
+
+loop:
+	TESTQ CX, CX
+	JEQ   done
+	MOVOU (SI), X0
+	MOVOU (DX), X1
+	MUL_POLY128
+	MOVOU X2, (DI)
+	ADDQ  $16, SI
+	ADDQ  $16, DX
+	ADDQ  $16, DI
+	DECQ  CX
+	JMP   loop
+
+done:
+	RET
+
+// func scalarMulVecPoly128(res, a, b *Poly128, n uint64)
+TEXT ·scalarMulVecPoly128(SB), NOSPLIT, $0-32
+	MOVQ  res+0(FP), DI
+	MOVQ  a+8(FP), SI
+	MOVQ  b+16(FP), DX
+	MOVQ  n+24(FP), CX
+	MOVQ  $0x87, AX
+	MOVQ  AX, X7
+	MOVOU (DX), X1
+
+scalarLoop:
+	TESTQ CX, CX
+	JEQ   scalarDone
+	MOVOU (SI), X0
+	MUL_POLY128
+	MOVOU X2, (DI)
+	ADDQ  $16, SI
+	ADDQ  $16, DI
+	DECQ  CX
+	JMP   scalarLoop
+
+scalarDone:
+	RET
@@ -0,0 +1,18 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+//go:build purego || !amd64
+
+package binary
+
+func mulPoly128(z, x, y *Poly128) {
+	*z = mulPoly128Generic(x, y)
+}
+
+func mulVec(res, a, b []Poly128) {
+	mulVecGeneric(res, a, b)
+}
+
+func scalarMulVec(res, a []Poly128, b *Poly128) {
+	scalarMulVecGeneric(res, a, b)
+}
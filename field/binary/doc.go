@@ -0,0 +1,30 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package binary implements the Wiedemann tower of binary fields
+//
+//	𝔽₂ ⊂ 𝔽₂² ⊂ 𝔽₂⁴ ⊂ 𝔽₂⁸ ⊂ 𝔽₂¹⁶ ⊂ 𝔽₂³² ⊂ 𝔽₂⁶⁴ ⊂ 𝔽₂¹²⁸
+//
+// as used by Binius. The tower is defined by
+//
+//	T₀ = 𝔽₂
+//	Tₖ = Tₖ₋₁[Xₖ] / (Xₖ² + Xₖ₋₁·Xₖ + 1), with X₀ = 1
+//
+// and an element a₀ + a₁·Xₖ of Tₖ is stored as the 2ᵏ-bit integer whose low half is a₀
+// and high half is a₁. Hence bit i of an element is the coefficient of the monomial
+// ∏ Xⱼ₊₁ for the bits j set in i, and the elements of Tⱼ are exactly the elements of
+// Tₖ (k ≥ j) smaller than 2^(2ʲ).
+//
+// Addition is XOR at every level. Multiplication uses the tower structure
+// (Karatsuba over the subfield, with a table for T₃ = 𝔽₂⁸).
+//
+// The package also provides Poly128, the field 𝔽₂¹²⁸ in the polynomial basis
+// modulo x¹²⁸ + x⁷ + x² + x + 1, whose multiplication uses the carry-less multiply
+// instruction (PCLMULQDQ) on amd64, and an explicit isomorphism between B128 and
+// Poly128 used to accelerate the vector operations.
+//
+// Finally, NTT implements the additive NTT of [LCH14] over B128, on the subspaces
+// spanned by the canonical tower basis, in the novel polynomial basis.
+//
+// [LCH14]: https://arxiv.org/abs/1404.3458
+package binary
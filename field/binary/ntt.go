@@ -0,0 +1,251 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"math/bits"
+	"sync"
+
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// NTT is the additive NTT of [LCH14] over B128.
+//
+// The evaluation domain of size 2ˡ with coset c is the affine subspace
+//
+//	{ ωₓ : x = c·2ˡ + y, 0 ≤ y < 2ˡ }
+//
+// where ωₓ = Σ xᵢ·βᵢ and βᵢ = 2ⁱ is the canonical basis of T₆ (that is, ωₓ is the
+// element of T₆ whose bit representation is x).
+//
+// The butterflies are computed in the polynomial basis (see Poly128), so that
+// the multiplications use the carry-less multiplication when available.
+//
+// Polynomials are given by their coefficients in the novel polynomial basis
+//
+//	Xⱼ(X) = ∏ Ŵᵢ(X)^jᵢ
+//
+// where jᵢ is the i-th bit of j, Ŵᵢ = Wᵢ / Wᵢ(βᵢ) and Wᵢ is the subspace vanishing
+// polynomial of span(β₀, …, βᵢ₋₁). Evaluations are in natural order: the evaluation
+// at ω_(c·2ˡ+y) is at index y.
+//
+// [LCH14]: https://arxiv.org/abs/1404.3458
+type NTT struct {
+	logSize int
+
+	// twiddles[i][j] = Ŵᵢ(ω_(j·2ⁱ⁺¹)), in the polynomial basis
+	twiddles [][]Poly128
+}
+
+// maxLogSize is the largest supported domain: the domain and its cosets live in T₆
+const maxLogSize = 63
+
+var (
+	subspaceOnce sync.Once
+	// subspaceEvals[i][m] = Ŵᵢ(βₘ) for i ≤ m < 64, in T₆
+	subspaceEvals [64][64]uint64
+)
+
+func initSubspaceEvals() {
+	// Ŵ₀(X) = X / β₀ = X
+	// Ŵᵢ₊₁(X) = Ŵᵢ(X)·(Ŵᵢ(X) + 1) / (Ŵᵢ(βᵢ₊₁)·(Ŵᵢ(βᵢ₊₁) + 1))
+	for m := 0; m < 64; m++ {
+		subspaceEvals[0][m] = 1 << m
+	}
+	for i := 0; i < 63; i++ {
+		w := subspaceEvals[i][i+1]
+		norm := inverse64(mul64(w, w^1))
+		for m := i + 1; m < 64; m++ {
+			w := subspaceEvals[i][m]
+			subspaceEvals[i+1][m] = mul64(mul64(w, w^1), norm)
+		}
+	}
+}
+
+// subspaceEval returns Ŵᵢ(ωₓ), x must be a multiple of 2ⁱ
+func subspaceEval(i int, x uint64) uint64 {
+	var r uint64
+	for x != 0 {
+		m := bits.TrailingZeros64(x)
+		r ^= subspaceEvals[i][m]
+		x &= x - 1
+	}
+	return r
+}
+
+// NewNTT returns an NTT for domains of size 2^logSize.
+// It panics if logSize is larger than 63.
+func NewNTT(logSize int) *NTT {
+	if logSize < 0 || logSize > maxLogSize {
+		panic("binary: unsupported NTT size")
+	}
+	subspaceOnce.Do(initSubspaceEvals)
+	isoOnce.Do(initIsomorphism)
+
+	ntt := &NTT{
+		logSize:  logSize,
+		twiddles: make([][]Poly128, logSize),
+	}
+	for i := range ntt.twiddles {
+		t := make([]Poly128, 1<<(logSize-i-1))
+		// Ŵᵢ and the change of basis are linear:
+		// t[j] = t[j without its lowest bit] + Ŵᵢ(β of the lowest bit of j)
+		for j := 1; j < len(t); j++ {
+			m := bits.TrailingZeros(uint(j))
+			w := toPoly(&B128{Lo: subspaceEvals[i][i+1+m]})
+			t[j].Add(&t[j&(j-1)], &w)
+		}
+		ntt.twiddles[i] = t
+	}
+	return ntt
+}
+
+// Size returns the size of the domain
+func (ntt *NTT) Size() int {
+	return 1 << ntt.logSize
+}
+
+// LogSize returns the base 2 logarithm of the size of the domain
+func (ntt *NTT) LogSize() int {
+	return ntt.logSize
+}
+
+// Forward evaluates on the coset the polynomial whose coefficients in the novel basis are data.
+// data is overwritten with the evaluations.
+// It panics if len(data) is not the size of the domain, or if the coset is out of range.
+func (ntt *NTT) Forward(data []B128, coset uint64) {
+	ntt.checkInputs(data, coset)
+	toPolyInPlace(data)
+	for i := ntt.logSize - 1; i >= 0; i-- {
+		ntt.layer(data, i, ntt.cosetTwiddle(i, coset), butterfly)
+	}
+	fromPolyInPlace(data)
+}
+
+// Inverse interpolates the evaluations data on the coset.
+// data is overwritten with the coefficients of the polynomial in the novel basis.
+// It panics if len(data) is not the size of the domain, or if the coset is out of range.
+func (ntt *NTT) Inverse(data []B128, coset uint64) {
+	ntt.checkInputs(data, coset)
+	toPolyInPlace(data)
+	for i := 0; i < ntt.logSize; i++ {
+		ntt.layer(data, i, ntt.cosetTwiddle(i, coset), butterflyInverse)
+	}
+	fromPolyInPlace(data)
+}
+
+func (ntt *NTT) checkInputs(data []B128, coset uint64) {
+	if len(data) != ntt.Size() {
+		panic("len(data) must be the size of the domain")
+	}
+	if ntt.logSize != 0 && coset>>(64-ntt.logSize) != 0 {
+		panic("coset is out of range")
+	}
+}
+
+// cosetTwiddle returns Ŵᵢ(ω_(coset·2ˡ)) in the polynomial basis,
+// to be added to the twiddles of the i-th layer
+func (ntt *NTT) cosetTwiddle(i int, coset uint64) Poly128 {
+	if coset == 0 {
+		return Poly128{}
+	}
+	return toPoly(&B128{Lo: subspaceEval(i, coset<<ntt.logSize)})
+}
+
+// toPolyInPlace maps the entries of data to the polynomial basis;
+// the results are stored as B128 and accessed as (*Poly128) by the butterflies.
+func toPolyInPlace(data []B128) {
+	parallel.Execute(len(data), func(start, end int) {
+		for i := start; i < end; i++ {
+			data[i] = B128(toPoly(&data[i]))
+		}
+	}, nbTasks(len(data)))
+}
+
+// fromPolyInPlace is the inverse of toPolyInPlace
+func fromPolyInPlace(data []B128) {
+	parallel.Execute(len(data), func(start, end int) {
+		for i := start; i < end; i++ {
+			data[i] = fromPoly((*Poly128)(&data[i]))
+		}
+	}, nbTasks(len(data)))
+}
+
+// layer applies the butterflies of the i-th layer: for each block j of size 2ⁱ⁺¹,
+// the entries at distance 2ⁱ are combined with the twiddle twiddles[i][j] + shift.
+// The entries of data are in the polynomial basis.
+func (ntt *NTT) layer(data []B128, i int, shift Poly128, bf func(u, v, t *Poly128)) {
+	twiddles := ntt.twiddles[i]
+	half := 1 << i
+	nbButterflies := len(data) >> 1
+	parallel.Execute(nbButterflies, func(start, end int) {
+		var t Poly128
+		for b := start; b < end; b++ {
+			j, k := b>>i, b&(half-1)
+			idx := j<<(i+1) | k
+			t.Add(&twiddles[j], &shift)
+			bf((*Poly128)(&data[idx]), (*Poly128)(&data[idx+half]), &t)
+		}
+	}, nbTasks(nbButterflies))
+}
+
+func nbTasks(n int) int {
+	return min(n/(1<<12)+1, 64)
+}
+
+// butterfly sets (u, v) = (u + t·v, u + (t+1)·v)
+func butterfly(u, v, t *Poly128) {
+	var tv Poly128
+	tv.Mul(v, t)
+	u.Add(u, &tv)
+	v.Add(v, u)
+}
+
+// butterflyInverse is the inverse of butterfly
+func butterflyInverse(u, v, t *Poly128) {
+	var tv Poly128
+	v.Add(v, u)
+	tv.Mul(v, t)
+	u.Add(u, &tv)
+}
+
+// EvalNovelBasis returns the evaluation at x of the polynomial whose coefficients
+// in the novel basis are coeffs. len(coeffs) must be a power of 2, at most 2⁶³.
+func EvalNovelBasis(coeffs []B128, x *B128) B128 {
+	n := len(coeffs)
+	if n == 0 || n&(n-1) != 0 {
+		panic("len(coeffs) must be a power of 2")
+	}
+	subspaceOnce.Do(initSubspaceEvals)
+	logN := bits.TrailingZeros(uint(n))
+
+	// Ŵᵢ(x) for i < logN; x is a general element of B128 so Ŵᵢ is evaluated
+	// with the recurrence on the normalized subspace polynomials.
+	w := make([]B128, logN)
+	if logN > 0 {
+		w[0] = *x
+	}
+	for i := 0; i+1 < logN; i++ {
+		var t, norm B128
+		t.Add(&w[i], &B128{Lo: 1}).Mul(&t, &w[i])
+		s := subspaceEvals[i][i+1]
+		norm.SetUint64(inverse64(mul64(s, s^1)))
+		w[i+1].Mul(&t, &norm)
+	}
+
+	// Horner-like evaluation on the product structure of the basis:
+	// P = P₀ + Ŵₗ₋₁·P₁ recursively
+	var eval func(c []B128, level int) B128
+	eval = func(c []B128, level int) B128 {
+		if len(c) == 1 {
+			return c[0]
+		}
+		h := len(c) / 2
+		lo := eval(c[:h], level-1)
+		hi := eval(c[h:], level-1)
+		hi.Mul(&hi, &w[level-1])
+		return *lo.Add(&lo, &hi)
+	}
+	return eval(coeffs, logN)
+}
@@ -0,0 +1,82 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubspacePolynomials(t *testing.T) {
+	assert := require.New(t)
+	subspaceOnce.Do(initSubspaceEvals)
+	for i := 0; i < 64; i++ {
+		// Ŵᵢ(βᵢ) = 1 and Ŵᵢ vanishes on span(β₀, …, βᵢ₋₁)
+		assert.Equal(uint64(1), subspaceEvals[i][i])
+		if i > 0 && i < 12 {
+			for x := uint64(0); x < 1<<i; x++ {
+				coeffs := make([]B128, 1<<(i+1))
+				coeffs[1<<i].SetOne()
+				e := EvalNovelBasis(coeffs, &B128{Lo: x})
+				assert.True(e.IsZero())
+			}
+		}
+	}
+}
+
+func TestNTT(t *testing.T) {
+	assert := require.New(t)
+
+	for logN := 0; logN < 9; logN++ {
+		ntt := NewNTT(logN)
+		n := ntt.Size()
+		for _, coset := range []uint64{0, 1, 5, 1 << 20} {
+			coeffs := make(Vector, n)
+			coeffs.MustSetRandom()
+
+			evals := make([]B128, n)
+			copy(evals, coeffs)
+			ntt.Forward(evals, coset)
+
+			for y := 0; y < n; y++ {
+				x := B128{Lo: coset<<logN | uint64(y)}
+				assert.Equal(EvalNovelBasis(coeffs, &x), evals[y], "logN=%d coset=%d y=%d", logN, coset, y)
+			}
+
+			ntt.Inverse(evals, coset)
+			assert.Equal([]B128(coeffs), evals)
+		}
+	}
+}
+
+func TestNTTLarge(t *testing.T) {
+	assert := require.New(t)
+	const logN = 16
+	ntt := NewNTT(logN)
+	data := make(Vector, ntt.Size())
+	data.MustSetRandom()
+	backup := make([]B128, len(data))
+	copy(backup, data)
+
+	const coset = 3
+	ntt.Forward(data, coset)
+	for _, y := range []int{0, 1, 12345, len(data) - 1} {
+		x := B128{Lo: coset<<logN | uint64(y)}
+		assert.Equal(EvalNovelBasis(backup, &x), data[y])
+	}
+	ntt.Inverse(data, coset)
+	assert.Equal(backup, []B128(data))
+}
+
+func BenchmarkNTTForward(b *testing.B) {
+	const logN = 18
+	ntt := NewNTT(logN)
+	data := make(Vector, ntt.Size())
+	data.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ntt.Forward(data, 0)
+	}
+}
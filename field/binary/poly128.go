@@ -0,0 +1,292 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"fmt"
+	"math/bits"
+	"sync"
+)
+
+// Poly128 is an element of 𝔽₂¹²⁸ in the polynomial basis modulo x¹²⁸ + x⁷ + x² + x + 1;
+// bit i of Lo (resp. Hi) is the coefficient of xⁱ (resp. x⁶⁴⁺ⁱ).
+type Poly128 struct {
+	Lo, Hi uint64
+}
+
+// Add sets z = x + y (XOR) and returns z
+func (z *Poly128) Add(x, y *Poly128) *Poly128 {
+	z.Lo = x.Lo ^ y.Lo
+	z.Hi = x.Hi ^ y.Hi
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *Poly128) Mul(x, y *Poly128) *Poly128 {
+	mulPoly128(z, x, y)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *Poly128) Square(x *Poly128) *Poly128 {
+	mulPoly128(z, x, x)
+	return z
+}
+
+// Inverse sets z = x⁻¹ = x^(2¹²⁸-2) and returns z; the inverse of 0 is 0
+func (z *Poly128) Inverse(x *Poly128) *Poly128 {
+	// a = x^(2ᵏ-1) for k = 1, 2, 3, 6, 7, 14, 15, 30, 31, 62, 63, 126, 127
+	a := *x
+	k := 1
+	for _, double := range []bool{true, false, true, false, true, false, true, false, true, false, true, false} {
+		if double {
+			t := a
+			for i := 0; i < k; i++ {
+				t.Square(&t)
+			}
+			a.Mul(&a, &t)
+			k *= 2
+		} else {
+			a.Square(&a).Mul(&a, x)
+			k++
+		}
+	}
+	// x^(2¹²⁸-2) = (x^(2¹²⁷-1))²
+	z.Square(&a)
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *Poly128) IsZero() bool {
+	return (z.Lo | z.Hi) == 0
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *Poly128) Equal(x *Poly128) bool {
+	return z.Lo == x.Lo && z.Hi == x.Hi
+}
+
+// String returns the hexadecimal representation of z
+func (z Poly128) String() string {
+	return fmt.Sprintf("0x%016x%016x", z.Hi, z.Lo)
+}
+
+// mulPoly128Generic returns x·y, using a constant time carry-less multiplication
+func mulPoly128Generic(x, y *Poly128) Poly128 {
+	// schoolbook 128x128 -> 256 bits
+	h0, l0 := clmul64(x.Lo, y.Lo)
+	h2, l2 := clmul64(x.Hi, y.Hi)
+	h1, l1 := clmul64(x.Lo, y.Hi)
+	h1b, l1b := clmul64(x.Hi, y.Lo)
+	h1 ^= h1b
+	l1 ^= l1b
+	p0 := l0
+	p1 := h0 ^ l1
+	p2 := l2 ^ h1
+	p3 := h2
+	return reduce256(p0, p1, p2, p3)
+}
+
+// reduce256 reduces p₀ + p₁·x⁶⁴ + p₂·x¹²⁸ + p₃·x¹⁹² using x¹²⁸ = x⁷ + x² + x + 1
+func reduce256(p0, p1, p2, p3 uint64) Poly128 {
+	const r = 0x87
+	h, l := clmul64(p3, r)
+	p1 ^= l
+	p2 ^= h
+	h, l = clmul64(p2, r)
+	return Poly128{Lo: p0 ^ l, Hi: p1 ^ h}
+}
+
+// clmul64 returns the carry-less product of x and y
+func clmul64(x, y uint64) (hi, lo uint64) {
+	lo = bmul64(x, y)
+	hi = bits.Reverse64(bmul64(bits.Reverse64(x), bits.Reverse64(y))) >> 1
+	return
+}
+
+// bmul64 returns the low 64 bits of the carry-less product of x and y.
+// Integer multiplications are used on sparse operands (1 bit out of 4) so that
+// the carries do not interfere with the bits of interest (see BearSSL).
+func bmul64(x, y uint64) uint64 {
+	const (
+		m0 = 0x1111111111111111
+		m1 = 0x2222222222222222
+		m2 = 0x4444444444444444
+		m3 = 0x8888888888888888
+	)
+	x0, x1, x2, x3 := x&m0, x&m1, x&m2, x&m3
+	y0, y1, y2, y3 := y&m0, y&m1, y&m2, y&m3
+	z0 := (x0 * y0) ^ (x1 * y3) ^ (x2 * y2) ^ (x3 * y1)
+	z1 := (x0 * y1) ^ (x1 * y0) ^ (x2 * y3) ^ (x3 * y2)
+	z2 := (x0 * y2) ^ (x1 * y1) ^ (x2 * y0) ^ (x3 * y3)
+	z3 := (x0 * y3) ^ (x1 * y2) ^ (x2 * y1) ^ (x3 * y0)
+	return (z0 & m0) | (z1 & m1) | (z2 & m2) | (z3 & m3)
+}
+
+// The isomorphism between the tower T₇ and the polynomial basis maps the
+// generators X₁, …, X₇ to roots x₁, …, x₇ of X₁² + X₁ + 1 and
+// Xₖ² + xₖ₋₁·Xₖ + 1 in 𝔽₂¹²⁸. Both directions are GF(2)-linear and
+// are evaluated with one table lookup per input byte.
+var (
+	isoOnce       sync.Once
+	toPolyTable   [16][256]Poly128
+	fromPolyTable [16][256]B128
+)
+
+// ToPoly128 returns the image of x in the polynomial basis
+func ToPoly128(x *B128) Poly128 {
+	isoOnce.Do(initIsomorphism)
+	return toPoly(x)
+}
+
+// FromPoly128 returns the image of x in the tower basis
+func FromPoly128(x *Poly128) B128 {
+	isoOnce.Do(initIsomorphism)
+	return fromPoly(x)
+}
+
+func toPoly(x *B128) (r Poly128) {
+	for i := 0; i < 8; i++ {
+		t := &toPolyTable[i][uint8(x.Lo>>(8*i))]
+		r.Lo ^= t.Lo
+		r.Hi ^= t.Hi
+		t = &toPolyTable[8+i][uint8(x.Hi>>(8*i))]
+		r.Lo ^= t.Lo
+		r.Hi ^= t.Hi
+	}
+	return
+}
+
+func fromPoly(x *Poly128) (r B128) {
+	for i := 0; i < 8; i++ {
+		t := &fromPolyTable[i][uint8(x.Lo>>(8*i))]
+		r.Lo ^= t.Lo
+		r.Hi ^= t.Hi
+		t = &fromPolyTable[8+i][uint8(x.Hi>>(8*i))]
+		r.Lo ^= t.Lo
+		r.Hi ^= t.Hi
+	}
+	return
+}
+
+func initIsomorphism() {
+	one := Poly128{Lo: 1}
+
+	// images of the generators
+	var gens [7]Poly128
+	prev := one
+	for k := range gens {
+		// y² + b·y + 1 = 0 with b = xₖ₋₁ (x₀ = 1); setting y = b·z gives z² + z = b⁻²
+		var c Poly128
+		c.Square(&prev).Inverse(&c)
+		z, ok := solveArtinSchreier(&c)
+		if !ok {
+			panic("binary: no root for the tower relation")
+		}
+		gens[k].Mul(&prev, &z)
+		prev = gens[k]
+	}
+
+	// images of the 128 monomials
+	var images [128]Poly128
+	images[0] = one
+	for i := 1; i < 128; i++ {
+		j := bits.TrailingZeros(uint(i))
+		images[i].Mul(&images[i&^(1<<j)], &gens[j])
+	}
+
+	for p := 0; p < 16; p++ {
+		for v := 1; v < 256; v++ {
+			t := images[8*p+bits.TrailingZeros(uint(v))]
+			toPolyTable[p][v].Add(&toPolyTable[p][v&(v-1)], &t)
+		}
+	}
+
+	// invert the linear map: express each xⁱ as a combination of the images
+	var eb echelon
+	for i := range images {
+		if !eb.insert(images[i], unit(i)) {
+			panic("binary: the tower isomorphism is singular")
+		}
+	}
+	var preimages [128]B128
+	for i := range preimages {
+		r, comb := eb.reduce(Poly128(unit(i)))
+		if !r.IsZero() {
+			panic("binary: the tower isomorphism is singular")
+		}
+		preimages[i] = comb
+	}
+	for p := 0; p < 16; p++ {
+		for v := 1; v < 256; v++ {
+			t := preimages[8*p+bits.TrailingZeros(uint(v))]
+			fromPolyTable[p][v].Add(&fromPolyTable[p][v&(v-1)], &t)
+		}
+	}
+}
+
+// solveArtinSchreier returns z such that z² + z = c, if it exists
+func solveArtinSchreier(c *Poly128) (Poly128, bool) {
+	var eb echelon
+	for i := 0; i < 128; i++ {
+		e := Poly128(unit(i))
+		var l Poly128
+		l.Square(&e).Add(&l, &e)
+		eb.insert(l, unit(i))
+	}
+	r, comb := eb.reduce(*c)
+	return Poly128(comb), r.IsZero()
+}
+
+func unit(i int) B128 {
+	if i < 64 {
+		return B128{Lo: 1 << i}
+	}
+	return B128{Hi: 1 << (i - 64)}
+}
+
+// echelon is a GF(2) basis in echelon form, indexed by the leading bit of its vectors.
+// Each vector keeps track of the combination of inserted vectors it is made of.
+type echelon struct {
+	vec  [128]Poly128
+	comb [128]B128
+	set  [128]bool
+}
+
+func leadingBit(v *Poly128) int {
+	if v.Hi != 0 {
+		return 127 - bits.LeadingZeros64(v.Hi)
+	}
+	return 63 - bits.LeadingZeros64(v.Lo)
+}
+
+// reduce returns v minus its projection on the basis, and the combination subtracted
+func (eb *echelon) reduce(v Poly128) (Poly128, B128) {
+	var comb B128
+	for !v.IsZero() {
+		b := leadingBit(&v)
+		if !eb.set[b] {
+			break
+		}
+		v.Add(&v, &eb.vec[b])
+		comb.Add(&comb, &eb.comb[b])
+	}
+	return v, comb
+}
+
+// insert adds v to the basis, returns false if v is in the span of the basis
+func (eb *echelon) insert(v Poly128, tag B128) bool {
+	for !v.IsZero() {
+		b := leadingBit(&v)
+		if !eb.set[b] {
+			eb.vec[b] = v
+			eb.comb[b] = tag
+			eb.set[b] = true
+			return true
+		}
+		v.Add(&v, &eb.vec[b])
+		tag.Add(&tag, &eb.comb[b])
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mulPoly128Naive multiplies bit by bit, reducing as it goes
+func mulPoly128Naive(x, y Poly128) (r Poly128) {
+	for i := 0; i < 128; i++ {
+		var bit uint64
+		if i < 64 {
+			bit = (y.Lo >> i) & 1
+		} else {
+			bit = (y.Hi >> (i - 64)) & 1
+		}
+		if bit == 1 {
+			r.Add(&r, &x)
+		}
+		// x = x·X
+		carry := x.Hi >> 63
+		x.Hi = x.Hi<<1 | x.Lo>>63
+		x.Lo <<= 1
+		x.Lo ^= carry * 0x87
+	}
+	return
+}
+
+func randomPoly128() Poly128 {
+	var b B128
+	b.MustSetRandom()
+	return Poly128(b)
+}
+
+func TestPoly128Mul(t *testing.T) {
+	assert := require.New(t)
+	for i := 0; i < nbTests; i++ {
+		x, y := randomPoly128(), randomPoly128()
+		expected := mulPoly128Naive(x, y)
+		assert.Equal(expected, mulPoly128Generic(&x, &y))
+		var z Poly128
+		assert.Equal(expected, *z.Mul(&x, &y))
+
+		var inv Poly128
+		inv.Inverse(&x).Mul(&inv, &x)
+		assert.Equal(Poly128{Lo: 1}, inv)
+	}
+	// edge case: all ones
+	x := Poly128{Lo: ^uint64(0), Hi: ^uint64(0)}
+	assert.Equal(mulPoly128Naive(x, x), mulPoly128Generic(&x, &x))
+}
+
+func TestPoly128Vector(t *testing.T) {
+	assert := require.New(t)
+	const n = 37
+	a, b, res := make([]Poly128, n), make([]Poly128, n), make([]Poly128, n)
+	for i := range a {
+		a[i], b[i] = randomPoly128(), randomPoly128()
+	}
+	mulVec(res, a, b)
+	for i := range res {
+		assert.Equal(mulPoly128Naive(a[i], b[i]), res[i])
+	}
+	scalarMulVec(res, a, &b[0])
+	for i := range res {
+		assert.Equal(mulPoly128Naive(a[i], b[0]), res[i])
+	}
+}
+
+func TestIsomorphism(t *testing.T) {
+	assert := require.New(t)
+	one := B128{Lo: 1}
+	assert.Equal(Poly128{Lo: 1}, ToPoly128(&one))
+	for i := 0; i < nbTests; i++ {
+		var a, b, c B128
+		a.MustSetRandom()
+		b.MustSetRandom()
+		c.Mul(&a, &b)
+
+		pa, pb := ToPoly128(&a), ToPoly128(&b)
+		var pc Poly128
+		pc.Mul(&pa, &pb)
+		assert.Equal(ToPoly128(&c), pc)
+		assert.Equal(c, FromPoly128(&pc))
+		assert.Equal(a, FromPoly128(&pa))
+	}
+}
+
+func BenchmarkPoly128Mul(b *testing.B) {
+	x, y := randomPoly128(), randomPoly128()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
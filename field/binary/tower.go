@@ -0,0 +1,634 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+)
+
+// B1 is an element of T₀ = 𝔽₂, stored in the low bit of a byte
+type B1 uint8
+
+// SetZero sets z to 0 and returns z
+func (z *B1) SetZero() *B1 {
+	*z = 0
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *B1) SetOne() *B1 {
+	*z = 1
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *B1) Set(x *B1) *B1 {
+	*z = *x
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *B1) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *B1) IsOne() bool {
+	return *z == 1
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *B1) Equal(x *B1) bool {
+	return *z == *x
+}
+
+// Add sets z = x + y (XOR) and returns z
+func (z *B1) Add(x, y *B1) *B1 {
+	*z = *x ^ *y
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *B1) Mul(x, y *B1) *B1 {
+	*z = B1(mul1(uint8(*x), uint8(*y)))
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *B1) Square(x *B1) *B1 {
+	*z = B1(square1(uint8(*x)))
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; the inverse of 0 is 0
+func (z *B1) Inverse(x *B1) *B1 {
+	*z = B1(inverse1(uint8(*x)))
+	return z
+}
+
+// SetRandom sets z to a uniformly random element
+func (z *B1) SetRandom() (*B1, error) {
+	var b [1]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = B1(b[0] & 0x1)
+	return z, nil
+}
+
+// MustSetRandom sets z to a uniformly random element.
+// It panics if reading from crypto/rand fails.
+func (z *B1) MustSetRandom() *B1 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z B1) String() string {
+	return fmt.Sprintf("0x%01x", uint8(z))
+}
+
+// B2 is an element of T₁ = 𝔽₂², stored in the low 2 bits of a byte
+type B2 uint8
+
+// SetZero sets z to 0 and returns z
+func (z *B2) SetZero() *B2 {
+	*z = 0
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *B2) SetOne() *B2 {
+	*z = 1
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *B2) Set(x *B2) *B2 {
+	*z = *x
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *B2) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *B2) IsOne() bool {
+	return *z == 1
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *B2) Equal(x *B2) bool {
+	return *z == *x
+}
+
+// Add sets z = x + y (XOR) and returns z
+func (z *B2) Add(x, y *B2) *B2 {
+	*z = *x ^ *y
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *B2) Mul(x, y *B2) *B2 {
+	*z = B2(mul2(uint8(*x), uint8(*y)))
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *B2) Square(x *B2) *B2 {
+	*z = B2(square2(uint8(*x)))
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; the inverse of 0 is 0
+func (z *B2) Inverse(x *B2) *B2 {
+	*z = B2(inverse2(uint8(*x)))
+	return z
+}
+
+// MulByGenerator sets z = x·X₁ and returns z, where X₁ generates T₁ over T₀
+func (z *B2) MulByGenerator(x *B2) *B2 {
+	*z = B2(mulX2(uint8(*x)))
+	return z
+}
+
+// SetRandom sets z to a uniformly random element
+func (z *B2) SetRandom() (*B2, error) {
+	var b [1]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = B2(b[0] & 0x3)
+	return z, nil
+}
+
+// MustSetRandom sets z to a uniformly random element.
+// It panics if reading from crypto/rand fails.
+func (z *B2) MustSetRandom() *B2 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z B2) String() string {
+	return fmt.Sprintf("0x%01x", uint8(z))
+}
+
+// B4 is an element of T₂ = 𝔽₂⁴, stored in the low 4 bits of a byte
+type B4 uint8
+
+// SetZero sets z to 0 and returns z
+func (z *B4) SetZero() *B4 {
+	*z = 0
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *B4) SetOne() *B4 {
+	*z = 1
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *B4) Set(x *B4) *B4 {
+	*z = *x
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *B4) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *B4) IsOne() bool {
+	return *z == 1
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *B4) Equal(x *B4) bool {
+	return *z == *x
+}
+
+// Add sets z = x + y (XOR) and returns z
+func (z *B4) Add(x, y *B4) *B4 {
+	*z = *x ^ *y
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *B4) Mul(x, y *B4) *B4 {
+	*z = B4(mul4(uint8(*x), uint8(*y)))
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *B4) Square(x *B4) *B4 {
+	*z = B4(square4(uint8(*x)))
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; the inverse of 0 is 0
+func (z *B4) Inverse(x *B4) *B4 {
+	*z = B4(inverse4(uint8(*x)))
+	return z
+}
+
+// MulByGenerator sets z = x·X₂ and returns z, where X₂ generates T₂ over T₁
+func (z *B4) MulByGenerator(x *B4) *B4 {
+	*z = B4(mulX4(uint8(*x)))
+	return z
+}
+
+// SetRandom sets z to a uniformly random element
+func (z *B4) SetRandom() (*B4, error) {
+	var b [1]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = B4(b[0] & 0xf)
+	return z, nil
+}
+
+// MustSetRandom sets z to a uniformly random element.
+// It panics if reading from crypto/rand fails.
+func (z *B4) MustSetRandom() *B4 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z B4) String() string {
+	return fmt.Sprintf("0x%01x", uint8(z))
+}
+
+// B8 is an element of T₃ = 𝔽₂⁸
+type B8 uint8
+
+// SetZero sets z to 0 and returns z
+func (z *B8) SetZero() *B8 {
+	*z = 0
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *B8) SetOne() *B8 {
+	*z = 1
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *B8) Set(x *B8) *B8 {
+	*z = *x
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *B8) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *B8) IsOne() bool {
+	return *z == 1
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *B8) Equal(x *B8) bool {
+	return *z == *x
+}
+
+// Add sets z = x + y (XOR) and returns z
+func (z *B8) Add(x, y *B8) *B8 {
+	*z = *x ^ *y
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *B8) Mul(x, y *B8) *B8 {
+	*z = B8(mul8(uint8(*x), uint8(*y)))
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *B8) Square(x *B8) *B8 {
+	*z = B8(square8(uint8(*x)))
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; the inverse of 0 is 0
+func (z *B8) Inverse(x *B8) *B8 {
+	*z = B8(inverse8(uint8(*x)))
+	return z
+}
+
+// MulByGenerator sets z = x·X₃ and returns z, where X₃ generates T₃ over T₂
+func (z *B8) MulByGenerator(x *B8) *B8 {
+	*z = B8(mulX8(uint8(*x)))
+	return z
+}
+
+// SetRandom sets z to a uniformly random element
+func (z *B8) SetRandom() (*B8, error) {
+	var b [1]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = B8(b[0])
+	return z, nil
+}
+
+// MustSetRandom sets z to a uniformly random element.
+// It panics if reading from crypto/rand fails.
+func (z *B8) MustSetRandom() *B8 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z B8) String() string {
+	return fmt.Sprintf("0x%02x", uint8(z))
+}
+
+// B16 is an element of T₄ = 𝔽₂¹⁶
+type B16 uint16
+
+// SetZero sets z to 0 and returns z
+func (z *B16) SetZero() *B16 {
+	*z = 0
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *B16) SetOne() *B16 {
+	*z = 1
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *B16) Set(x *B16) *B16 {
+	*z = *x
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *B16) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *B16) IsOne() bool {
+	return *z == 1
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *B16) Equal(x *B16) bool {
+	return *z == *x
+}
+
+// Add sets z = x + y (XOR) and returns z
+func (z *B16) Add(x, y *B16) *B16 {
+	*z = *x ^ *y
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *B16) Mul(x, y *B16) *B16 {
+	*z = B16(mul16(uint16(*x), uint16(*y)))
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *B16) Square(x *B16) *B16 {
+	*z = B16(square16(uint16(*x)))
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; the inverse of 0 is 0
+func (z *B16) Inverse(x *B16) *B16 {
+	*z = B16(inverse16(uint16(*x)))
+	return z
+}
+
+// MulByGenerator sets z = x·X₄ and returns z, where X₄ generates T₄ over T₃
+func (z *B16) MulByGenerator(x *B16) *B16 {
+	*z = B16(mulX16(uint16(*x)))
+	return z
+}
+
+// SetRandom sets z to a uniformly random element
+func (z *B16) SetRandom() (*B16, error) {
+	var b [2]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = B16(binary.LittleEndian.Uint16(b[:]))
+	return z, nil
+}
+
+// MustSetRandom sets z to a uniformly random element.
+// It panics if reading from crypto/rand fails.
+func (z *B16) MustSetRandom() *B16 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z B16) String() string {
+	return fmt.Sprintf("0x%04x", uint16(z))
+}
+
+// B32 is an element of T₅ = 𝔽₂³²
+type B32 uint32
+
+// SetZero sets z to 0 and returns z
+func (z *B32) SetZero() *B32 {
+	*z = 0
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *B32) SetOne() *B32 {
+	*z = 1
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *B32) Set(x *B32) *B32 {
+	*z = *x
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *B32) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *B32) IsOne() bool {
+	return *z == 1
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *B32) Equal(x *B32) bool {
+	return *z == *x
+}
+
+// Add sets z = x + y (XOR) and returns z
+func (z *B32) Add(x, y *B32) *B32 {
+	*z = *x ^ *y
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *B32) Mul(x, y *B32) *B32 {
+	*z = B32(mul32(uint32(*x), uint32(*y)))
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *B32) Square(x *B32) *B32 {
+	*z = B32(square32(uint32(*x)))
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; the inverse of 0 is 0
+func (z *B32) Inverse(x *B32) *B32 {
+	*z = B32(inverse32(uint32(*x)))
+	return z
+}
+
+// MulByGenerator sets z = x·X₅ and returns z, where X₅ generates T₅ over T₄
+func (z *B32) MulByGenerator(x *B32) *B32 {
+	*z = B32(mulX32(uint32(*x)))
+	return z
+}
+
+// SetRandom sets z to a uniformly random element
+func (z *B32) SetRandom() (*B32, error) {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = B32(binary.LittleEndian.Uint32(b[:]))
+	return z, nil
+}
+
+// MustSetRandom sets z to a uniformly random element.
+// It panics if reading from crypto/rand fails.
+func (z *B32) MustSetRandom() *B32 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z B32) String() string {
+	return fmt.Sprintf("0x%08x", uint32(z))
+}
+
+// B64 is an element of T₆ = 𝔽₂⁶⁴
+type B64 uint64
+
+// SetZero sets z to 0 and returns z
+func (z *B64) SetZero() *B64 {
+	*z = 0
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *B64) SetOne() *B64 {
+	*z = 1
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *B64) Set(x *B64) *B64 {
+	*z = *x
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *B64) IsZero() bool {
+	return *z == 0
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *B64) IsOne() bool {
+	return *z == 1
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *B64) Equal(x *B64) bool {
+	return *z == *x
+}
+
+// Add sets z = x + y (XOR) and returns z
+func (z *B64) Add(x, y *B64) *B64 {
+	*z = *x ^ *y
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *B64) Mul(x, y *B64) *B64 {
+	*z = B64(mul64(uint64(*x), uint64(*y)))
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *B64) Square(x *B64) *B64 {
+	*z = B64(square64(uint64(*x)))
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; the inverse of 0 is 0
+func (z *B64) Inverse(x *B64) *B64 {
+	*z = B64(inverse64(uint64(*x)))
+	return z
+}
+
+// MulByGenerator sets z = x·X₆ and returns z, where X₆ generates T₆ over T₅
+func (z *B64) MulByGenerator(x *B64) *B64 {
+	*z = B64(mulX64(uint64(*x)))
+	return z
+}
+
+// SetRandom sets z to a uniformly random element
+func (z *B64) SetRandom() (*B64, error) {
+	var b [8]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	*z = B64(binary.LittleEndian.Uint64(b[:]))
+	return z, nil
+}
+
+// MustSetRandom sets z to a uniformly random element.
+// It panics if reading from crypto/rand fails.
+func (z *B64) MustSetRandom() *B64 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// String returns the hexadecimal representation of z
+func (z B64) String() string {
+	return fmt.Sprintf("0x%016x", uint64(z))
+}
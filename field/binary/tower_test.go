@@ -0,0 +1,410 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const nbTests = 200
+
+func TestB1(t *testing.T) {
+	assert := require.New(t)
+	for a := B1(0); a < 2; a++ {
+		for b := B1(0); b < 2; b++ {
+			var c, d B1
+			assert.Equal(a^b, *c.Add(&a, &b))
+			assert.Equal(a&b, *d.Mul(&a, &b))
+		}
+	}
+	one := B1(1)
+	var inv B1
+	assert.True(inv.Inverse(&one).IsOne())
+}
+
+func TestB8Table(t *testing.T) {
+	assert := require.New(t)
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			assert.Equal(mul8Slow(uint8(a), uint8(b)), mul8(uint8(a), uint8(b)))
+		}
+		if a != 0 {
+			assert.Equal(uint8(1), mul8(uint8(a), inverse8(uint8(a))))
+		}
+	}
+}
+
+func TestSubfieldEmbedding(t *testing.T) {
+	assert := require.New(t)
+	// the elements of Tⱼ are the elements of Tₖ smaller than 2^(2ʲ), and the
+	// multiplication in Tₖ restricted to Tⱼ is the multiplication in Tⱼ
+	for i := 0; i < nbTests; i++ {
+		var a, b B8
+		a.MustSetRandom()
+		b.MustSetRandom()
+		var c B8
+		c.Mul(&a, &b)
+		a16, b16 := B16(a), B16(b)
+		var c16 B16
+		c16.Mul(&a16, &b16)
+		assert.Equal(B16(c), c16)
+		a128, b128 := B128{Lo: uint64(a)}, B128{Lo: uint64(b)}
+		var c128 B128
+		c128.Mul(&a128, &b128)
+		assert.Equal(B128{Lo: uint64(c)}, c128)
+	}
+}
+
+func TestTowerRelation(t *testing.T) {
+	assert := require.New(t)
+	// Xₖ² = Xₖ₋₁·Xₖ + 1, with Xₖ = 2^(2ᵏ⁻¹) in the bit representation
+	x1 := B2(2)
+	var s B2
+	s.Square(&x1)
+	assert.Equal(B2(3), s)
+
+	x6, x7 := uint64(1)<<32, B128{Hi: 1}
+	var s128 B128
+	s128.Square(&x7)
+	assert.Equal(B128{Lo: 1, Hi: x6}, s128)
+}
+
+func TestB2(t *testing.T) {
+	assert := require.New(t)
+	for i := 0; i < nbTests; i++ {
+		var a, b, c, d, e B2
+		a.MustSetRandom()
+		b.MustSetRandom()
+		c.MustSetRandom()
+
+		// distributivity
+		d.Add(&b, &c).Mul(&d, &a)
+		var ab, ac B2
+		ab.Mul(&a, &b)
+		ac.Mul(&a, &c)
+		e.Add(&ab, &ac)
+		assert.Equal(d, e)
+
+		// commutativity and associativity
+		var ba B2
+		ba.Mul(&b, &a)
+		assert.Equal(ab, ba)
+		d.Mul(&ab, &c)
+		e.Mul(&b, &c).Mul(&e, &a)
+		assert.Equal(d, e)
+
+		// square
+		d.Mul(&a, &a)
+		e.Square(&a)
+		assert.Equal(d, e)
+
+		// mul by generator
+		gen := B2(1) << (2 / 2)
+		d.Mul(&a, &gen)
+		e.MulByGenerator(&a)
+		assert.Equal(d, e)
+
+		// inverse
+		if !a.IsZero() {
+			d.Inverse(&a).Mul(&d, &a)
+			assert.True(d.IsOne())
+		}
+	}
+	var zero B2
+	assert.True(zero.Inverse(&zero).IsZero())
+}
+
+func TestB4(t *testing.T) {
+	assert := require.New(t)
+	for i := 0; i < nbTests; i++ {
+		var a, b, c, d, e B4
+		a.MustSetRandom()
+		b.MustSetRandom()
+		c.MustSetRandom()
+
+		// distributivity
+		d.Add(&b, &c).Mul(&d, &a)
+		var ab, ac B4
+		ab.Mul(&a, &b)
+		ac.Mul(&a, &c)
+		e.Add(&ab, &ac)
+		assert.Equal(d, e)
+
+		// commutativity and associativity
+		var ba B4
+		ba.Mul(&b, &a)
+		assert.Equal(ab, ba)
+		d.Mul(&ab, &c)
+		e.Mul(&b, &c).Mul(&e, &a)
+		assert.Equal(d, e)
+
+		// square
+		d.Mul(&a, &a)
+		e.Square(&a)
+		assert.Equal(d, e)
+
+		// mul by generator
+		gen := B4(1) << (4 / 2)
+		d.Mul(&a, &gen)
+		e.MulByGenerator(&a)
+		assert.Equal(d, e)
+
+		// inverse
+		if !a.IsZero() {
+			d.Inverse(&a).Mul(&d, &a)
+			assert.True(d.IsOne())
+		}
+	}
+	var zero B4
+	assert.True(zero.Inverse(&zero).IsZero())
+}
+
+func TestB8(t *testing.T) {
+	assert := require.New(t)
+	for i := 0; i < nbTests; i++ {
+		var a, b, c, d, e B8
+		a.MustSetRandom()
+		b.MustSetRandom()
+		c.MustSetRandom()
+
+		// distributivity
+		d.Add(&b, &c).Mul(&d, &a)
+		var ab, ac B8
+		ab.Mul(&a, &b)
+		ac.Mul(&a, &c)
+		e.Add(&ab, &ac)
+		assert.Equal(d, e)
+
+		// commutativity and associativity
+		var ba B8
+		ba.Mul(&b, &a)
+		assert.Equal(ab, ba)
+		d.Mul(&ab, &c)
+		e.Mul(&b, &c).Mul(&e, &a)
+		assert.Equal(d, e)
+
+		// square
+		d.Mul(&a, &a)
+		e.Square(&a)
+		assert.Equal(d, e)
+
+		// mul by generator
+		gen := B8(1) << (8 / 2)
+		d.Mul(&a, &gen)
+		e.MulByGenerator(&a)
+		assert.Equal(d, e)
+
+		// inverse
+		if !a.IsZero() {
+			d.Inverse(&a).Mul(&d, &a)
+			assert.True(d.IsOne())
+		}
+	}
+	var zero B8
+	assert.True(zero.Inverse(&zero).IsZero())
+}
+
+func TestB16(t *testing.T) {
+	assert := require.New(t)
+	for i := 0; i < nbTests; i++ {
+		var a, b, c, d, e B16
+		a.MustSetRandom()
+		b.MustSetRandom()
+		c.MustSetRandom()
+
+		// distributivity
+		d.Add(&b, &c).Mul(&d, &a)
+		var ab, ac B16
+		ab.Mul(&a, &b)
+		ac.Mul(&a, &c)
+		e.Add(&ab, &ac)
+		assert.Equal(d, e)
+
+		// commutativity and associativity
+		var ba B16
+		ba.Mul(&b, &a)
+		assert.Equal(ab, ba)
+		d.Mul(&ab, &c)
+		e.Mul(&b, &c).Mul(&e, &a)
+		assert.Equal(d, e)
+
+		// square
+		d.Mul(&a, &a)
+		e.Square(&a)
+		assert.Equal(d, e)
+
+		// mul by generator
+		gen := B16(1) << (16 / 2)
+		d.Mul(&a, &gen)
+		e.MulByGenerator(&a)
+		assert.Equal(d, e)
+
+		// inverse
+		if !a.IsZero() {
+			d.Inverse(&a).Mul(&d, &a)
+			assert.True(d.IsOne())
+		}
+	}
+	var zero B16
+	assert.True(zero.Inverse(&zero).IsZero())
+}
+
+func TestB32(t *testing.T) {
+	assert := require.New(t)
+	for i := 0; i < nbTests; i++ {
+		var a, b, c, d, e B32
+		a.MustSetRandom()
+		b.MustSetRandom()
+		c.MustSetRandom()
+
+		// distributivity
+		d.Add(&b, &c).Mul(&d, &a)
+		var ab, ac B32
+		ab.Mul(&a, &b)
+		ac.Mul(&a, &c)
+		e.Add(&ab, &ac)
+		assert.Equal(d, e)
+
+		// commutativity and associativity
+		var ba B32
+		ba.Mul(&b, &a)
+		assert.Equal(ab, ba)
+		d.Mul(&ab, &c)
+		e.Mul(&b, &c).Mul(&e, &a)
+		assert.Equal(d, e)
+
+		// square
+		d.Mul(&a, &a)
+		e.Square(&a)
+		assert.Equal(d, e)
+
+		// mul by generator
+		gen := B32(1) << (32 / 2)
+		d.Mul(&a, &gen)
+		e.MulByGenerator(&a)
+		assert.Equal(d, e)
+
+		// inverse
+		if !a.IsZero() {
+			d.Inverse(&a).Mul(&d, &a)
+			assert.True(d.IsOne())
+		}
+	}
+	var zero B32
+	assert.True(zero.Inverse(&zero).IsZero())
+}
+
+func TestB64(t *testing.T) {
+	assert := require.New(t)
+	for i := 0; i < nbTests; i++ {
+		var a, b, c, d, e B64
+		a.MustSetRandom()
+		b.MustSetRandom()
+		c.MustSetRandom()
+
+		// distributivity
+		d.Add(&b, &c).Mul(&d, &a)
+		var ab, ac B64
+		ab.Mul(&a, &b)
+		ac.Mul(&a, &c)
+		e.Add(&ab, &ac)
+		assert.Equal(d, e)
+
+		// commutativity and associativity
+		var ba B64
+		ba.Mul(&b, &a)
+		assert.Equal(ab, ba)
+		d.Mul(&ab, &c)
+		e.Mul(&b, &c).Mul(&e, &a)
+		assert.Equal(d, e)
+
+		// square
+		d.Mul(&a, &a)
+		e.Square(&a)
+		assert.Equal(d, e)
+
+		// mul by generator
+		gen := B64(1) << (64 / 2)
+		d.Mul(&a, &gen)
+		e.MulByGenerator(&a)
+		assert.Equal(d, e)
+
+		// inverse
+		if !a.IsZero() {
+			d.Inverse(&a).Mul(&d, &a)
+			assert.True(d.IsOne())
+		}
+	}
+	var zero B64
+	assert.True(zero.Inverse(&zero).IsZero())
+}
+
+func TestB128(t *testing.T) {
+	assert := require.New(t)
+	for i := 0; i < nbTests; i++ {
+		var a, b, c, d, e B128
+		a.MustSetRandom()
+		b.MustSetRandom()
+		c.MustSetRandom()
+
+		d.Add(&b, &c).Mul(&d, &a)
+		var ab, ac B128
+		ab.Mul(&a, &b)
+		ac.Mul(&a, &c)
+		e.Add(&ab, &ac)
+		assert.Equal(d, e)
+
+		d.Mul(&ab, &c)
+		e.Mul(&b, &c).Mul(&e, &a)
+		assert.Equal(d, e)
+
+		d.Mul(&a, &a)
+		e.Square(&a)
+		assert.Equal(d, e)
+
+		gen := B128{Hi: 1}
+		d.Mul(&a, &gen)
+		e.MulByGenerator(&a)
+		assert.Equal(d, e)
+
+		d.MulByB64(&a, b.Lo)
+		e.SetUint64(b.Lo).Mul(&e, &a)
+		assert.Equal(d, e)
+
+		d.Inverse(&a).Mul(&d, &a)
+		assert.True(d.IsOne())
+
+		// Frobenius: a^(2¹²⁸) = a
+		d = a
+		for j := 0; j < 128; j++ {
+			d.Square(&d)
+		}
+		assert.Equal(a, d)
+
+		bytes := a.Bytes()
+		assert.NoError(d.SetBytes(bytes[:]))
+		assert.Equal(a, d)
+	}
+}
+
+func BenchmarkB128Mul(b *testing.B) {
+	var x, y B128
+	x.MustSetRandom()
+	y.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(&x, &y)
+	}
+}
+
+func BenchmarkB128Inverse(b *testing.B) {
+	var x B128
+	x.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Inverse(&x)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+// Vector represents a slice of B128.
+//
+// The multiplicative operations map the elements to the polynomial basis (see Poly128)
+// in chunks, where they use the carry-less multiplication when available.
+type Vector []B128
+
+// chunkSize is the number of elements converted at once by the vector operations
+const chunkSize = 256
+
+// Add adds two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	for i := range *vector {
+		(*vector)[i].Add(&a[i], &b[i])
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *B128) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	isoOnce.Do(initIsomorphism)
+	var buf [chunkSize]Poly128
+	pb := toPoly(b)
+	for start := 0; start < len(a); start += chunkSize {
+		end := min(start+chunkSize, len(a))
+		t := buf[:end-start]
+		for i := range t {
+			t[i] = toPoly(&a[start+i])
+		}
+		scalarMulVec(t, t, &pb)
+		for i := range t {
+			(*vector)[start+i] = fromPoly(&t[i])
+		}
+	}
+}
+
+// Mul multiplies two vectors element-wise and stores the result in vector.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	isoOnce.Do(initIsomorphism)
+	var bufA, bufB [chunkSize]Poly128
+	for start := 0; start < len(a); start += chunkSize {
+		end := min(start+chunkSize, len(a))
+		ta, tb := bufA[:end-start], bufB[:end-start]
+		for i := range ta {
+			ta[i] = toPoly(&a[start+i])
+			tb[i] = toPoly(&b[start+i])
+		}
+		mulVec(ta, ta, tb)
+		for i := range ta {
+			(*vector)[start+i] = fromPoly(&ta[i])
+		}
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res B128) {
+	for i := range *vector {
+		res.Add(&res, &(*vector)[i])
+	}
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res B128) {
+	if len(*vector) != len(other) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	isoOnce.Do(initIsomorphism)
+	var bufA, bufB [chunkSize]Poly128
+	var acc Poly128
+	for start := 0; start < len(other); start += chunkSize {
+		end := min(start+chunkSize, len(other))
+		ta, tb := bufA[:end-start], bufB[:end-start]
+		for i := range ta {
+			ta[i] = toPoly(&(*vector)[start+i])
+			tb[i] = toPoly(&other[start+i])
+		}
+		mulVec(ta, ta, tb)
+		for i := range ta {
+			acc.Add(&acc, &ta[i])
+		}
+	}
+	return fromPoly(&acc)
+}
+
+// MustSetRandom sets the elements in vector to independent uniformly random values.
+// It panics if reading from crypto/rand fails.
+func (vector Vector) MustSetRandom() {
+	for i := range vector {
+		vector[i].MustSetRandom()
+	}
+}
+
+func mulVecGeneric(res, a, b []Poly128) {
+	for i := range res {
+		res[i] = mulPoly128Generic(&a[i], &b[i])
+	}
+}
+
+func scalarMulVecGeneric(res, a []Poly128, b *Poly128) {
+	for i := range res {
+		res[i] = mulPoly128Generic(&a[i], b)
+	}
+}
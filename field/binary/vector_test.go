@@ -0,0 +1,73 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package binary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorOps(t *testing.T) {
+	assert := require.New(t)
+
+	for _, n := range []int{0, 1, 7, chunkSize, 3*chunkSize + 5} {
+		a, b := make(Vector, n), make(Vector, n)
+		a.MustSetRandom()
+		b.MustSetRandom()
+		res := make(Vector, n)
+
+		res.Add(a, b)
+		for i := range res {
+			var e B128
+			assert.Equal(*e.Add(&a[i], &b[i]), res[i])
+		}
+
+		res.Mul(a, b)
+		for i := range res {
+			var e B128
+			assert.Equal(*e.Mul(&a[i], &b[i]), res[i])
+		}
+
+		var s B128
+		s.MustSetRandom()
+		res.ScalarMul(a, &s)
+		for i := range res {
+			var e B128
+			assert.Equal(*e.Mul(&a[i], &s), res[i])
+		}
+
+		var ip, sum B128
+		for i := range a {
+			var e B128
+			e.Mul(&a[i], &b[i])
+			ip.Add(&ip, &e)
+			sum.Add(&sum, &a[i])
+		}
+		assert.Equal(ip, a.InnerProduct(b))
+		assert.Equal(sum, a.Sum())
+	}
+}
+
+func BenchmarkVectorMul(b *testing.B) {
+	const n = 1 << 14
+	x, y := make(Vector, n), make(Vector, n)
+	x.MustSetRandom()
+	y.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Mul(x, y)
+	}
+}
+
+func BenchmarkVectorInnerProduct(b *testing.B) {
+	const n = 1 << 14
+	x, y := make(Vector, n), make(Vector, n)
+	x.MustSetRandom()
+	y.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = x.InnerProduct(y)
+	}
+}
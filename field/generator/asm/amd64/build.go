@@ -248,6 +248,9 @@ func GenerateCommonASM(w io.Writer, nbWords, nbBits int, hasVector bool) error {
 	if nbWords == 1 {
 		if nbBits <= 31 {
 			return GenerateF31ASM(f, hasVector)
+		} else if nbBits == 64 {
+			// only the Goldilocks field (q = 2⁶⁴ - 2³² + 1) is configured to use this path.
+			return GenerateGoldilocksASM(f, hasVector)
 		} else {
 			panic("not implemented")
 		}
@@ -411,6 +414,70 @@ func GenerateF31ASM(f *FFAmd64, hasVector bool) error {
 	return nil
 }
 
+// GenerateGoldilocksASM generates the AVX512 vector operations for the
+// Goldilocks field. Scalar operations are not implemented in assembly.
+func GenerateGoldilocksASM(f *FFAmd64, hasVector bool) error {
+	if !hasVector {
+		return nil // nothing for now.
+	}
+
+	f.generateGoldilocksDefines()
+
+	f.generateAddVecGoldilocks()
+	f.generateSubVecGoldilocks()
+	f.generateSumVecGoldilocks()
+	f.generateMulVecGoldilocks("mulVec")
+	f.generateMulVecGoldilocks("scalarMulVec")
+	f.generateInnerProdVecGoldilocks()
+
+	return nil
+}
+
+// GenerateGoldilocksFFTKernels generates the AVX512 butterflies with twiddles
+// used in the FFT over the Goldilocks field.
+func GenerateGoldilocksFFTKernels(w io.Writer) error {
+	f := NewFFAmd64(w, 1)
+
+	f.WriteLn("")
+	f.Comment("Code generated by gnark-crypto/generator. DO NOT EDIT.")
+	f.Comment("Refer to the generator for more documentation.")
+	f.WriteLn("")
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("#include \"go_asm.h\"")
+	f.WriteLn("")
+
+	f.generateGoldilocksDefines()
+
+	f.generateFFTInnerGoldilocks(false)
+	f.generateFFTInnerGoldilocks(true)
+
+	return nil
+}
+
+// GenerateGoldilocksPoseidon2 generates the AVX512 Poseidon2 permutations
+// over the Goldilocks field, for widths 8 and 12.
+func GenerateGoldilocksPoseidon2(w io.Writer, params []Poseidon2Parameters) error {
+	f := NewFFAmd64(w, 1)
+
+	f.WriteLn("")
+	f.Comment("Code generated by gnark-crypto/generator. DO NOT EDIT.")
+	f.Comment("Refer to the generator for more documentation.")
+	f.WriteLn("")
+	f.WriteLn("#include \"textflag.h\"")
+	f.WriteLn("#include \"funcdata.h\"")
+	f.WriteLn("#include \"go_asm.h\"")
+	f.WriteLn("")
+
+	f.generateGoldilocksDefines()
+
+	for _, p := range params {
+		f.generatePoseidon2Goldilocks(p)
+	}
+
+	return nil
+}
+
 func ElementASMFileName(nbWords, nbBits int) string {
 	const nameW1 = "element_%db_amd64.s"
 	const nameWN = "element_%dw_amd64.s"
@@ -429,7 +496,10 @@ func ElementASMBaseDir(nbWords, nbBits int) string {
 	const fWN = "element_%dw"
 
 	if nbWords == 1 {
-		return fmt.Sprintf(fW1, 31)
+		if nbBits <= 31 {
+			return fmt.Sprintf(fW1, 31)
+		}
+		return fmt.Sprintf(fW1, nbBits)
 	}
 	return fmt.Sprintf(fWN, nbWords)
 }
@@ -0,0 +1,472 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/consensys/bavard/amd64"
+)
+
+// Goldilocks modulus q = 2⁶⁴ - 2³² + 1 and ε = 2⁶⁴ mod q = 2³² - 1.
+const (
+	goldilocksQ       = uint64(0xFFFFFFFF00000001)
+	goldilocksEpsilon = uint64(0xFFFFFFFF)
+)
+
+// VPCMPUQ compares unsigned quadwords; k[i] = r2[i] <op> r1[i]
+// where <op> is given by imm8 (1: LT, 2: LE, 5: NLT, 6: NLE).
+func (f *FFAmd64) VPCMPUQ(imm8 int, r1, r2, k any, comment ...string) {
+	s := fmt.Sprintf("    VPCMPUQ $%d, %v, %v, %v", imm8, r1, r2, k)
+	if len(comment) == 1 {
+		s += " // " + comment[0]
+	}
+	f.WriteLn(s)
+}
+
+// generateGoldilocksDefines generates the macros shared by the Goldilocks
+// vector operations, FFT kernels and Poseidon2 permutations.
+//
+// Each 64bit lane holds an element in Montgomery form (R = 2⁶⁴), in [0, q).
+// K3 must hold the dword mask 0x5555 and is used in the multiplication;
+// K4 is used as scratch.
+func (f *FFAmd64) generateGoldilocksDefines() {
+
+	// load_goldilocks(q, eps, ones) broadcasts the constants and sets K3.
+	_ = f.Define("load_goldilocks", 3, func(args ...any) {
+		q := args[0]
+		eps := args[1]
+		ones := args[2]
+		f.MOVQ(goldilocksQ, amd64.AX)
+		f.VPBROADCASTQ(amd64.AX, q)
+		f.MOVQ(goldilocksEpsilon, amd64.AX)
+		f.VPBROADCASTQ(amd64.AX, eps)
+		f.VPTERNLOGD(uint64(0xff), ones, ones, ones)
+		f.MOVQ(uint64(0x5555), amd64.AX)
+		f.KMOVD(amd64.AX, amd64.K3)
+	})
+
+	// add_g computes into = a + b mod q; a, b in [0, q).
+	// On overflow the sum wraps around 2⁶⁴ and we add 2⁶⁴ mod q = ε;
+	// the result is then in [0, 2q) and a final min(x, x - q) reduces it.
+	_ = f.Define("add_g", 7, func(args ...any) {
+		a := args[0]
+		b := args[1]
+		into := args[2]
+		t0 := args[3]
+		t1 := args[4]
+		q := args[5]
+		eps := args[6]
+		f.VPADDQ(a, b, t0)
+		f.VPCMPUQ(1, b, t0, amd64.K4) // carry
+		f.VPADDQk(eps, t0, t0, amd64.K4)
+		f.VPSUBQ(q, t0, t1)
+		f.VPMINUQ(t0, t1, into)
+	})
+
+	// sub_g computes into = a - b mod q; a, b in [0, q).
+	_ = f.Define("sub_g", 4, func(args ...any) {
+		a := args[0]
+		b := args[1]
+		into := args[2]
+		q := args[3]
+		f.VPCMPUQ(1, b, a, amd64.K4) // borrow
+		f.VPSUBQ(b, a, into)
+		f.VPADDQk(q, into, into, amd64.K4)
+	})
+
+	// mul_g computes into = a * b * 2⁻⁶⁴ mod q; a, b in [0, q).
+	//
+	// The 128bit product hi:lo is computed with 4 32x32 bits multiplications,
+	// with an ordering of the partial sums that never overflows.
+	// We then use the special form of q for the Montgomery reduction:
+	// q⁻¹ mod 2⁶⁴ = 2³² + 1, so m = lo + (lo << 32) and
+	// the high word of m * q is m - (m >> 32) - (carry of the previous addition).
+	// The result hi - (m * q) / 2⁶⁴ is in (-q, q) and we add q if negative.
+	_ = f.Define("mul_g", 13, func(args ...any) {
+		a := args[0]
+		b := args[1]
+		into := args[2]
+		t0 := args[3]
+		t1 := args[4]
+		t2 := args[5]
+		t3 := args[6]
+		t4 := args[7]
+		t5 := args[8]
+		t6 := args[9]
+		q := args[10]
+		eps := args[11]
+		ones := args[12]
+
+		f.VPSRLQ("$32", a, t0) // a_hi
+		f.VPSRLQ("$32", b, t1) // b_hi
+
+		// VPMULUDQ conveniently ignores the high 32 bits of each QWORD lane
+		f.VPMULUDQ(a, b, t2)   // lo * lo
+		f.VPMULUDQ(a, t1, t3)  // lo * hi
+		f.VPMULUDQ(t0, b, t4)  // hi * lo
+		f.VPMULUDQ(t0, t1, t5) // hi * hi
+
+		f.VPSRLQ("$32", t2, t6)
+		f.VPADDQ(t6, t4, t4) // mid = hi*lo + (lo*lo >> 32)
+		f.VPANDQ(eps, t4, t6)
+		f.VPADDQ(t6, t3, t3) // mid2 = lo*hi + (mid & 0xffffffff)
+		f.VPSRLQ("$32", t4, t6)
+		f.VPADDQ(t6, t5, t5)
+		f.VPSRLQ("$32", t3, t6)
+		f.VPADDQ(t6, t5, t5) // hi = hi*hi + (mid >> 32) + (mid2 >> 32)
+		f.VPSLLQ("$32", t3, t6)
+		f.VPBLENDMD(t2, t6, t2, amd64.K3) // lo = (mid2 << 32) | (lo*lo & 0xffffffff)
+
+		// Montgomery reduction
+		f.VPSLLQ("$32", t2, t6)
+		f.VPADDQ(t6, t2, t0)           // m = lo + (lo << 32)
+		f.VPCMPUQ(1, t2, t0, amd64.K4) // carry
+		f.VPSRLQ("$32", t0, t6)
+		f.VPSUBQ(t6, t0, t0)
+		f.VPADDQk(ones, t0, t0, amd64.K4) // t = m - (m >> 32) - carry
+		f.VPCMPUQ(1, t0, t5, amd64.K4)    // borrow
+		f.VPSUBQ(t0, t5, into)            // hi - t
+		f.VPADDQk(q, into, into, amd64.K4)
+	})
+}
+
+// goldilocksHelper wraps the Goldilocks macros with a fixed set of constant
+// and scratch registers.
+type goldilocksHelper struct {
+	*FFAmd64
+	q, eps, ones amd64.VectorRegister
+	t            []amd64.VectorRegister // 7 scratch registers
+}
+
+func (f *FFAmd64) newGoldilocksHelper(registers *amd64.Registers) *goldilocksHelper {
+	g := &goldilocksHelper{
+		FFAmd64: f,
+		q:       registers.PopV(),
+		eps:     registers.PopV(),
+		ones:    registers.PopV(),
+		t:       registers.PopVN(7),
+	}
+	loadGoldilocks, _ := f.DefineFn("load_goldilocks")
+	loadGoldilocks(g.q, g.eps, g.ones)
+	return g
+}
+
+// sameWidth returns a function that converts a register to the same width as r.
+func sameWidth(r amd64.VectorRegister) func(amd64.VectorRegister) amd64.VectorRegister {
+	switch r[0] {
+	case 'Y':
+		return amd64.VectorRegister.Y
+	case 'X':
+		return amd64.VectorRegister.X
+	}
+	return amd64.VectorRegister.Z
+}
+
+func (g *goldilocksHelper) add(a, b, into amd64.VectorRegister) {
+	w := sameWidth(a)
+	g.CallDefine("add_g", a, b, into, w(g.t[5]), w(g.t[6]), w(g.q), w(g.eps))
+}
+
+func (g *goldilocksHelper) sub(a, b, into amd64.VectorRegister) {
+	w := sameWidth(a)
+	g.CallDefine("sub_g", a, b, into, w(g.q))
+}
+
+func (g *goldilocksHelper) mul(a, b, into amd64.VectorRegister) {
+	w := sameWidth(a)
+	g.CallDefine("mul_g", a, b, into,
+		w(g.t[0]), w(g.t[1]), w(g.t[2]), w(g.t[3]), w(g.t[4]), w(g.t[5]), w(g.t[6]),
+		w(g.q), w(g.eps), w(g.ones))
+}
+
+func (f *FFAmd64) generateAddVecGoldilocks() {
+	f.Comment("addVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] + b[0...n]")
+	f.Comment("n is the number of blocks of 8 elements to process")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(f.NbWords*2+4, 0, 0)
+	registers := f.FnHeader("addVec", stackSize, argSize, amd64.AX)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	addrA := registers.Pop()
+	addrB := registers.Pop()
+	addrRes := registers.Pop()
+	len := registers.Pop()
+
+	g := f.newGoldilocksHelper(&registers)
+	a := registers.PopV()
+	b := registers.PopV()
+
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", len)
+
+	f.Loop(len, func() {
+		f.VMOVDQU64(addrA.At(0), a)
+		f.VMOVDQU64(addrB.At(0), b)
+		g.add(a, b, a)
+		f.VMOVDQU64(a, addrRes.At(0), "res = a + b")
+
+		f.Comment("increment pointers to visit next element")
+		f.ADDQ("$64", addrA)
+		f.ADDQ("$64", addrB)
+		f.ADDQ("$64", addrRes)
+	})
+
+	f.RET()
+
+	f.Push(&registers, addrA, addrB, addrRes, len)
+}
+
+func (f *FFAmd64) generateSubVecGoldilocks() {
+	f.Comment("subVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] - b[0...n]")
+	f.Comment("n is the number of blocks of 8 elements to process")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(f.NbWords*2+4, 0, 0)
+	registers := f.FnHeader("subVec", stackSize, argSize, amd64.AX)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	addrA := registers.Pop()
+	addrB := registers.Pop()
+	addrRes := registers.Pop()
+	len := registers.Pop()
+
+	g := f.newGoldilocksHelper(&registers)
+	a := registers.PopV()
+	b := registers.PopV()
+
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", len)
+
+	f.Loop(len, func() {
+		f.VMOVDQU64(addrA.At(0), a)
+		f.VMOVDQU64(addrB.At(0), b)
+		g.sub(a, b, a)
+		f.VMOVDQU64(a, addrRes.At(0), "res = a - b")
+
+		f.Comment("increment pointers to visit next element")
+		f.ADDQ("$64", addrA)
+		f.ADDQ("$64", addrB)
+		f.ADDQ("$64", addrRes)
+	})
+
+	f.RET()
+
+	f.Push(&registers, addrA, addrB, addrRes, len)
+}
+
+// sumVec t[0...8] = Σ a[8i...8i+8]
+func (f *FFAmd64) generateSumVecGoldilocks() {
+	f.Comment("sumVec(t *Element, a *Element, n uint64) t[0...8] = sum(a[0...n])")
+	f.Comment("n is the number of blocks of 8 elements to process")
+	f.Comment("the caller needs to add the 8 reduced accumulators")
+
+	const argSize = 3 * 8
+	stackSize := f.StackSize(f.NbWords*3+2, 0, 0)
+	registers := f.FnHeader("sumVec", stackSize, argSize, amd64.AX)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	addrA := registers.Pop()
+	addrT := registers.Pop()
+	len := registers.Pop()
+
+	g := f.newGoldilocksHelper(&registers)
+	a := registers.PopV()
+	acc := registers.PopV()
+
+	f.MOVQ("t+0(FP)", addrT)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("n+16(FP)", len)
+
+	f.VPXORQ(acc, acc, acc, "acc = 0")
+
+	f.Loop(len, func() {
+		f.VMOVDQU64(addrA.At(0), a)
+		g.add(acc, a, acc)
+
+		f.Comment("increment pointers to visit next element")
+		f.ADDQ("$64", addrA)
+	})
+
+	f.VMOVDQU64(acc, addrT.At(0), "t = acc")
+
+	f.RET()
+
+	f.Push(&registers, addrA, addrT, len)
+}
+
+// mulVec res = a * b, scalarMulVec res = a * b[0]
+func (f *FFAmd64) generateMulVecGoldilocks(funcName string) {
+	scalarMul := funcName == "scalarMulVec"
+	if scalarMul {
+		f.Comment("scalarMulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b")
+	} else {
+		f.Comment("mulVec(res, a, b *Element, n uint64) res[0...n] = a[0...n] * b[0...n]")
+	}
+	f.Comment("n is the number of blocks of 8 elements to process")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(f.NbWords*2+4, 0, 0)
+	registers := f.FnHeader(funcName, stackSize, argSize, amd64.AX)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	addrA := registers.Pop()
+	addrB := registers.Pop()
+	addrRes := registers.Pop()
+	len := registers.Pop()
+
+	g := f.newGoldilocksHelper(&registers)
+	a := registers.PopV()
+	b := registers.PopV()
+
+	f.MOVQ("res+0(FP)", addrRes)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", len)
+
+	if scalarMul {
+		f.VPBROADCASTQ(addrB.At(0), b)
+	}
+
+	f.Loop(len, func() {
+		f.VMOVDQU64(addrA.At(0), a)
+		if !scalarMul {
+			f.VMOVDQU64(addrB.At(0), b)
+		}
+		g.mul(a, b, a)
+		f.VMOVDQU64(a, addrRes.At(0), "res = a * b")
+
+		f.Comment("increment pointers to visit next element")
+		f.ADDQ("$64", addrA)
+		if !scalarMul {
+			f.ADDQ("$64", addrB)
+		}
+		f.ADDQ("$64", addrRes)
+	})
+
+	f.RET()
+
+	f.Push(&registers, addrA, addrB, addrRes, len)
+}
+
+// innerProdVec t[0...8] = Σ a[8i...8i+8] * b[8i...8i+8]
+func (f *FFAmd64) generateInnerProdVecGoldilocks() {
+	f.Comment("innerProdVec(t *Element, a, b *Element, n uint64) t[0...8] = sum(a[0...n] * b[0...n])")
+	f.Comment("n is the number of blocks of 8 elements to process")
+	f.Comment("the caller needs to add the 8 reduced accumulators")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(f.NbWords*4+2, 0, 0)
+	registers := f.FnHeader("innerProdVec", stackSize, argSize, amd64.AX)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	addrA := registers.Pop()
+	addrB := registers.Pop()
+	addrT := registers.Pop()
+	len := registers.Pop()
+
+	g := f.newGoldilocksHelper(&registers)
+	a := registers.PopV()
+	b := registers.PopV()
+	acc := registers.PopV()
+
+	f.MOVQ("t+0(FP)", addrT)
+	f.MOVQ("a+8(FP)", addrA)
+	f.MOVQ("b+16(FP)", addrB)
+	f.MOVQ("n+24(FP)", len)
+
+	f.VPXORQ(acc, acc, acc, "acc = 0")
+
+	f.Loop(len, func() {
+		f.VMOVDQU64(addrA.At(0), a)
+		f.VMOVDQU64(addrB.At(0), b)
+		g.mul(a, b, a)
+		g.add(acc, a, acc)
+
+		f.Comment("increment pointers to visit next element")
+		f.ADDQ("$64", addrA)
+		f.ADDQ("$64", addrB)
+	})
+
+	f.VMOVDQU64(acc, addrT.At(0), "t = acc")
+
+	f.RET()
+
+	f.Push(&registers, addrA, addrB, addrT, len)
+}
+
+// generateFFTInnerGoldilocks generates the butterflies with twiddles of one FFT stage.
+func (f *FFAmd64) generateFFTInnerGoldilocks(dif bool) {
+	name := "innerDITWithTwiddles_avx512"
+	if dif {
+		name = "innerDIFWithTwiddles_avx512"
+		// for i := 0; i < 8n; i++ {
+		// 	Butterfly(&a[i], &a[i+m])
+		// 	a[i+m].Mul(&a[i+m], &twiddles[i])
+		// }
+		f.Comment("innerDIFWithTwiddles_avx512(a, twiddles *Element, n, m int)")
+	} else {
+		// for i := 0; i < 8n; i++ {
+		// 	a[i+m].Mul(&a[i+m], &twiddles[i])
+		// 	Butterfly(&a[i], &a[i+m])
+		// }
+		f.Comment("innerDITWithTwiddles_avx512(a, twiddles *Element, n, m int)")
+	}
+	f.Comment("n is the number of blocks of 8 butterflies to process")
+
+	const argSize = 4 * 8
+	stackSize := f.StackSize(f.NbWords*2+4, 0, 0)
+	registers := f.FnHeader(name, stackSize, argSize, amd64.AX)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	addrA := registers.Pop()
+	addrTwiddles := registers.Pop()
+	len := registers.Pop()
+	addrAPlusM := registers.Pop()
+
+	g := f.newGoldilocksHelper(&registers)
+	a := registers.PopV()
+	am := registers.PopV()
+	tw := registers.PopV()
+	b0 := registers.PopV()
+
+	f.MOVQ("a+0(FP)", addrA)
+	f.MOVQ("twiddles+8(FP)", addrTwiddles)
+	f.MOVQ("n+16(FP)", len)
+	f.MOVQ("m+24(FP)", addrAPlusM)
+
+	f.SHLQ("$3", addrAPlusM, "offset = m * 8bytes")
+	f.ADDQ(addrA, addrAPlusM)
+
+	f.Loop(len, func() {
+		f.VMOVDQU64(addrA.At(0), a, "load a[i]")
+		f.VMOVDQU64(addrAPlusM.At(0), am, "load a[i+m]")
+		f.VMOVDQU64(addrTwiddles.At(0), tw, "load twiddles[i]")
+
+		if !dif {
+			g.mul(am, tw, am)
+		}
+		g.add(a, am, b0)
+		g.sub(a, am, am)
+		if dif {
+			g.mul(am, tw, am)
+		}
+
+		f.VMOVDQU64(b0, addrA.At(0), "store a[i]")
+		f.VMOVDQU64(am, addrAPlusM.At(0), "store a[i+m]")
+
+		f.ADDQ("$64", addrA)
+		f.ADDQ("$64", addrAPlusM)
+		f.ADDQ("$64", addrTwiddles)
+	})
+
+	f.RET()
+
+	f.Push(&registers, addrA, addrTwiddles, len, addrAPlusM)
+}
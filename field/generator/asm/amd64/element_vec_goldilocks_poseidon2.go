@@ -0,0 +1,214 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package amd64
+
+import (
+	"fmt"
+
+	"github.com/consensys/bavard/amd64"
+)
+
+// generatePoseidon2Goldilocks generates permutation8_avx512 or permutation12_avx512.
+//
+// The state is kept in b0 (lanes 0..7) and, for width 12, in the Y register b1 (lanes 8..11).
+// The external matrix circ(2M4, M4, ...) works on blocks of 4 lanes, which
+// map to the 256bit halves of the registers.
+func (f *FFAmd64) generatePoseidon2Goldilocks(params Poseidon2Parameters) {
+	fullRounds := params.FullRounds
+	partialRounds := params.PartialRounds
+	width := params.Width
+	rf := fullRounds / 2
+
+	if width != 8 && width != 12 {
+		panic("only width 8 and 12 are supported")
+	}
+	if params.SBoxDegree != 7 {
+		panic("only SBox degree 7 is supported")
+	}
+	width12 := width == 12
+	fnName := fmt.Sprintf("permutation%d_avx512", width)
+
+	f.Comment(fmt.Sprintf("%s(input []fr.Element, roundKeys [][]fr.Element)", fnName))
+
+	const argSize = 2 * 3 * 8
+	stackSize := f.StackSize(f.NbWords*2+4, 1, 0)
+	registers := f.FnHeader(fnName, stackSize, argSize, amd64.AX, amd64.DX)
+	defer f.AssertCleanStack(stackSize, 0)
+
+	addrInput := registers.Pop()
+	addrRoundKeys := registers.Pop()
+	addrDiagonal := registers.Pop()
+	rKey := registers.Pop()
+
+	g := f.newGoldilocksHelper(&registers)
+
+	// state
+	b0 := registers.PopV()
+	b1 := registers.PopV().Y()
+
+	// temporary registers
+	v0 := registers.PopV()
+	v1 := registers.PopV()
+	s0 := registers.PopV()
+	s1 := registers.PopV()
+	s2 := registers.PopV()
+	acc := registers.PopV()
+
+	// diagonal of the internal matrix
+	d0 := registers.PopV()
+	d1 := registers.PopV().Y()
+
+	// K1 selects the first lane (partial rounds)
+	// K2 selects the lanes 0 and 2 of each block of 4 (matMulM4)
+	f.MOVQ(uint64(0x1), amd64.AX)
+	f.KMOVQ(amd64.AX, amd64.K1)
+	f.MOVQ(uint64(0x55), amd64.AX)
+	f.KMOVQ(amd64.AX, amd64.K2)
+
+	f.MOVQ("input+0(FP)", addrInput)
+	f.MOVQ("roundKeys+24(FP)", addrRoundKeys)
+
+	f.VMOVDQU64(addrInput.At(0), b0)
+	if width12 {
+		f.VMOVDQU64(addrInput.At(8), b1)
+		f.MOVQ("·diag12+0(SB)", addrDiagonal)
+		f.VMOVDQU64(addrDiagonal.At(0), d0)
+		f.VMOVDQU64(addrDiagonal.At(8), d1)
+	} else {
+		f.MOVQ("·diag8+0(SB)", addrDiagonal)
+		f.VMOVDQU64(addrDiagonal.At(0), d0)
+	}
+
+	// matMulM4 multiplies each block of 4 lanes of x by
+	// (5 7 1 3)
+	// (4 6 1 1)
+	// (1 3 5 7)
+	// (1 1 4 6)
+	// following the addition chain of https://eprint.iacr.org/2023/323.pdf appendix B:
+	// u = [s0+s1, s0+s1, s2+s3, s2+s3] = [t0, t0, t1, t1]
+	// w = [2s3+t0, 2s1+t1, 2s1+t1, 2s3+t0] = [t3, t2, t2, t3]
+	// z = 4u + [t2, t2, t3, t3] = [t5, t5, t4, t4]
+	// x = z + [t3, 0, t2, 0] = [t3+t5, t5, t2+t4, t4]
+	matMulM4 := func(x amd64.VectorRegister) {
+		w := sameWidth(x)
+		u, d, t := w(s0), w(s1), w(s2)
+		f.VPERMQ(uint64(0b10_11_00_01), x, t)
+		g.add(x, t, u)
+		g.add(x, x, d)
+		f.VPERMQ(uint64(0b11_01_01_11), d, d)
+		f.VPERMQ(uint64(0b00_10_10_00), u, t)
+		g.add(d, t, d)
+		g.add(u, u, u)
+		g.add(u, u, u)
+		f.VPERMQ(uint64(0b00_00_01_01), d, t)
+		g.add(u, t, u)
+		g.add(u, d, x)
+		f.VPBLENDMQ(x, u, x, amd64.K2)
+	}
+
+	matMulExternal := func() {
+		matMulM4(b0)
+		if width12 {
+			matMulM4(b1)
+		}
+		// acc = Σ blocks of 4
+		f.VEXTRACTI64X4(1, b0, acc.Y())
+		g.add(acc.Y(), b0.Y(), acc.Y())
+		if width12 {
+			g.add(acc.Y(), b1, acc.Y())
+			g.add(b1, acc.Y(), b1)
+		}
+		f.VINSERTI64X4(1, acc.Y(), acc, acc)
+		g.add(b0, acc, b0)
+	}
+
+	// x = x⁷
+	sbox := func(x amd64.VectorRegister) {
+		w := sameWidth(x)
+		x2, x4 := w(v0), w(v1)
+		g.mul(x, x, x2)
+		g.mul(x2, x2, x4)
+		g.mul(x, x2, x)
+		g.mul(x, x4, x)
+	}
+
+	fullRound := func() {
+		f.VMOVDQU64(rKey.At(0), s0)
+		g.add(b0, s0, b0)
+		if width12 {
+			f.VMOVDQU64(rKey.At(8), s0.Y())
+			g.add(b1, s0.Y(), b1)
+		}
+		sbox(b0)
+		if width12 {
+			sbox(b1)
+		}
+		matMulExternal()
+	}
+
+	partialRound := func() {
+		// add the round key to the first lane and apply the sbox
+		f.VMOVQ(rKey.At(0), s0.X())
+		g.add(b0.X(), s0.X(), s2.X())
+		sbox(s2.X())
+		f.VPBLENDMQ(s2, b0, b0, amd64.K1)
+
+		// acc = Σ lanes, broadcast
+		f.VEXTRACTI64X4(1, b0, acc.Y())
+		g.add(acc.Y(), b0.Y(), acc.Y())
+		if width12 {
+			g.add(acc.Y(), b1, acc.Y())
+		}
+		f.VEXTRACTI64X2(1, acc.Y(), s0.X())
+		g.add(acc.X(), s0.X(), acc.X())
+		f.VPSHUFD(uint64(0x4e), acc.X(), s0.X())
+		g.add(acc.X(), s0.X(), acc.X())
+		f.VPBROADCASTQ(acc.X(), acc)
+
+		// x = x * diag + Σ
+		g.mul(b0, d0, b0)
+		g.add(b0, acc, b0)
+		if width12 {
+			g.mul(b1, d1, b1)
+			g.add(b1, acc.Y(), b1)
+		}
+	}
+
+	matMulExternal()
+
+	for i := 0; i < rf; i++ {
+		f.MOVQ(addrRoundKeys.At(i*3), rKey)
+		fullRound()
+	}
+
+	f.Comment("loop over the partial rounds")
+	{
+		n := registers.Pop()
+		addrRoundKeys2 := registers.Pop()
+		f.MOVQ(partialRounds, n, fmt.Sprintf("nb partial rounds --> %d", partialRounds))
+		f.MOVQ(addrRoundKeys, addrRoundKeys2)
+		f.ADDQ(rf*24, addrRoundKeys2)
+
+		f.Loop(n, func() {
+			f.MOVQ(addrRoundKeys2.At(0), rKey)
+			partialRound()
+			f.ADDQ("$24", addrRoundKeys2)
+		})
+		f.Push(&registers, n, addrRoundKeys2)
+	}
+
+	for i := rf + partialRounds; i < fullRounds+partialRounds; i++ {
+		f.MOVQ(addrRoundKeys.At(i*3), rKey)
+		fullRound()
+	}
+
+	f.VMOVDQU64(b0, addrInput.At(0))
+	if width12 {
+		f.VMOVDQU64(b1, addrInput.At(8))
+	}
+
+	f.RET()
+
+	f.Push(&registers, addrInput, addrRoundKeys, addrDiagonal, rKey)
+}
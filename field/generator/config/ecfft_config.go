@@ -0,0 +1,291 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package config
+
+import (
+	"errors"
+	"math/big"
+	"math/rand/v2"
+)
+
+// ECFFT holds the parameters of the evaluation domains of the ECFFT [BCKL21] over 𝔽ₚ:
+// an elliptic curve E: y² = x³ + A·x + B, a point G of order 2^LogSize and a point R
+// such that 2R ∉ ⟨G⟩. The evaluation domain of size 2^LogSize is the set of the
+// x-coordinates of the coset R + ⟨G⟩; the smaller domains use the subgroups of ⟨G⟩.
+//
+// The values are decimal strings.
+//
+// [BCKL21]: https://arxiv.org/abs/2107.08473
+type ECFFT struct {
+	LogSize int
+	A, B    string
+	Gx, Gy  string
+	Rx, Ry  string
+}
+
+// NewECFFTConfig searches for ECFFT parameters over 𝔽ₚ, for a domain of size 2^logSize.
+//
+// The search is deterministic; its expected cost grows as 2^logSize.
+func NewECFFTConfig(modulus *big.Int, logSize int) (*ECFFT, error) {
+	if logSize < 1 || logSize > 32 {
+		return nil, errors.New("ecfft: logSize must be between 1 and 32")
+	}
+	if modulus.BitLen() < 2*logSize+8 || !modulus.ProbablyPrime(20) {
+		return nil, errors.New("ecfft: the modulus must be a large enough prime")
+	}
+	p := new(big.Int).Set(modulus)
+	rng := rand.New(rand.NewChaCha8([32]byte{'e', 'c', 'f', 'f', 't'}))
+
+	const maxTrials = 1 << 40
+	for trial := uint64(0); trial < maxTrials; trial++ {
+		// y² = x·(x - e₁)·(x - e₂) has all its 2-torsion points rational
+		c := &ecfftCurve{p: p}
+		c.e[1] = randomElement(rng, p)
+		c.e[2] = randomElement(rng, p)
+		if c.e[1].Sign() == 0 || c.e[2].Sign() == 0 || c.e[1].Cmp(c.e[2]) == 0 {
+			continue
+		}
+		c.e[0] = new(big.Int)
+		c.init()
+
+		g := c.pointOfOrder(logSize)
+		if g == nil {
+			continue
+		}
+		r := c.cosetShift(rng, g, logSize)
+		if r == nil {
+			continue
+		}
+		return c.shortWeierstrass(logSize, g, r), nil
+	}
+	return nil, errors.New("ecfft: no curve found")
+}
+
+// ecfftCurve is the curve y² = (x - e₀)·(x - e₁)·(x - e₂) = x³ + a₂·x² + a₄·x + a₆ over 𝔽ₚ;
+// the point at infinity is nil.
+type ecfftCurve struct {
+	p          *big.Int
+	e          [3]*big.Int
+	a2, a4, a6 *big.Int
+}
+
+type ecfftPoint struct {
+	x, y *big.Int
+}
+
+func (c *ecfftCurve) init() {
+	e0, e1, e2 := c.e[0], c.e[1], c.e[2]
+	c.a2 = c.mod(new(big.Int).Neg(new(big.Int).Add(e0, new(big.Int).Add(e1, e2))))
+	c.a4 = c.mod(new(big.Int).Add(new(big.Int).Mul(e0, e1), new(big.Int).Add(new(big.Int).Mul(e0, e2), new(big.Int).Mul(e1, e2))))
+	c.a6 = c.mod(new(big.Int).Neg(new(big.Int).Mul(e0, new(big.Int).Mul(e1, e2))))
+}
+
+func (c *ecfftCurve) mod(x *big.Int) *big.Int {
+	return x.Mod(x, c.p)
+}
+
+func (c *ecfftCurve) isSquare(x *big.Int) bool {
+	return big.Jacobi(x, c.p) == 1
+}
+
+// f returns x³ + a₂·x² + a₄·x + a₆
+func (c *ecfftCurve) f(x *big.Int) *big.Int {
+	r := new(big.Int).Add(x, c.a2)
+	r.Mul(r, x).Add(r, c.a4)
+	r.Mul(r, x).Add(r, c.a6)
+	return c.mod(r)
+}
+
+func (c *ecfftCurve) add(p, q *ecfftPoint) *ecfftPoint {
+	if p == nil {
+		return q
+	}
+	if q == nil {
+		return p
+	}
+	var lambda *big.Int
+	if p.x.Cmp(q.x) == 0 {
+		if new(big.Int).Add(p.y, q.y).Cmp(c.p) == 0 || (p.y.Sign() == 0 && q.y.Sign() == 0) {
+			return nil
+		}
+		// λ = (3x² + 2a₂x + a₄) / 2y
+		num := new(big.Int).Mul(p.x, big.NewInt(3))
+		num.Add(num, new(big.Int).Lsh(c.a2, 1)).Mul(num, p.x).Add(num, c.a4)
+		den := new(big.Int).Lsh(p.y, 1)
+		lambda = c.mod(num.Mul(num, den.ModInverse(c.mod(den), c.p)))
+	} else {
+		num := new(big.Int).Sub(q.y, p.y)
+		den := c.mod(new(big.Int).Sub(q.x, p.x))
+		lambda = c.mod(num.Mul(num, den.ModInverse(den, c.p)))
+	}
+	// x₃ = λ² - a₂ - x₁ - x₂, y₃ = λ·(x₁ - x₃) - y₁
+	x := new(big.Int).Mul(lambda, lambda)
+	x.Sub(x, c.a2).Sub(x, p.x).Sub(x, q.x)
+	c.mod(x)
+	y := new(big.Int).Sub(p.x, x)
+	y.Mul(y, lambda).Sub(y, p.y)
+	return &ecfftPoint{x: x, y: c.mod(y)}
+}
+
+func (c *ecfftCurve) neg(p *ecfftPoint) *ecfftPoint {
+	if p == nil {
+		return nil
+	}
+	return &ecfftPoint{x: p.x, y: c.mod(new(big.Int).Neg(p.y))}
+}
+
+// double2k returns 2ᵏ·p
+func (c *ecfftCurve) double2k(p *ecfftPoint, k int) *ecfftPoint {
+	for i := 0; i < k && p != nil; i++ {
+		p = c.add(p, p)
+	}
+	return p
+}
+
+// isHalvable returns true if p ∈ 2·E(𝔽ₚ), using the 2-descent map
+// p ↦ (x - e₀, x - e₁, x - e₂) ∈ (𝔽ₚˣ/𝔽ₚˣ²)³
+func (c *ecfftCurve) isHalvable(p *ecfftPoint) bool {
+	for i := range c.e {
+		d := c.mod(new(big.Int).Sub(p.x, c.e[i]))
+		if d.Sign() == 0 {
+			// p = (eᵢ, 0), the i-th component is the product of the two others
+			d.SetInt64(1)
+			for j := range c.e {
+				if j != i {
+					d.Mul(d, new(big.Int).Sub(p.x, c.e[j]))
+				}
+			}
+			c.mod(d)
+		}
+		if !c.isSquare(d) {
+			return false
+		}
+	}
+	return true
+}
+
+// halves returns the points q such that 2q = p, p must be halvable
+func (c *ecfftCurve) halves(p *ecfftPoint) []*ecfftPoint {
+	var r [3]*big.Int
+	for i := range c.e {
+		r[i] = new(big.Int).ModSqrt(c.mod(new(big.Int).Sub(p.x, c.e[i])), c.p)
+		if r[i] == nil {
+			return nil
+		}
+	}
+	// x(q) = x(p) + r₀r₁ + r₀r₂ + r₁r₂ for the choices of signs of the square roots rᵢ of x(p) - eᵢ
+	var res []*ecfftPoint
+	for signs := 0; signs < 4; signs++ {
+		var s [3]*big.Int
+		for i := range s {
+			s[i] = new(big.Int).Set(r[i])
+			if i < 2 && signs>>i&1 == 1 {
+				s[i].Neg(s[i])
+			}
+		}
+		x := new(big.Int).Mul(s[0], s[1])
+		x.Add(x, new(big.Int).Mul(s[0], s[2])).Add(x, new(big.Int).Mul(s[1], s[2])).Add(x, p.x)
+		c.mod(x)
+		y := new(big.Int).ModSqrt(c.f(x), c.p)
+		if y == nil {
+			continue
+		}
+		for _, q := range []*ecfftPoint{{x: x, y: y}, c.neg(&ecfftPoint{x: x, y: y})} {
+			if d := c.add(q, q); d != nil && d.x.Cmp(p.x) == 0 && d.y.Cmp(p.y) == 0 {
+				res = append(res, q)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// pointOfOrder returns a point of order 2ᵏ, or nil if it doesn't find one
+func (c *ecfftCurve) pointOfOrder(k int) *ecfftPoint {
+	for i := range c.e {
+		p := &ecfftPoint{x: c.e[i], y: new(big.Int)}
+		for order := 1; order < k; order++ {
+			if !c.isHalvable(p) {
+				p = nil
+				break
+			}
+			var next *ecfftPoint
+			for _, q := range c.halves(p) {
+				if order+1 == k || c.isHalvable(q) {
+					next = q
+					break
+				}
+			}
+			p = next
+			if p == nil {
+				break
+			}
+		}
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// cosetShift returns a point r such that 2r ∉ ⟨g⟩ and such that the coset r + ⟨g⟩
+// doesn't contain a point of x-coordinate 0 in the short Weierstrass model
+func (c *ecfftCurve) cosetShift(rng *rand.Rand, g *ecfftPoint, k int) *ecfftPoint {
+	// the x-coordinate 0 in the short Weierstrass model is -a₂/3
+	third := new(big.Int).ModInverse(big.NewInt(3), c.p)
+	x0 := c.mod(new(big.Int).Neg(new(big.Int).Mul(c.a2, third)))
+	var zeros []*ecfftPoint
+	if y0 := new(big.Int).ModSqrt(c.f(x0), c.p); y0 != nil {
+		z := &ecfftPoint{x: x0, y: y0}
+		zeros = append(zeros, z, c.neg(z))
+	}
+
+	for trial := 0; trial < 64; trial++ {
+		x := randomElement(rng, c.p)
+		y := new(big.Int).ModSqrt(c.f(x), c.p)
+		if y == nil || y.Sign() == 0 {
+			continue
+		}
+		r := &ecfftPoint{x: x, y: y}
+		// 2ᵏ·q ≠ 0 implies q ∉ ⟨g⟩
+		ok := c.double2k(c.add(r, r), k) != nil
+		for _, z := range zeros {
+			ok = ok && c.double2k(c.add(z, c.neg(r)), k) != nil
+		}
+		if ok {
+			return r
+		}
+	}
+	return nil
+}
+
+// shortWeierstrass returns the parameters in the model y² = x³ + A·x + B, with x ↦ x + a₂/3
+func (c *ecfftCurve) shortWeierstrass(k int, g, r *ecfftPoint) *ECFFT {
+	third := new(big.Int).ModInverse(big.NewInt(3), c.p)
+	shift := c.mod(new(big.Int).Mul(c.a2, third))
+
+	// A = a₄ - a₂²/3, B = 2a₂³/27 - a₂a₄/3 + a₆
+	A := new(big.Int).Mul(c.a2, shift)
+	A.Sub(c.a4, A)
+	B := new(big.Int).Mul(shift, shift)
+	B.Mul(B, shift).Lsh(B, 1)
+	B.Sub(B, new(big.Int).Mul(shift, c.a4)).Add(B, c.a6)
+
+	convert := func(p *ecfftPoint) (string, string) {
+		return c.mod(new(big.Int).Add(p.x, shift)).String(), p.y.String()
+	}
+	res := &ECFFT{LogSize: k, A: c.mod(A).String(), B: c.mod(B).String()}
+	res.Gx, res.Gy = convert(g)
+	res.Rx, res.Ry = convert(r)
+	return res
+}
+
+func randomElement(rng *rand.Rand, p *big.Int) *big.Int {
+	b := make([]byte, (p.BitLen()+7)/8+8)
+	for i := range b {
+		b[i] = byte(rng.Uint32())
+	}
+	r := new(big.Int).SetBytes(b)
+	return r.Mod(r, p)
+}
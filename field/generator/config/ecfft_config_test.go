@@ -0,0 +1,50 @@
+package config
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestECFFTConfig(t *testing.T) {
+	const logSize = 8
+	// secp256k1 scalar field
+	p, _ := new(big.Int).SetString("115792089237316195423570985008687907852837564279074904382605163141518161494337", 10)
+	cfg, err := NewECFFTConfig(p, logSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	setString := func(s string) *big.Int {
+		r, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			t.Fatal("invalid decimal string")
+		}
+		return r
+	}
+	// y² = x³ + A·x + B
+	c := &ecfftCurve{p: p, a2: new(big.Int), a4: setString(cfg.A), a6: setString(cfg.B)}
+	g := &ecfftPoint{x: setString(cfg.Gx), y: setString(cfg.Gy)}
+	r := &ecfftPoint{x: setString(cfg.Rx), y: setString(cfg.Ry)}
+	for _, q := range []*ecfftPoint{g, r} {
+		y2 := new(big.Int).Mul(q.y, q.y)
+		if c.mod(y2).Cmp(c.f(q.x)) != 0 {
+			t.Fatal("G and R should be on the curve")
+		}
+	}
+
+	if c.double2k(g, logSize-1) == nil || c.double2k(g, logSize) != nil {
+		t.Fatal("G should have order 2^logSize")
+	}
+	if c.double2k(c.add(r, r), logSize) == nil {
+		t.Fatal("2R should not be in ⟨G⟩")
+	}
+
+	// the search is deterministic
+	cfg2, err := NewECFFTConfig(p, logSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *cfg != *cfg2 {
+		t.Fatal("NewECFFTConfig should be deterministic")
+	}
+}
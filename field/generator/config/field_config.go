@@ -65,12 +65,17 @@ type Field struct {
 	Word Word // 32 iff Q < 2^32, else 64
 	F31  bool // 31 bits field
 
+	Goldilocks bool // q = 2⁶⁴ - 2³² + 1
+
 	// asm code generation
 	GenerateOpsAMD64       bool
 	GenerateOpsARM64       bool
 	GenerateVectorOpsAMD64 bool
 	GenerateVectorOpsARM64 bool
 
+	// 32-bit limbs code generation (see generator.WithLimbs32)
+	GenerateLimbs32 bool
+
 	ASMPackagePath string
 }
 
@@ -113,6 +118,7 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	// set q from big int repr
 	F.Q = toUint64Slice(&bModulus)
 	F.IsMSWSaturated = F.Q[len(F.Q)-1] == math.MaxUint64
+	F.Goldilocks = F.NbWords == 1 && F.Q[0] == 0xFFFFFFFF00000001
 	_qHalved := big.NewInt(0)
 	bOne := new(big.Int).SetUint64(1)
 	_qHalved.Sub(&bModulus, bOne).Rsh(_qHalved, 1).Add(_qHalved, bOne)
@@ -298,14 +304,16 @@ func NewFieldConfig(packageName, elementName, modulus string, useAddChain bool)
 	// note: to simplify output files generated, we generated ASM code only for
 	// moduli that meet the condition F.NoCarry
 	// asm code generation for moduli with more than 6 words can be optimized further
-	F.GenerateOpsAMD64 = F.F31 || (F.NoCarry && F.NbWords <= 12 && F.NbWords > 1)
+	// the Goldilocks field has a dedicated AVX512 backend for vector ops only;
+	// scalar ops stay in pure Go.
+	F.GenerateOpsAMD64 = F.F31 || F.Goldilocks || (F.NoCarry && F.NbWords <= 12 && F.NbWords > 1)
 	if F.NbWords == 4 && F.GenerateOpsAMD64 && F.NbBits <= 225 {
 		// 4 words field with 225 bits or less have no vector ops
 		// for now since we generate both in same file we disable
 		// TODO @gbotrel
 		F.GenerateOpsAMD64 = false
 	}
-	F.GenerateVectorOpsAMD64 = F.F31 || (F.GenerateOpsAMD64 && F.NbWords == 4 && F.NbBits > 225)
+	F.GenerateVectorOpsAMD64 = F.F31 || F.Goldilocks || (F.GenerateOpsAMD64 && F.NbWords == 4 && F.NbBits > 225)
 	F.GenerateOpsARM64 = F.F31 || (F.GenerateOpsAMD64 && (F.NbWords%2 == 0))
 	F.GenerateVectorOpsARM64 = F.F31
 
@@ -14,6 +14,7 @@ func GenerateFF(F *config.Field, outputDir string, options ...Option) error {
 
 	// default config
 	cfg := generatorOptions(options...)
+	F.GenerateLimbs32 = cfg.HasLimbs32() && !F.F31 && !F.Goldilocks
 
 	// generate asm
 	// note: we need to do that before the fields, as the fields will include a hash of the (shared)
@@ -53,6 +54,13 @@ func GenerateFF(F *config.Field, outputDir string, options ...Option) error {
 		}
 	}
 
+	// generate ecfft
+	if cfg.HasECFFT() {
+		if err := generateECFFT(F, cfg.ecfftConfig, outputDir); err != nil {
+			return err
+		}
+	}
+
 	// generate SIS
 	if cfg.HasSIS() {
 		if err := generateSIS(F, outputDir); err != nil {
@@ -74,6 +82,13 @@ func GenerateFF(F *config.Field, outputDir string, options ...Option) error {
 		}
 	}
 
+	// generate FRI
+	if cfg.HasFRI() && F.F31 {
+		if err := generateFRI(F, outputDir); err != nil {
+			return err
+		}
+	}
+
 	return runFormatters(outputDir)
 }
 
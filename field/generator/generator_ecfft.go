@@ -0,0 +1,124 @@
+package generator
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+	eccconfig "github.com/consensys/gnark-crypto/internal/generator/config"
+)
+
+// defaultECFFTLogSize is the size of the largest ECFFT domain searched for a modulus
+// that is not in ecfftConfigs.
+const defaultECFFTLogSize = 12
+
+func generateECFFT(F *config.Field, ecfft *config.ECFFT, outputDir string) error {
+
+	if ecfft == nil || ecfft.A == "" {
+		if data, ok := ecfftConfigs[F.Modulus]; ok && (ecfft == nil || ecfft.LogSize == 0 || ecfft.LogSize <= data.LogSize) {
+			ecfft = &data
+		} else {
+			// search the parameters; this can take a while for the large sizes
+			logSize := defaultECFFTLogSize
+			if ecfft != nil && ecfft.LogSize != 0 {
+				logSize = ecfft.LogSize
+			}
+			var err error
+			if ecfft, err = config.NewECFFTConfig(F.ModulusBig, logSize); err != nil {
+				return fmt.Errorf("no ecfft config for modulus %s: %w", F.Modulus, err)
+			}
+		}
+	}
+
+	fieldImportPath, err := getImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+	data := &ecfftTemplateData{
+		ECFFT:            *ecfft,
+		FieldPackagePath: fieldImportPath,
+		FF:               F.PackageName,
+		Package:          "ecfft",
+	}
+	outputDir = filepath.Join(outputDir, "ecfft")
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(outputDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(outputDir, "ecfft.go"), Templates: []string{"ecfft.go.tmpl"}},
+		{File: filepath.Join(outputDir, "ecfft_test.go"), Templates: []string{"tests/ecfft.go.tmpl"}},
+	}
+
+	bgen := bavard.NewBatchGenerator("Consensys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	ecfftTemplatesRootDir, err := findTemplatesRootDir()
+	if err != nil {
+		return err
+	}
+	ecfftTemplatesRootDir = filepath.Join(ecfftTemplatesRootDir, "ecfft")
+
+	if err := bgen.Generate(data, "ecfft", ecfftTemplatesRootDir, entries...); err != nil {
+		return err
+	}
+
+	return runFormatters(outputDir)
+}
+
+type ecfftTemplateData struct {
+	config.ECFFT
+
+	FieldPackagePath string // path to the finite field package
+	FF               string // name of the package corresponding to the finite field
+	Package          string // package name
+}
+
+// ecfftConfigs are the precomputed ECFFT parameters (see config.NewECFFTConfig)
+var ecfftConfigs map[string]config.ECFFT
+
+func init() {
+	ecfftConfigs = make(map[string]config.ECFFT)
+
+	// grumpkin fr (= bn254 fp)
+	ecfftConfigs[eccconfig.GRUMPKIN.FrModulus] = config.ECFFT{
+		LogSize: 20,
+		A:       "5345898356124836417854137986892891506141698485931035146710647184426305283643",
+		B:       "6382134949927946934497553935537177052696697626179525225938990228597696284086",
+		Gx:      "9522076114175200913365826010455705249101634961941833020033041917788147605754",
+		Gy:      "18229980359075651317107575004345288143797847589990209590597296051461268800887",
+		Rx:      "1930019627613737044235858872457654827803142546894649182473853636379779062622",
+		Ry:      "21365817140873897150956750241738441916203115294155180849309875249546251200650",
+	}
+
+	// secp256k1 fr
+	ecfftConfigs[eccconfig.SECP256K1.FrModulus] = config.ECFFT{
+		LogSize: 20,
+		A:       "63726700192287520480124774787898602425647978511255450016029048577211211920816",
+		B:       "37420286561071111045590582897134946555792053716922791099839944780227710095911",
+		Gx:      "35401695347174439277105961155633324023194263046904679108581808771465089697038",
+		Gy:      "28547556673714001580883476798710534092474149820496142106297960355287222590098",
+		Rx:      "95201136610794709411321194710093609199847802203435382693925354725013631379872",
+		Ry:      "36608447551698613692019518109036080055997395472661073258102516641079144912249",
+	}
+
+	// secp256k1 fp
+	ecfftConfigs[eccconfig.SECP256K1.FpModulus] = config.ECFFT{
+		LogSize: 20,
+		A:       "83781209472789292316974484081398133901510278630142772644482887351738575312043",
+		B:       "36689802524594531461773292055880351639263912140113866596288866646155869920137",
+		Gx:      "49754621668978083445655172374197631219253658698650185135916774977302429316294",
+		Gy:      "65033650872641580721394002555340651481821113013817236291727244910102275547553",
+		Rx:      "33001157564401522034760800582310954317904119404699936689004318629588557483065",
+		Ry:      "46515165595104134368690649587239261107297799335491545759274946280563755483743",
+	}
+
+	// stark curve fr
+	ecfftConfigs[eccconfig.STARK_CURVE.FrModulus] = config.ECFFT{
+		LogSize: 20,
+		A:       "3456808029597725056807897360285748663015835462301220195325674442124102625254",
+		B:       "2531435064651158515719279789302949167693745187289340188839648812775354742906",
+		Gx:      "1722619699100248802075794120129685271409801406874917641162811607107734640737",
+		Gy:      "123950872815921941198993483271977252073721672701740750231271361754210712543",
+		Rx:      "2586430025916017824406270392448237045869851169176093461118014289997283816192",
+		Ry:      "1046035276119087563684275155477689470587841548624135535198184780821653493409",
+	}
+}
@@ -78,6 +78,17 @@ func generateExtensions(F *config.Field, outputDir string) error {
 		}
 		asmFile.Close()
 	}
+	if F.Goldilocks {
+		entries_ext3 := []bavard.Entry{
+			{File: filepath.Join(outputDir, "e3.go"), Templates: []string{"e3.go.tmpl"}},
+			{File: filepath.Join(outputDir, "e3_test.go"), Templates: []string{"e3_test.go.tmpl"}},
+			{File: filepath.Join(outputDir, "e6.go"), Templates: []string{"e6.go.tmpl"}},
+			{File: filepath.Join(outputDir, "e6_test.go"), Templates: []string{"e6_test.go.tmpl"}},
+		}
+		if err := bgen.GenerateWithOptions(data, "extensions", extensionsTemplatesRootDir, nil, entries_ext3...); err != nil {
+			return err
+		}
+	}
 
 	return runFormatters(outputDir)
 }
@@ -31,13 +31,14 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 		return err
 	}
 	data := &fftTemplateData{
-		FFT:              *fft,
-		FieldPackagePath: fieldImportPath,
-		FF:               F.PackageName,
-		HasASMKernel:     F.F31,
-		Kernels:          []int{5, 8},
-		Package:          "fft",
-		F31:              F.F31,
+		FFT:               *fft,
+		FieldPackagePath:  fieldImportPath,
+		FF:                F.PackageName,
+		HasASMKernel:      F.F31,
+		HasASMButterflies: F.Goldilocks,
+		Kernels:           []int{5, 8},
+		Package:           "fft",
+		F31:               F.F31,
 	}
 	outputDir = filepath.Join(outputDir, "fft")
 
@@ -46,6 +47,9 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 		pureGoBuildTag = "purego || (!amd64)"
 		data.Kernels = []int{8}
 	}
+	if data.HasASMButterflies {
+		pureGoBuildTag = "purego || (!amd64)"
+	}
 
 	entries := []bavard.Entry{
 		{File: filepath.Join(outputDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
@@ -57,9 +61,15 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 		{File: filepath.Join(outputDir, "kernel_purego.go"), Templates: []string{"kernel.purego.go.tmpl"}, BuildTag: pureGoBuildTag},
 		{File: filepath.Join(outputDir, "bitreverse.go"), Templates: []string{"bitreverse.go.tmpl"}},
 		{File: filepath.Join(outputDir, "options.go"), Templates: []string{"options.go.tmpl"}},
+		{File: filepath.Join(outputDir, "mixedradix.go"), Templates: []string{"mixedradix.go.tmpl"}},
+		{File: filepath.Join(outputDir, "mixedradix_test.go"), Templates: []string{"tests/mixedradix.go.tmpl"}},
+		{File: filepath.Join(outputDir, "outofcore.go"), Templates: []string{"outofcore.go.tmpl"}},
+		{File: filepath.Join(outputDir, "outofcore_test.go"), Templates: []string{"tests/outofcore.go.tmpl"}},
+		{File: filepath.Join(outputDir, "batch.go"), Templates: []string{"batch.go.tmpl"}},
+		{File: filepath.Join(outputDir, "batch_test.go"), Templates: []string{"tests/batch.go.tmpl"}},
 	}
 
-	if data.HasASMKernel {
+	if data.HasASMKernel || data.HasASMButterflies {
 		data.Q = F.Q[0]
 		data.QInvNeg = F.QInverse[0]
 		entries = append(entries,
@@ -76,7 +86,12 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 
 		fftKernels.WriteString("//go:build !purego\n")
 
-		if err := amd64.GenerateF31FFTKernels(fftKernels, F.NbBits, data.Kernels); err != nil {
+		if data.HasASMKernel {
+			err = amd64.GenerateF31FFTKernels(fftKernels, F.NbBits, data.Kernels)
+		} else {
+			err = amd64.GenerateGoldilocksFFTKernels(fftKernels)
+		}
+		if err != nil {
 			fftKernels.Close()
 			return err
 		}
@@ -126,13 +141,14 @@ func generateFFT(F *config.Field, fft *config.FFT, outputDir string) error {
 type fftTemplateData struct {
 	config.FFT
 
-	FieldPackagePath string // path to the finite field package
-	FF               string // name of the package corresponding to the finite field
-	HasASMKernel     bool   // indicates if the kernels have an assembly impl
-	Kernels          []int  // indicates which kernels to generate
-	Package          string // package name
-	Q, QInvNeg       uint64
-	F31              bool
+	FieldPackagePath  string // path to the finite field package
+	FF                string // name of the package corresponding to the finite field
+	HasASMKernel      bool   // indicates if the kernels have an assembly impl
+	HasASMButterflies bool   // indicates if only the butterflies with twiddles have an assembly impl
+	Kernels           []int  // indicates which kernels to generate
+	Package           string // package name
+	Q, QInvNeg        uint64
+	F31               bool
 }
 
 func findTemplatesRootDir() (string, error) {
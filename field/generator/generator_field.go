@@ -28,6 +28,8 @@ func generateField(F *config.Field, outputDir, asmDirIncludePath, hashArm64, has
 		element.Sqrt,
 		element.Inverse,
 		element.BigNum,
+		element.MulWNonModular,
+		element.MontReduceSigned,
 	}
 
 	// test file templates
@@ -141,8 +143,15 @@ func generateField(F *config.Field, outputDir, asmDirIncludePath, hashArm64, has
 		pureGoVectorBuildTag = "purego || (!amd64)"
 	}
 
-	if F.F31 {
-		pureGoBuildTag = "" // always generate pure go for F31
+	if F.F31 || F.Goldilocks {
+		pureGoBuildTag = "" // always generate pure go for F31 and Goldilocks
+	}
+
+	// the 32-bit limbs targets never use the assembly, so the 32-bit limbs files
+	// only need to be excluded from the purego ones
+	if F.GenerateLimbs32 {
+		pureGoBuildTag = andBuildTags(pureGoBuildTag, "!("+limbs32BuildTag+")")
+		pureGoVectorBuildTag = andBuildTags(pureGoVectorBuildTag, "!("+limbs32BuildTag+")")
 	}
 
 	var g errgroup.Group
@@ -157,17 +166,21 @@ func generateField(F *config.Field, outputDir, asmDirIncludePath, hashArm64, has
 	g.Go(generate("element_amd64.s", []string{element.IncludeASM}, only(F.GenerateOpsAMD64 && hashAMD64 != ""), withBuildTag("!purego"), withData(amd64d)))
 	g.Go(generate("element_arm64.s", []string{element.IncludeASM}, only(F.GenerateOpsARM64 && hashArm64 != ""), withBuildTag("!purego"), withData(arm64d)))
 
-	g.Go(generate("element_amd64.go", []string{element.OpsAMD64, element.MulDoc}, only(F.GenerateOpsAMD64 && !F.F31 && hashAMD64 != ""), withBuildTag("!purego")))
+	g.Go(generate("element_amd64.go", []string{element.OpsAMD64, element.MulDoc}, only(F.GenerateOpsAMD64 && !F.F31 && !F.Goldilocks && hashAMD64 != ""), withBuildTag("!purego")))
 	g.Go(generate("element_arm64.go", []string{element.OpsARM64, element.MulNoCarry, element.Reduce}, only(F.GenerateOpsARM64 && !F.F31 && hashArm64 != ""), withBuildTag("!purego")))
 
 	g.Go(generate("element_purego.go", []string{element.OpsNoAsm, element.MulCIOS, element.MulNoCarry, element.Reduce, element.MulDoc}, withBuildTag(pureGoBuildTag)))
+	g.Go(generate("element_limbs32.go", []string{element.OpsLimbs32}, only(F.GenerateLimbs32), withBuildTag(limbs32BuildTag)))
+	g.Go(generate("element_limbs64.go", []string{element.OpsLimbs64, element.MulWNonModular, element.MontReduceSigned, element.Reduce}, only(F.GenerateLimbs32 && F.UsingP20Inverse), withBuildTag("!("+limbs32BuildTag+")")))
 
-	g.Go(generate("vector_amd64.go", []string{element.VectorOpsAmd64}, only(F.GenerateVectorOpsAMD64 && !F.F31 && hashAMD64 != ""), withBuildTag("!purego")))
+	g.Go(generate("vector_amd64.go", []string{element.VectorOpsAmd64}, only(F.GenerateVectorOpsAMD64 && !F.F31 && !F.Goldilocks && hashAMD64 != ""), withBuildTag("!purego")))
+	g.Go(generate("vector_amd64.go", []string{element.VectorOpsAmd64Goldilocks}, only(F.GenerateVectorOpsAMD64 && F.Goldilocks && hashAMD64 != ""), withBuildTag("!purego")))
 	g.Go(generate("vector_amd64.go", []string{element.VectorOpsAmd64F31}, only(F.GenerateVectorOpsAMD64 && F.F31 && hashAMD64 != ""), withBuildTag("!purego")))
 	g.Go(generate("vector_arm64.go", []string{element.VectorOpsArm64}, only(F.GenerateVectorOpsARM64 && !F.F31 && hashArm64 != ""), withBuildTag("!purego")))
 	g.Go(generate("vector_arm64.go", []string{element.VectorOpsArm64F31}, only(F.GenerateVectorOpsARM64 && F.F31 && hashArm64 != ""), withBuildTag("!purego")))
 
 	g.Go(generate("vector_purego.go", []string{element.VectorOpsPureGo}, withBuildTag(pureGoVectorBuildTag)))
+	g.Go(generate("vector_limbs32.go", []string{element.VectorOpsLimbs32}, only(F.GenerateLimbs32), withBuildTag(limbs32BuildTag)))
 
 	if F.UseAddChain {
 		g.Go(generate("element_exp.go", []string{element.FixedExp}))
@@ -176,6 +189,17 @@ func generateField(F *config.Field, outputDir, asmDirIncludePath, hashArm64, has
 	return g.Wait()
 }
 
+// limbs32BuildTag selects the 32-bit limbs code (see WithLimbs32)
+const limbs32BuildTag = "!limbs64 && (386 || arm || mips || mipsle || wasm || (purego && limbs32))"
+
+// andBuildTags returns the conjunction of two build constraints, the empty one being true
+func andBuildTags(a, b string) string {
+	if a == "" {
+		return b
+	}
+	return "(" + a + ") && " + b
+}
+
 type fieldOption func(*fieldConfig)
 type fieldConfig struct {
 	buildTag string
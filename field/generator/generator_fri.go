@@ -0,0 +1,54 @@
+package generator
+
+import (
+	"path/filepath"
+
+	"github.com/consensys/bavard"
+	"github.com/consensys/gnark-crypto/field/generator/asm/amd64"
+	"github.com/consensys/gnark-crypto/field/generator/config"
+)
+
+func generateFRI(F *config.Field, outputDir string) error {
+
+	fieldImportPath, err := getImportPath(outputDir)
+	if err != nil {
+		return err
+	}
+
+	outputDir = filepath.Join(outputDir, "fri")
+
+	entries := []bavard.Entry{
+		{File: filepath.Join(outputDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
+		{File: filepath.Join(outputDir, "config.go"), Templates: []string{"config.go.tmpl"}},
+		{File: filepath.Join(outputDir, "merkle.go"), Templates: []string{"merkle.go.tmpl"}},
+		{File: filepath.Join(outputDir, "fri.go"), Templates: []string{"fri.go.tmpl"}},
+		{File: filepath.Join(outputDir, "fri_test.go"), Templates: []string{"fri_test.go.tmpl"}},
+	}
+
+	type friTemplateData struct {
+		FF                string
+		FieldPackagePath  string
+		ParamsCompression amd64.Poseidon2Parameters
+		ParamsSponge      amd64.Poseidon2Parameters
+	}
+
+	data := &friTemplateData{
+		FF:               F.PackageName,
+		FieldPackagePath: fieldImportPath,
+	}
+	data.ParamsCompression, data.ParamsSponge = poseidon2Parameters(data.FF)
+
+	bgen := bavard.NewBatchGenerator("Consensys Software Inc.", 2020, "consensys/gnark-crypto")
+
+	friTemplatesRootDir, err := findTemplatesRootDir()
+	if err != nil {
+		return err
+	}
+	friTemplatesRootDir = filepath.Join(friTemplatesRootDir, "fri")
+
+	if err := bgen.GenerateWithOptions(data, "fri", friTemplatesRootDir, nil, entries...); err != nil {
+		return err
+	}
+
+	return runFormatters(outputDir)
+}
@@ -74,7 +74,7 @@ func TestIntegration(t *testing.T) {
 		childDir := filepath.Join(rootDir, elementName)
 		fIntegration, err = config.NewFieldConfig("integration", elementName, modulus, false)
 		assert.NoError(err)
-		assert.NoError(GenerateFF(fIntegration, childDir, WithASM(&config.Assembly{BuildDir: asmDir, IncludeDir: asmDirIncludePath})))
+		assert.NoError(GenerateFF(fIntegration, childDir, WithASM(&config.Assembly{BuildDir: asmDir, IncludeDir: asmDirIncludePath}), WithLimbs32()))
 	}
 
 	// run go test
@@ -96,6 +96,18 @@ func TestIntegration(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// the 32-bit limbs code is also tested with GOARCH=386, on a subset of the moduli
+	limbs32Tests := map[string]bool{
+		"e_cios_0064":            true,
+		"e_cios_0256":            true,
+		"e_nocarry_0255":         true,
+		"e_nocarry_0381":         true,
+		"e_secp256k1":            true,
+		"e_nocarry_edge_1279":    true,
+		"small":                  true,
+		"small_without_no_carry": true,
+	}
+
 	errGroup := errgroup.Group{}
 
 	for _, subDir := range subDirs {
@@ -111,6 +123,21 @@ func TestIntegration(t *testing.T) {
 			}
 			return nil
 		})
+		if !limbs32Tests[filepath.Base(subDir)] {
+			continue
+		}
+		errGroup.Go(func() error {
+			cmd := exec.Command("go", "test", "-short")
+			cmd.Dir = subDir
+			cmd.Env = append(os.Environ(), "GOARCH=386")
+			var stdouterr strings.Builder
+			cmd.Stdout = &stdouterr
+			cmd.Stderr = &stdouterr
+			if err := cmd.Run(); err != nil {
+				return fmt.Errorf("go test (GOARCH=386) failed, output:\n%s\n%s", stdouterr.String(), err)
+			}
+			return nil
+		})
 	}
 
 	if err := errGroup.Wait(); err != nil {
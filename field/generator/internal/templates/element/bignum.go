@@ -18,25 +18,9 @@ func negL(x *{{.ElementName}}, xHi uint64) uint64 {
 	return xHi
 }
 
-// mulWNonModular multiplies by one word in non-montgomery, without reducing
-func (z *{{.ElementName}}) mulWNonModular(x *{{.ElementName}}, y int64) uint64 {
-
-	// w := abs(y)
-	m := y >> 63
-	w := uint64((y^m)-m)
-
-	var c uint64
-	c, z[0] = bits.Mul64(x[0], w)
-	{{- range $i := .NbWordsIndexesNoZero }}
-	c, z[{{$i}}] = madd1(x[{{$i}}], w, c)
-	{{- end}}
-
-	if y < 0 {
-		c = negL(z, c)
-	}
-
-	return c
-}
+{{- if not $.GenerateLimbs32}}
+{{ template "mulWNonModular" . }}
+{{- end}}
 
 // linearCombNonModular computes a linear combination without modular reduction
 func (z *{{.ElementName}}) linearCombNonModular(x *{{.ElementName}}, xC int64, y *{{.ElementName}}, yC int64) uint64 {
@@ -58,3 +42,29 @@ func (z *{{.ElementName}}) linearCombNonModular(x *{{.ElementName}}, xC int64, y
 
 {{- end}}
 `
+
+// MulWNonModular is the 64-bit mulWNonModular of the Pornin inverse; with the 32-bit limbs code
+// it is generated in element_limbs64.go, see OpsLimbs64.
+const MulWNonModular = `
+{{ define "mulWNonModular" }}
+// mulWNonModular multiplies by one word in non-montgomery, without reducing
+func (z *{{.ElementName}}) mulWNonModular(x *{{.ElementName}}, y int64) uint64 {
+
+	// w := abs(y)
+	m := y >> 63
+	w := uint64((y^m)-m)
+
+	var c uint64
+	c, z[0] = bits.Mul64(x[0], w)
+	{{- range $i := .NbWordsIndexesNoZero }}
+	c, z[{{$i}}] = madd1(x[{{$i}}], w, c)
+	{{- end}}
+
+	if y < 0 {
+		c = negL(z, c)
+	}
+
+	return c
+}
+{{ end }}
+`
@@ -2,21 +2,6 @@ package element
 
 const Inverse = `
 
-{{ define "addQ" }}
-if b != 0 {
-	// z[{{.NbWordsLastIndex}}] = -1
-	// negative: add q
-	const neg1 = 0xFFFFFFFFFFFFFFFF
-
-	var carry uint64
-	{{$lastIndex := sub .NbWords 1}}
-	{{- range $i :=  iterate 0 $lastIndex}}
-	z[{{$i}}], carry = bits.Add64(z[{{$i}}], q{{$i}}, {{- if eq $i 0}}0{{- else}}carry{{- end}})
-	{{- end}}
-	z[{{.NbWordsLastIndex}}], _ = bits.Add64(neg1, q{{$.NbWordsLastIndex}}, carry)
-}
-{{- end}}
-
 {{/* We use big.Int for Inverse for these type of moduli */}}
 {{if not $.UsingP20Inverse}}
 
@@ -334,6 +319,47 @@ func (z *{{.ElementName}}) linearComb(x *{{.ElementName}}, xC int64, y *{{.Eleme
 	z.montReduceSigned(z, hi)
 }
 
+{{- if not $.GenerateLimbs32}}
+{{ template "montReduceSigned" . }}
+{{- end}}
+
+const (
+	updateFactorsConversionBias int64 = 0x7fffffff7fffffff // (2³¹ - 1)(2³² + 1)
+	updateFactorIdentityMatrixRow0 = 1
+	updateFactorIdentityMatrixRow1 = 1 << 32
+)
+
+func updateFactorsDecompose(c int64) (int64, int64) {
+	c += updateFactorsConversionBias
+ 	const low32BitsFilter int64 = 0xFFFFFFFF
+ 	f := c&low32BitsFilter - 0x7FFFFFFF
+ 	g := c>>32&low32BitsFilter - 0x7FFFFFFF
+ 	return f, g
+}
+
+{{ end }}
+
+`
+
+// MontReduceSigned is the 64-bit montReduceSigned of the Pornin inverse; with the 32-bit limbs code
+// it is generated in element_limbs64.go, see OpsLimbs64.
+const MontReduceSigned = `
+{{ define "addQ" }}
+if b != 0 {
+	// z[{{.NbWordsLastIndex}}] = -1
+	// negative: add q
+	const neg1 = 0xFFFFFFFFFFFFFFFF
+
+	var carry uint64
+	{{$lastIndex := sub .NbWords 1}}
+	{{- range $i :=  iterate 0 $lastIndex}}
+	z[{{$i}}], carry = bits.Add64(z[{{$i}}], q{{$i}}, {{- if eq $i 0}}0{{- else}}carry{{- end}})
+	{{- end}}
+	z[{{.NbWordsLastIndex}}], _ = bits.Add64(neg1, q{{$.NbWordsLastIndex}}, carry)
+}
+{{- end}}
+
+{{ define "montReduceSigned" }}
 // montReduceSigned z = (xHi * r + x) * r⁻¹ using the SOS algorithm
 // Requires |xHi| < 2⁶³. Most significant bit of xHi is the sign bit.
 func (z *{{.ElementName}}) montReduceSigned(x *{{.ElementName}}, xHi uint64) {
@@ -403,21 +429,5 @@ func (z *{{.ElementName}}) montReduceSigned(x *{{.ElementName}}, xHi uint64) {
 		{{ template "addQ" .}}
 	}
 }
-
-const (
-	updateFactorsConversionBias int64 = 0x7fffffff7fffffff // (2³¹ - 1)(2³² + 1)
-	updateFactorIdentityMatrixRow0 = 1
-	updateFactorIdentityMatrixRow1 = 1 << 32
-)
-
-func updateFactorsDecompose(c int64) (int64, int64) {
-	c += updateFactorsConversionBias
- 	const low32BitsFilter int64 = 0xFFFFFFFF
- 	f := c&low32BitsFilter - 0x7FFFFFFF
- 	g := c>>32&low32BitsFilter - 0x7FFFFFFF
- 	return f, g
-}
-
 {{ end }}
-
 `
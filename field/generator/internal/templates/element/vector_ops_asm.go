@@ -470,3 +470,187 @@ func (vector *Vector) Mul(a, b Vector) {
 	}
 }
 `
+
+const VectorOpsAmd64Goldilocks = `
+
+import (
+	_ "{{.ASMPackagePath}}"
+	"github.com/consensys/gnark-crypto/utils/cpu"
+)
+
+//go:noescape
+func addVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func subVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func sumVec(t *{{.ElementName}}, a *{{.ElementName}}, n uint64)
+
+//go:noescape
+func mulVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func scalarMulVec(res, a, b *{{.ElementName}}, n uint64)
+
+//go:noescape
+func innerProdVec(t *{{.ElementName}}, a, b *{{.ElementName}}, n uint64)
+
+// Add adds two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Add(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Add: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	if !cpu.SupportAVX512 {
+		// call addVecGeneric
+		addVecGeneric(*vector, a, b)
+		return
+	}
+
+	const blockSize = 8
+	addVec(&(*vector)[0], &a[0], &b[0], n/blockSize)
+	if n % blockSize != 0 {
+		// call addVecGeneric on the rest
+		start := n - n % blockSize
+		addVecGeneric((*vector)[start:], a[start:], b[start:])
+	}
+}
+
+// Sub subtracts two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Sub(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Sub: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	if !cpu.SupportAVX512 {
+		// call subVecGeneric
+		subVecGeneric(*vector, a, b)
+		return
+	}
+
+	const blockSize = 8
+	subVec(&(*vector)[0], &a[0], &b[0], n/blockSize)
+	if n % blockSize != 0 {
+		// call subVecGeneric on the rest
+		start := n - n % blockSize
+		subVecGeneric((*vector)[start:], a[start:], b[start:])
+	}
+}
+
+// ScalarMul multiplies a vector by a scalar element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) ScalarMul(a Vector, b *{{.ElementName}}) {
+	if len(a) != len(*vector) {
+		panic("vector.ScalarMul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	if !cpu.SupportAVX512 {
+		// call scalarMulVecGeneric
+		scalarMulVecGeneric(*vector, a, b)
+		return
+	}
+
+	const blockSize = 8
+	scalarMulVec(&(*vector)[0], &a[0], b, n/blockSize)
+	if n % blockSize != 0 {
+		// call scalarMulVecGeneric on the rest
+		start := n - n % blockSize
+		scalarMulVecGeneric((*vector)[start:], a[start:], b)
+	}
+}
+
+// Sum computes the sum of all elements in the vector.
+func (vector *Vector) Sum() (res {{.ElementName}}) {
+	n := uint64(len(*vector))
+	if n == 0 {
+		return
+	}
+	if !cpu.SupportAVX512 {
+		// call sumVecGeneric
+		sumVecGeneric(&res, *vector)
+		return
+	}
+
+	const blockSize = 8
+	var t [blockSize]{{.ElementName}} // stores the accumulators (reduced mod q)
+	sumVec(&t[0], &(*vector)[0], n/blockSize)
+	for i := range t {
+		res.Add(&res, &t[i])
+	}
+	if n % blockSize != 0 {
+		// call sumVecGeneric on the rest
+		start := n - n % blockSize
+		sumVecGeneric(&res, (*vector)[start:])
+	}
+
+	return
+}
+
+// InnerProduct computes the inner product of two vectors.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) InnerProduct(other Vector) (res {{.ElementName}}) {
+	n := uint64(len(*vector))
+	if n != uint64(len(other)) {
+		panic("vector.InnerProduct: vectors don't have the same length")
+	}
+	if n == 0 {
+		return
+	}
+	if !cpu.SupportAVX512 {
+		// call innerProductVecGeneric
+		innerProductVecGeneric(&res, *vector, other)
+		return
+	}
+
+	const blockSize = 8
+	var t [blockSize]{{.ElementName}} // stores the accumulators (reduced mod q)
+	innerProdVec(&t[0], &(*vector)[0], &other[0], n/blockSize)
+	for i := range t {
+		res.Add(&res, &t[i])
+	}
+	if n % blockSize != 0 {
+		// call innerProductVecGeneric on the rest
+		start := n - n % blockSize
+		innerProductVecGeneric(&res, (*vector)[start:], other[start:])
+	}
+
+	return
+}
+
+// Mul multiplies two vectors element-wise and stores the result in self.
+// It panics if the vectors don't have the same length.
+func (vector *Vector) Mul(a, b Vector) {
+	if len(a) != len(b) || len(a) != len(*vector) {
+		panic("vector.Mul: vectors don't have the same length")
+	}
+	n := uint64(len(a))
+	if n == 0 {
+		return
+	}
+	if !cpu.SupportAVX512 {
+		// call mulVecGeneric
+		mulVecGeneric(*vector, a, b)
+		return
+	}
+
+	const blockSize = 8
+	mulVec(&(*vector)[0], &a[0], &b[0], n/blockSize)
+	if n % blockSize != 0 {
+		// call mulVecGeneric on the rest
+		start := n - n % blockSize
+		mulVecGeneric((*vector)[start:], a[start:], b[start:])
+	}
+}
+`
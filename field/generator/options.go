@@ -8,10 +8,14 @@ type Option func(*generatorConfig)
 
 type generatorConfig struct {
 	fftConfig      *config.FFT
+	ecfftConfig    *config.ECFFT
+	withECFFT      bool
 	asmConfig      *config.Assembly
 	withSIS        bool
 	withPoseidon2  bool
 	withExtensions bool
+	withFRI        bool
+	withLimbs32    bool
 }
 
 func (cfg *generatorConfig) HasExtensions() bool {
@@ -22,14 +26,26 @@ func (cfg *generatorConfig) HasPoseidon2() bool {
 	return cfg.withPoseidon2
 }
 
+func (cfg *generatorConfig) HasFRI() bool {
+	return cfg.withFRI
+}
+
 func (cfg *generatorConfig) HasSIS() bool {
 	return cfg.withSIS
 }
 
+func (cfg *generatorConfig) HasLimbs32() bool {
+	return cfg.withLimbs32
+}
+
 func (cfg *generatorConfig) HasFFT() bool {
 	return cfg.fftConfig != nil
 }
 
+func (cfg *generatorConfig) HasECFFT() bool {
+	return cfg.withECFFT
+}
+
 func (cfg *generatorConfig) HasArm64() bool {
 	return cfg.asmConfig != nil && cfg.asmConfig.BuildDir != ""
 }
@@ -56,12 +72,44 @@ func WithExtensions() Option {
 	}
 }
 
+// WithFRI generates a FRI low degree test folding in the degree 4 extension.
+// It requires the extensions and the Poseidon2 permutation, and is only
+// generated for fields fitting on 31 bits.
+func WithFRI() Option {
+	return func(opt *generatorConfig) {
+		opt.withFRI = true
+	}
+}
+
+// WithLimbs32 generates, next to the 64-bit code, a Montgomery arithmetic on
+// 32-bit limbs (Mul, Square, Inverse and vector multiplications). It is
+// selected on 386, arm, mips, mipsle and wasm, where bits.Mul64 is emulated;
+// it can be selected on the other targets with the purego and limbs32 build
+// tags, and deselected with the limbs64 build tag.
+// It has no effect on fields fitting on 31 bits and on Goldilocks.
+func WithLimbs32() Option {
+	return func(opt *generatorConfig) {
+		opt.withLimbs32 = true
+	}
+}
+
 func WithFFT(cfg *config.FFT) Option {
 	return func(opt *generatorConfig) {
 		opt.fftConfig = cfg
 	}
 }
 
+// WithECFFT generates an ECFFT package, for the fields without a large subgroup of
+// order 2ᵏ in their multiplicative group. If cfg is nil or has no curve, the
+// parameters are taken from a lookup table, or searched (this can take a while
+// for a large cfg.LogSize).
+func WithECFFT(cfg *config.ECFFT) Option {
+	return func(opt *generatorConfig) {
+		opt.withECFFT = true
+		opt.ecfftConfig = cfg
+	}
+}
+
 func WithASM(cfg *config.Assembly) Option {
 	return func(opt *generatorConfig) {
 		opt.asmConfig = cfg
@@ -30,6 +30,7 @@ var (
 	fOutputDir   string
 	fPackageName string
 	fElementName string
+	fLimbs32     bool
 )
 
 func init() {
@@ -38,6 +39,7 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&fModulus, "modulus", "m", "", "field modulus (base 10)")
 	rootCmd.PersistentFlags().StringVarP(&fOutputDir, "output", "o", "", "destination path to create output files")
 	rootCmd.PersistentFlags().StringVarP(&fPackageName, "package", "p", "", "package name in generated files")
+	rootCmd.PersistentFlags().BoolVar(&fLimbs32, "limbs32", true, "generate 32-bit limbs arithmetic for 32-bit architectures")
 	if bits.UintSize != 64 {
 		panic("goff only supports 64bits architectures")
 	}
@@ -64,7 +66,11 @@ func cmdGenerate(cmd *cobra.Command, args []string) {
 
 	asmDir := filepath.Join(fOutputDir, "asm")
 
-	if err := generator.GenerateFF(F, fOutputDir, generator.WithASM(&config.Assembly{BuildDir: asmDir, IncludeDir: "asm"})); err != nil {
+	opts := []generator.Option{generator.WithASM(&config.Assembly{BuildDir: asmDir, IncludeDir: "asm"})}
+	if fLimbs32 {
+		opts = append(opts, generator.WithLimbs32())
+	}
+	if err := generator.GenerateFF(F, fOutputDir, opts...); err != nil {
 		fmt.Printf("\n%s\n", err.Error())
 		os.Exit(-1)
 	}
@@ -4,6 +4,9 @@
 // Package goff (go finite field) is a library that generates fast field arithmetic code for a given modulus.
 //
 // Generated code is optimized for x86 (amd64) targets, and most methods do not allocate memory on the heap.
+// On 32-bit targets (386, arm, mips, mipsle) and wasm, the multiplications use 32-bit limbs; see the
+// limbs32 and limbs64 build tags in the generated element_limbs32.go. Use --limbs32=false to
+// generate only the 64-bit limbs arithmetic.
 //
 // Example usage:
 //
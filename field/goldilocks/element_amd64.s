@@ -0,0 +1,10 @@
+//go:build  !purego
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+// We include the hash to force the Go compiler to recompile: 17431226173364330035
+#include "../asm/element_64b/element_64b_amd64.s"
+
@@ -3,8 +3,10 @@
 
 // Code generated by consensys/gnark-crypto DO NOT EDIT
 
-// Package extensions implements the fields arithmetic of the 𝔽r²
+// Package extensions implements the fields arithmetic of the 𝔽r², 𝔽r³ and 𝔽r⁶
 // extensions of the goldilocks field.
 //
 //	𝔽r²[u] = 𝔽r/u²-7
+//	𝔽r³[u] = 𝔽r/u³-7
+//	𝔽r⁶[v] = 𝔽r³/v²-u
 package extensions
@@ -0,0 +1,425 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"errors"
+	"math/big"
+
+	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// E3 is a degree three finite field extension of fr.Element
+//
+//	𝔽r³[u] = 𝔽r/u³-7
+type E3 struct {
+	A0, A1, A2 fr.Element
+}
+
+// SizeOfE3 represents the size in bytes that an E3 element need in binary form
+const SizeOfE3 = 3 * fr.Bytes
+
+// frobenius coefficients:
+// ω = 7^((q-1)/3) is a primitive cube root of unity, so that u^q = ω·u
+var (
+	frobOmega  = fr.NewElement(18446744065119617025)
+	frobOmega2 = fr.NewElement(4294967295)
+)
+
+// Equal returns true if z equals x, false otherwise
+func (z *E3) Equal(x *E3) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1) && z.A2.Equal(&x.A2)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *E3) Cmp(x *E3) int {
+	if a2 := z.A2.Cmp(&x.A2); a2 != 0 {
+		return a2
+	}
+	if a1 := z.A1.Cmp(&x.A1); a1 != 0 {
+		return a1
+	}
+	return z.A0.Cmp(&x.A0)
+}
+
+// String puts E3 in string form
+func (z *E3) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*u+" + z.A2.String() + "*u**2"
+}
+
+// SetString sets a E3 element from strings
+func (z *E3) SetString(s0, s1, s2 string) *E3 {
+	z.A0.SetString(s0)
+	z.A1.SetString(s1)
+	z.A2.SetString(s2)
+	return z
+}
+
+// SetUint64 sets z to a0 + a1·u + a2·u² and returns z
+func (z *E3) SetUint64(a0, a1, a2 uint64) *E3 {
+	z.A0.SetUint64(a0)
+	z.A1.SetUint64(a1)
+	z.A2.SetUint64(a2)
+	return z
+}
+
+// SetElement sets z to x, embedded from the base field, and returns z
+func (z *E3) SetElement(x *fr.Element) *E3 {
+	z.A0.Set(x)
+	z.A1.SetZero()
+	z.A2.SetZero()
+	return z
+}
+
+// Set sets an E3 from x
+func (z *E3) Set(x *E3) *E3 {
+	*z = *x
+	return z
+}
+
+// SetZero sets an E3 elmt to zero
+func (z *E3) SetZero() *E3 {
+	*z = E3{}
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *E3) SetOne() *E3 {
+	*z = E3{}
+	z.A0.SetOne()
+	return z
+}
+
+// SetRandom sets a0, a1 and a2 to random values
+func (z *E3) SetRandom() (*E3, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A2.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// MustSetRandom sets the element to a random value.
+// It panics if reading from crypto/rand fails.
+func (z *E3) MustSetRandom() *E3 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *E3) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E3) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero() && z.A2.IsZero()
+}
+
+// Add adds two elements of E3
+func (z *E3) Add(x, y *E3) *E3 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	z.A2.Add(&x.A2, &y.A2)
+	return z
+}
+
+// Sub subtracts two elements of E3
+func (z *E3) Sub(x, y *E3) *E3 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	z.A2.Sub(&x.A2, &y.A2)
+	return z
+}
+
+// Double doubles an E3 element
+func (z *E3) Double(x *E3) *E3 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	z.A2.Double(&x.A2)
+	return z
+}
+
+// Neg negates an E3 element
+func (z *E3) Neg(x *E3) *E3 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	z.A2.Neg(&x.A2)
+	return z
+}
+
+// MulByElement multiplies an element in E3 by an element in fr
+func (z *E3) MulByElement(x *E3, y *fr.Element) *E3 {
+	var yCopy fr.Element
+	yCopy.Set(y)
+	z.A0.Mul(&x.A0, &yCopy)
+	z.A1.Mul(&x.A1, &yCopy)
+	z.A2.Mul(&x.A2, &yCopy)
+	return z
+}
+
+// MulByNonResidue multiplies a E3 by u
+func (z *E3) MulByNonResidue(x *E3) *E3 {
+	a0, a1, a2 := x.A0, x.A1, x.A2
+	MulBy7(&a2)
+	z.A0 = a2
+	z.A1 = a0
+	z.A2 = a1
+	return z
+}
+
+// Mul sets z to the E3-product of x,y, returns z
+func (z *E3) Mul(x, y *E3) *E3 {
+	// Algorithm 13 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, t2, c0, c1, c2, tmp fr.Element
+	t0.Mul(&x.A0, &y.A0)
+	t1.Mul(&x.A1, &y.A1)
+	t2.Mul(&x.A2, &y.A2)
+
+	c0.Add(&x.A1, &x.A2)
+	tmp.Add(&y.A1, &y.A2)
+	c0.Mul(&c0, &tmp).Sub(&c0, &t1).Sub(&c0, &t2)
+	MulBy7(&c0)
+	c0.Add(&c0, &t0)
+
+	c1.Add(&x.A0, &x.A1)
+	tmp.Add(&y.A0, &y.A1)
+	c1.Mul(&c1, &tmp).Sub(&c1, &t0).Sub(&c1, &t1)
+	tmp.Set(&t2)
+	MulBy7(&tmp)
+	c1.Add(&c1, &tmp)
+
+	c2.Add(&x.A0, &x.A2)
+	tmp.Add(&y.A0, &y.A2)
+	c2.Mul(&c2, &tmp).Sub(&c2, &t0).Sub(&c2, &t2).Add(&c2, &t1)
+
+	z.A0 = c0
+	z.A1 = c1
+	z.A2 = c2
+	return z
+}
+
+// Square sets z to the E3-product of x,x, returns z
+func (z *E3) Square(x *E3) *E3 {
+	// Algorithm 16 from https://eprint.iacr.org/2010/354.pdf
+	var c0, c1, c2, c3, c4, c5 fr.Element
+	c4.Mul(&x.A0, &x.A1).Double(&c4)
+	c5.Square(&x.A2)
+	c1.Set(&c5)
+	MulBy7(&c1)
+	c1.Add(&c1, &c4)
+	c2.Sub(&c4, &c5)
+	c3.Square(&x.A0)
+	c4.Sub(&x.A0, &x.A1).Add(&c4, &x.A2)
+	c5.Mul(&x.A1, &x.A2).Double(&c5)
+	c4.Square(&c4)
+	c0.Set(&c5)
+	MulBy7(&c0)
+	c0.Add(&c0, &c3)
+	z.A2.Add(&c2, &c4).Add(&z.A2, &c5).Sub(&z.A2, &c3)
+	z.A0 = c0
+	z.A1 = c1
+	return z
+}
+
+// Inverse sets z to the E3-inverse of x, returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E3) Inverse(x *E3) *E3 {
+	// Algorithm 17 from https://eprint.iacr.org/2010/354.pdf
+	var t0, t1, t2, t3, t4, t5, t6, c0, c1, c2, d1, d2 fr.Element
+	t0.Square(&x.A0)
+	t1.Square(&x.A1)
+	t2.Square(&x.A2)
+	t3.Mul(&x.A0, &x.A1)
+	t4.Mul(&x.A0, &x.A2)
+	t5.Mul(&x.A1, &x.A2)
+	c0.Set(&t5)
+	MulBy7(&c0)
+	c0.Sub(&t0, &c0)
+	c1.Set(&t2)
+	MulBy7(&c1)
+	c1.Sub(&c1, &t3)
+	c2.Sub(&t1, &t4)
+	t6.Mul(&x.A0, &c0)
+	d1.Mul(&x.A2, &c1)
+	d2.Mul(&x.A1, &c2)
+	d1.Add(&d1, &d2)
+	MulBy7(&d1)
+	t6.Add(&t6, &d1)
+	t6.Inverse(&t6)
+	z.A0.Mul(&c0, &t6)
+	z.A1.Mul(&c1, &t6)
+	z.A2.Mul(&c2, &t6)
+
+	return z
+}
+
+// Div divides an element in E3 by an element in E3
+func (z *E3) Div(x *E3, y *E3) *E3 {
+	var r E3
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Exp sets z=xᵏ (mod q³) and returns it
+func (z *E3) Exp(x E3, k *big.Int) *E3 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod q³) == (x⁻¹)ᵏ (mod q³)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Frobenius sets z to x^q and returns z
+func (z *E3) Frobenius(x *E3) *E3 {
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &frobOmega)
+	z.A2.Mul(&x.A2, &frobOmega2)
+	return z
+}
+
+// FrobeniusSquare sets z to x^(q²) and returns z
+func (z *E3) FrobeniusSquare(x *E3) *E3 {
+	z.A0 = x.A0
+	z.A1.Mul(&x.A1, &frobOmega2)
+	z.A2.Mul(&x.A2, &frobOmega)
+	return z
+}
+
+// Norm sets x to the norm of z, that is z·z^q·z^(q²)
+func (z *E3) Norm(x *fr.Element) {
+	var t, n E3
+	t.Frobenius(z)
+	n.FrobeniusSquare(z).Mul(&n, &t).Mul(&n, z)
+	x.Set(&n.A0)
+}
+
+// Marshal converts z to a byte slice
+func (z *E3) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// Unmarshal is an alias to SetBytes()
+func (z *E3) Unmarshal(buf []byte) error {
+	return z.SetBytes(buf)
+}
+
+// Bytes returns the regular (non montgomery) value
+// of z as a big-endian byte array.
+// z.A0 | z.A1 | z.A2
+func (z *E3) Bytes() (r [SizeOfE3]byte) {
+	fr.BigEndian.PutElement((*[fr.Bytes]byte)(r[0:fr.Bytes]), z.A0)
+	fr.BigEndian.PutElement((*[fr.Bytes]byte)(r[fr.Bytes:2*fr.Bytes]), z.A1)
+	fr.BigEndian.PutElement((*[fr.Bytes]byte)(r[2*fr.Bytes:3*fr.Bytes]), z.A2)
+	return
+}
+
+// SetBytes interprets e as the bytes of a big-endian E3
+// sets z to that value (in Montgomery form), and returns an error
+// if e is not of the right size or a coordinate is not canonical.
+// z.A0 | z.A1 | z.A2
+func (z *E3) SetBytes(e []byte) error {
+	if len(e) != SizeOfE3 {
+		return errors.New("invalid buffer size")
+	}
+	if err := z.A0.SetBytesCanonical(e[0:fr.Bytes]); err != nil {
+		return err
+	}
+	if err := z.A1.SetBytesCanonical(e[fr.Bytes : 2*fr.Bytes]); err != nil {
+		return err
+	}
+	if err := z.A2.SetBytesCanonical(e[2*fr.Bytes : 3*fr.Bytes]); err != nil {
+		return err
+	}
+	return nil
+}
+
+// BatchInvertE3 returns a new slice with every element in a inverted.
+// It uses Montgomery batch inversion trick.
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE3(a []E3) []E3 {
+	res := make([]E3, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E3
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// MulAccE3 sets res[i] += alpha * scale[i] for every i
+func MulAccE3(alpha *E3, scale []fr.Element, res []E3) {
+	N := len(res)
+	if N != len(scale) {
+		panic("MulAccE3: len(res) != len(scale)")
+	}
+	var tmp E3
+	for i := 0; i < N; i++ {
+		tmp.MulByElement(alpha, &scale[i])
+		res[i].Add(&res[i], &tmp)
+	}
+}
@@ -0,0 +1,336 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+
+	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE3ReceiverIsOperand(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE3()
+	genB := GenE3()
+	genE := GenFr()
+
+	properties.Property("[goldilocks] Having the receiver as operand (addition) should output the same result", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Set(a)
+			c.Add(a, b)
+			a.Add(a, b)
+			b.Add(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (sub) should output the same result", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Set(a)
+			c.Sub(a, b)
+			a.Sub(a, b)
+			b.Sub(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Set(a)
+			c.Mul(a, b)
+			a.Mul(a, b)
+			b.Mul(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (mul by element) should output the same result", prop.ForAll(
+		func(a *E3, b fr.Element) bool {
+			var c E3
+			c.MulByElement(a, &b)
+			a.MulByElement(a, &b)
+			return a.Equal(&c)
+		},
+		genA,
+		genE,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (square) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Square(a)
+			a.Square(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (mul by non residue) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.MulByNonResidue(a)
+			a.MulByNonResidue(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (Inverse) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a)
+			a.Inverse(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (Frobenius) should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Frobenius(a)
+			a.Frobenius(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE3Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE3()
+	genB := GenE3()
+
+	properties.Property("[goldilocks] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c E3
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *E3) bool {
+			var c, d E3
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] BatchInvertE3 should output the same result as Inverse", prop.ForAll(
+		func(a, b, c *E3) bool {
+
+			batch := BatchInvertE3([]E3{*a, *b, *c})
+			a.Inverse(a)
+			b.Inverse(b)
+			c.Inverse(c)
+			return a.Equal(&batch[0]) && b.Equal(&batch[1]) && c.Equal(&batch[2])
+		},
+		genA,
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] inverse twice should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			b.Inverse(a).Inverse(&b)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] square and mul should output the same result", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] mul by non residue should be a multiplication by u", prop.ForAll(
+		func(a *E3) bool {
+			var b, u E3
+			u.A1.SetOne()
+			b.Mul(a, &u)
+			a.MulByNonResidue(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Frobenius should output the same result as Exp(q)", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.Frobenius(a)
+			c.Exp(*a, fr.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] FrobeniusSquare should output the same result as Frobenius twice", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			b.FrobeniusSquare(a)
+			c.Frobenius(a).Frobenius(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Norm should output the same result as Exp(q²+q+1)", prop.ForAll(
+		func(a *E3) bool {
+			var n fr.Element
+			var b E3
+			var e big.Int
+			q := fr.Modulus()
+			e.Mul(q, q).Add(&e, q).Add(&e, big.NewInt(1))
+			b.Exp(*a, &e)
+			a.Norm(&n)
+			return b.A1.IsZero() && b.A2.IsZero() && b.A0.Equal(&n)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Exp should be consistent with negative exponents", prop.ForAll(
+		func(a *E3) bool {
+			var b, c E3
+			k := big.NewInt(-42)
+			b.Exp(*a, k)
+			c.Exp(*a, k.Neg(k)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] SetBytes(Bytes()) should leave an element invariant", prop.ForAll(
+		func(a *E3) bool {
+			var b E3
+			if err := b.SetBytes(a.Marshal()); err != nil {
+				return false
+			}
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] MulAccE3 should output the same result as MulByElement and Add", prop.ForAll(
+		func(a, b, c *E3) bool {
+			scale := make([]fr.Element, 3)
+			for i := range scale {
+				scale[i].MustSetRandom()
+			}
+			res := []E3{*a, *b, *c}
+			MulAccE3(a, scale, res)
+			var tmp E3
+			for i, x := range []E3{*a, *b, *c} {
+				tmp.MulByElement(a, &scale[i]).Add(&tmp, &x)
+				if !tmp.Equal(&res[i]) {
+					return false
+				}
+			}
+			return true
+		},
+		genA,
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE3SetBytesNonCanonical(t *testing.T) {
+	b := make([]byte, SizeOfE3)
+	for i := 0; i < fr.Bytes; i++ {
+		b[fr.Bytes+i] = 0xff
+	}
+	var a E3
+	if err := a.SetBytes(b); err == nil {
+		t.Fatal("expected an error on non canonical input")
+	}
+	if err := a.SetBytes(b[1:]); err == nil {
+		t.Fatal("expected an error on invalid buffer size")
+	}
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE3Add(b *testing.B) {
+	var a, c E3
+	a.MustSetRandom()
+	c.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Add(&a, &c)
+	}
+}
+
+func BenchmarkE3Mul(b *testing.B) {
+	var a, c E3
+	a.MustSetRandom()
+	c.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE3Square(b *testing.B) {
+	var a E3
+	a.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE3Inverse(b *testing.B) {
+	var a E3
+	a.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
@@ -0,0 +1,343 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"errors"
+	"math/big"
+
+	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// E6 is a degree two finite field extension of fr3
+//
+//	𝔽r⁶[v] = 𝔽r³/v²-u
+type E6 struct {
+	B0, B1 E3
+}
+
+// SizeOfE6 represents the size in bytes that an E6 element need in binary form
+const SizeOfE6 = 2 * SizeOfE3
+
+// frobGamma = 7^((q-1)/6) = u^((q-1)/2), so that v^q = frobGamma·v
+var frobGamma = fr.NewElement(18446744065119617026)
+
+// Equal returns true if z equals x, false otherwise
+func (z *E6) Equal(x *E6) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
+}
+
+// Cmp compares (lexicographic order) z and x and returns:
+//
+//	-1 if z <  x
+//	 0 if z == x
+//	+1 if z >  x
+func (z *E6) Cmp(x *E6) int {
+	if b1 := z.B1.Cmp(&x.B1); b1 != 0 {
+		return b1
+	}
+	return z.B0.Cmp(&x.B0)
+}
+
+// String puts E6 in string form
+func (z *E6) String() string {
+	return (z.B0.String() + "+(" + z.B1.String() + ")*v")
+}
+
+// SetString sets a E6 from string
+func (z *E6) SetString(s0, s1, s2, s3, s4, s5 string) *E6 {
+	z.B0.SetString(s0, s1, s2)
+	z.B1.SetString(s3, s4, s5)
+	return z
+}
+
+// Set copies x into z and returns z
+func (z *E6) Set(x *E6) *E6 {
+	z.B0 = x.B0
+	z.B1 = x.B1
+	return z
+}
+
+// SetZero sets an E6 elmt to zero
+func (z *E6) SetZero() *E6 {
+	*z = E6{}
+	return z
+}
+
+// SetOne sets z to 1 in Montgomery form and returns z
+func (z *E6) SetOne() *E6 {
+	*z = E6{}
+	z.B0.A0.SetOne()
+	return z
+}
+
+// SetRandom sets z to a random value
+func (z *E6) SetRandom() (*E6, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// MustSetRandom sets the element to a random value.
+// It panics if reading from crypto/rand fails.
+func (z *E6) MustSetRandom() *E6 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *E6) IsZero() bool {
+	return z.B0.IsZero() && z.B1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *E6) IsOne() bool {
+	return z.B0.IsOne() && z.B1.IsZero()
+}
+
+// Add sets z=x+y in E6 and returns z
+func (z *E6) Add(x, y *E6) *E6 {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	return z
+}
+
+// Sub sets z to x-y and returns z
+func (z *E6) Sub(x, y *E6) *E6 {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	return z
+}
+
+// Double sets z=2*x and returns z
+func (z *E6) Double(x *E6) *E6 {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	return z
+}
+
+// Neg negates an E6 element
+func (z *E6) Neg(x *E6) *E6 {
+	z.B0.Neg(&x.B0)
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// MulByElement multiplies an element in E6 by an element in fr
+func (z *E6) MulByElement(x *E6, y *fr.Element) *E6 {
+	z.B0.MulByElement(&x.B0, y)
+	z.B1.MulByElement(&x.B1, y)
+	return z
+}
+
+// MulByE3 multiplies an element in E6 by an element in E3
+func (z *E6) MulByE3(x *E6, y *E3) *E6 {
+	var yCopy E3
+	yCopy.Set(y)
+	z.B0.Mul(&x.B0, &yCopy)
+	z.B1.Mul(&x.B1, &yCopy)
+	return z
+}
+
+// MulByNonResidue mul x by (0,1)
+func (z *E6) MulByNonResidue(x *E6) *E6 {
+	z.B1, z.B0 = x.B0, x.B1
+	z.B0.MulByNonResidue(&z.B0)
+	return z
+}
+
+// Mul sets z=x*y in E6 and returns z
+func (z *E6) Mul(x, y *E6) *E6 {
+	var a, b, c E3
+	a.Add(&x.B0, &x.B1)
+	b.Add(&y.B0, &y.B1)
+	a.Mul(&a, &b)
+	b.Mul(&x.B0, &y.B0)
+	c.Mul(&x.B1, &y.B1)
+	z.B1.Sub(&a, &b).Sub(&z.B1, &c)
+	z.B0.MulByNonResidue(&c).Add(&z.B0, &b)
+	return z
+}
+
+// Square sets z=x*x in E6 and returns z
+func (z *E6) Square(x *E6) *E6 {
+
+	//Algorithm 22 from https://eprint.iacr.org/2010/354.pdf
+	var c0, c2, c3 E3
+	c0.Sub(&x.B0, &x.B1)
+	c3.MulByNonResidue(&x.B1).Sub(&x.B0, &c3)
+	c2.Mul(&x.B0, &x.B1)
+	c0.Mul(&c0, &c3).Add(&c0, &c2)
+	z.B1.Double(&c2)
+	c2.MulByNonResidue(&c2)
+	z.B0.Add(&c0, &c2)
+
+	return z
+}
+
+// Inverse sets z to the inverse of x in E6 and returns z
+//
+// if x == 0, sets and returns z = x
+func (z *E6) Inverse(x *E6) *E6 {
+	// Algorithm 23 from https://eprint.iacr.org/2010/354.pdf
+
+	var t0, t1, tmp E3
+	t0.Square(&x.B0)
+	t1.Square(&x.B1)
+	tmp.MulByNonResidue(&t1)
+	t0.Sub(&t0, &tmp)
+	t1.Inverse(&t0)
+	z.B0.Mul(&x.B0, &t1)
+	z.B1.Mul(&x.B1, &t1).Neg(&z.B1)
+
+	return z
+}
+
+// Div divides an element in E6 by an element in E6
+func (z *E6) Div(x *E6, y *E6) *E6 {
+	var r E6
+	r.Inverse(y).Mul(x, &r)
+	return z.Set(&r)
+}
+
+// Exp sets z=xᵏ (mod q⁶) and returns it
+func (z *E6) Exp(x E6, k *big.Int) *E6 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod q⁶) == (x⁻¹)ᵏ (mod q⁶)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// Conjugate sets z to x conjugated and returns z.
+// This is also x^(q³).
+func (z *E6) Conjugate(x *E6) *E6 {
+	z.B0 = x.B0
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Frobenius sets z to x^q and returns z
+func (z *E6) Frobenius(x *E6) *E6 {
+	z.B0.Frobenius(&x.B0)
+	z.B1.Frobenius(&x.B1).MulByElement(&z.B1, &frobGamma)
+	return z
+}
+
+// Marshal converts z to a byte slice
+func (z *E6) Marshal() []byte {
+	b := z.Bytes()
+	return b[:]
+}
+
+// Unmarshal is an alias to SetBytes()
+func (z *E6) Unmarshal(buf []byte) error {
+	return z.SetBytes(buf)
+}
+
+// Bytes returns the regular (non montgomery) value
+// of z as a big-endian byte array.
+// z.B0.A0 | z.B0.A1 | z.B0.A2 | z.B1.A0 | z.B1.A1 | z.B1.A2
+func (z *E6) Bytes() (r [SizeOfE6]byte) {
+	b0 := z.B0.Bytes()
+	b1 := z.B1.Bytes()
+	copy(r[:SizeOfE3], b0[:])
+	copy(r[SizeOfE3:], b1[:])
+	return
+}
+
+// SetBytes interprets e as the bytes of a big-endian E6
+// sets z to that value (in Montgomery form), and returns an error
+// if e is not of the right size or a coordinate is not canonical.
+func (z *E6) SetBytes(e []byte) error {
+	if len(e) != SizeOfE6 {
+		return errors.New("invalid buffer size")
+	}
+	if err := z.B0.SetBytes(e[:SizeOfE3]); err != nil {
+		return err
+	}
+	return z.B1.SetBytes(e[SizeOfE3:])
+}
+
+// BatchInvertE6 returns a new slice with every element in a inverted.
+// It uses Montgomery batch inversion trick.
+//
+// if a[i] == 0, returns result[i] = a[i]
+func BatchInvertE6(a []E6) []E6 {
+	res := make([]E6, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator E6
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
+
+// MulAccE6 sets res[i] += alpha * scale[i] for every i
+func MulAccE6(alpha *E6, scale []fr.Element, res []E6) {
+	N := len(res)
+	if N != len(scale) {
+		panic("MulAccE6: len(res) != len(scale)")
+	}
+	var tmp E6
+	for i := 0; i < N; i++ {
+		tmp.MulByElement(alpha, &scale[i])
+		res[i].Add(&res[i], &tmp)
+	}
+}
@@ -0,0 +1,278 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+
+	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+// ------------------------------------------------------------
+// tests
+
+func TestE6ReceiverIsOperand(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE6()
+	genB := GenE6()
+
+	properties.Property("[goldilocks] Having the receiver as operand (addition) should output the same result", prop.ForAll(
+		func(a, b *E6) bool {
+			var c, d E6
+			d.Set(a)
+			c.Add(a, b)
+			a.Add(a, b)
+			b.Add(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (sub) should output the same result", prop.ForAll(
+		func(a, b *E6) bool {
+			var c, d E6
+			d.Set(a)
+			c.Sub(a, b)
+			a.Sub(a, b)
+			b.Sub(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (mul) should output the same result", prop.ForAll(
+		func(a, b *E6) bool {
+			var c, d E6
+			d.Set(a)
+			c.Mul(a, b)
+			a.Mul(a, b)
+			b.Mul(&d, b)
+			return a.Equal(b) && a.Equal(&c) && b.Equal(&c)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (square) should output the same result", prop.ForAll(
+		func(a *E6) bool {
+			var b E6
+			b.Square(a)
+			a.Square(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (mul by non residue) should output the same result", prop.ForAll(
+		func(a *E6) bool {
+			var b E6
+			b.MulByNonResidue(a)
+			a.MulByNonResidue(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (Inverse) should output the same result", prop.ForAll(
+		func(a *E6) bool {
+			var b E6
+			b.Inverse(a)
+			a.Inverse(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Having the receiver as operand (Frobenius) should output the same result", prop.ForAll(
+		func(a *E6) bool {
+			var b E6
+			b.Frobenius(a)
+			a.Frobenius(a)
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestE6Ops(t *testing.T) {
+
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 100
+
+	properties := gopter.NewProperties(parameters)
+
+	genA := GenE6()
+	genB := GenE6()
+
+	properties.Property("[goldilocks] sub & add should leave an element invariant", prop.ForAll(
+		func(a, b *E6) bool {
+			var c E6
+			c.Set(a)
+			c.Add(&c, b).Sub(&c, b)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] mul & inverse should leave an element invariant", prop.ForAll(
+		func(a, b *E6) bool {
+			var c, d E6
+			d.Inverse(b)
+			c.Set(a)
+			c.Mul(&c, b).Mul(&c, &d)
+			return c.Equal(a)
+		},
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] BatchInvertE6 should output the same result as Inverse", prop.ForAll(
+		func(a, b, c *E6) bool {
+
+			batch := BatchInvertE6([]E6{*a, *b, *c})
+			a.Inverse(a)
+			b.Inverse(b)
+			c.Inverse(c)
+			return a.Equal(&batch[0]) && b.Equal(&batch[1]) && c.Equal(&batch[2])
+		},
+		genA,
+		genA,
+		genB,
+	))
+
+	properties.Property("[goldilocks] square and mul should output the same result", prop.ForAll(
+		func(a *E6) bool {
+			var b, c E6
+			b.Mul(a, a)
+			c.Square(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] MulByE3 should output the same result as Mul", prop.ForAll(
+		func(a *E6, b *E3) bool {
+			var c, d E6
+			d.B0.Set(b)
+			c.MulByE3(a, b)
+			d.Mul(a, &d)
+			return c.Equal(&d)
+		},
+		genA,
+		GenE3(),
+	))
+
+	properties.Property("[goldilocks] Frobenius should output the same result as Exp(q)", prop.ForAll(
+		func(a *E6) bool {
+			var b, c E6
+			b.Frobenius(a)
+			c.Exp(*a, fr.Modulus())
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Frobenius three times should output the conjugate", prop.ForAll(
+		func(a *E6) bool {
+			var b, c E6
+			b.Frobenius(a).Frobenius(&b).Frobenius(&b)
+			c.Conjugate(a)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] Exp should be consistent with negative exponents", prop.ForAll(
+		func(a *E6) bool {
+			var b, c E6
+			k := big.NewInt(-42)
+			b.Exp(*a, k)
+			c.Exp(*a, k.Neg(k)).Inverse(&c)
+			return b.Equal(&c)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] SetBytes(Bytes()) should leave an element invariant", prop.ForAll(
+		func(a *E6) bool {
+			var b E6
+			if err := b.SetBytes(a.Marshal()); err != nil {
+				return false
+			}
+			return a.Equal(&b)
+		},
+		genA,
+	))
+
+	properties.Property("[goldilocks] MulAccE6 should output the same result as MulByElement and Add", prop.ForAll(
+		func(a, b, c *E6) bool {
+			scale := make([]fr.Element, 3)
+			for i := range scale {
+				scale[i].MustSetRandom()
+			}
+			res := []E6{*a, *b, *c}
+			MulAccE6(a, scale, res)
+			var tmp E6
+			for i, x := range []E6{*a, *b, *c} {
+				tmp.MulByElement(a, &scale[i]).Add(&tmp, &x)
+				if !tmp.Equal(&res[i]) {
+					return false
+				}
+			}
+			return true
+		},
+		genA,
+		genA,
+		genB,
+	))
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+// ------------------------------------------------------------
+// benches
+
+func BenchmarkE6Mul(b *testing.B) {
+	var a, c E6
+	a.MustSetRandom()
+	c.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Mul(&a, &c)
+	}
+}
+
+func BenchmarkE6Square(b *testing.B) {
+	var a E6
+	a.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Square(&a)
+	}
+}
+
+func BenchmarkE6Inverse(b *testing.B) {
+	var a E6
+	a.MustSetRandom()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Inverse(&a)
+	}
+}
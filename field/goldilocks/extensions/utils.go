@@ -39,3 +39,24 @@ func GenE2() gopter.Gen {
 		return &E2{A0: values[0].(fr.Element), A1: values[1].(fr.Element)}
 	})
 }
+
+// E3 generates an E3 elmt
+func GenE3() gopter.Gen {
+	return gopter.CombineGens(
+		GenFr(),
+		GenFr(),
+		GenFr(),
+	).Map(func(values []interface{}) *E3 {
+		return &E3{A0: values[0].(fr.Element), A1: values[1].(fr.Element), A2: values[2].(fr.Element)}
+	})
+}
+
+// E6 generates an E6 elmt
+func GenE6() gopter.Gen {
+	return gopter.CombineGens(
+		GenE3(),
+		GenE3(),
+	).Map(func(values []interface{}) *E6 {
+		return &E6{B0: *values[0].(*E3), B1: *values[1].(*E3)}
+	})
+}
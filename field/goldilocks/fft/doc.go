@@ -4,5 +4,8 @@
 // Code generated by consensys/gnark-crypto DO NOT EDIT
 
 // Package fft provides in-place discrete Fourier transform on powers-of-two subgroups
-// of 𝔽ᵣˣ (the multiplicative group (ℤ/rℤ, x) ).
+// of 𝔽ᵣˣ (the multiplicative group (ℤ/rℤ, x) ), and on subgroups of order 2ᵃ·3ᵇ·5ᶜ (see MixedRadixDomain).
+// Vectors that don't fit in memory can be transformed out-of-core (see FFTOutOfCore), on a domain
+// built with NewDomain(n, WithoutPrecompute()) so that its memory doesn't grow with n, and several
+// polynomials can be transformed at once (see FFTBatch and FFTMatrix).
 package fft
@@ -0,0 +1,60 @@
+//go:build !purego
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fft
+
+import (
+	"github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/utils/cpu"
+)
+
+//go:noescape
+func innerDIFWithTwiddles_avx512(a, twiddles *goldilocks.Element, n, m int)
+
+//go:noescape
+func innerDITWithTwiddles_avx512(a, twiddles *goldilocks.Element, n, m int)
+
+// blockSize is the number of butterflies processed at once by the assembly kernels.
+const blockSize = 8
+
+func innerDIFWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+	if !cpu.SupportAVX512 || end-start < blockSize {
+		innerDIFWithTwiddlesGeneric(a, twiddles, start, end, m)
+		return
+	}
+	n := (end - start) / blockSize
+	innerDIFWithTwiddles_avx512(&a[start], &twiddles[start], n, m)
+	if rest := start + n*blockSize; rest != end {
+		innerDIFWithTwiddlesGeneric(a, twiddles, rest, end, m)
+	}
+}
+
+func innerDITWithTwiddles(a []goldilocks.Element, twiddles []goldilocks.Element, start, end, m int) {
+	if !cpu.SupportAVX512 || end-start < blockSize {
+		innerDITWithTwiddlesGeneric(a, twiddles, start, end, m)
+		return
+	}
+	n := (end - start) / blockSize
+	innerDITWithTwiddles_avx512(&a[start], &twiddles[start], n, m)
+	if rest := start + n*blockSize; rest != end {
+		innerDITWithTwiddlesGeneric(a, twiddles, rest, end, m)
+	}
+}
+
+func kerDIFNP_32(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	kerDIFNP_32generic(a, twiddles, stage)
+}
+func kerDITNP_32(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	kerDITNP_32generic(a, twiddles, stage)
+}
+
+func kerDIFNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	kerDIFNP_256generic(a, twiddles, stage)
+}
+func kerDITNP_256(a []goldilocks.Element, twiddles [][]goldilocks.Element, stage int) {
+	kerDITNP_256generic(a, twiddles, stage)
+}
@@ -0,0 +1,118 @@
+//go:build !purego
+
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+// Refer to the generator for more documentation.
+
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+#define LOAD_GOLDILOCKS(in0, in1, in2) \
+	MOVQ         $0xffffffff00000001, AX            \
+	VPBROADCASTQ AX, in0                            \
+	MOVQ         $0x00000000ffffffff, AX            \
+	VPBROADCASTQ AX, in1                            \
+	VPTERNLOGD   $0x00000000000000ff, in2, in2, in2 \
+	MOVQ         $0x0000000000005555, AX            \
+	KMOVD        AX, K3                             \
+
+#define ADD_G(in0, in1, in2, in3, in4, in5, in6) \
+	VPADDQ  in0, in1, in3     \
+	VPCMPUQ $1, in1, in3, K4  \
+	VPADDQ  in6, in3, K4, in3 \
+	VPSUBQ  in5, in3, in4     \
+	VPMINUQ in3, in4, in2     \
+
+#define SUB_G(in0, in1, in2, in3) \
+	VPCMPUQ $1, in1, in0, K4  \
+	VPSUBQ  in1, in0, in2     \
+	VPADDQ  in3, in2, K4, in2 \
+
+#define MUL_G(in0, in1, in2, in3, in4, in5, in6, in7, in8, in9, in10, in11, in12) \
+	VPSRLQ    $32, in0, in3      \
+	VPSRLQ    $32, in1, in4      \
+	VPMULUDQ  in0, in1, in5      \
+	VPMULUDQ  in0, in4, in6      \
+	VPMULUDQ  in3, in1, in7      \
+	VPMULUDQ  in3, in4, in8      \
+	VPSRLQ    $32, in5, in9      \
+	VPADDQ    in9, in7, in7      \
+	VPANDQ    in11, in7, in9     \
+	VPADDQ    in9, in6, in6      \
+	VPSRLQ    $32, in7, in9      \
+	VPADDQ    in9, in8, in8      \
+	VPSRLQ    $32, in6, in9      \
+	VPADDQ    in9, in8, in8      \
+	VPSLLQ    $32, in6, in9      \
+	VPBLENDMD in5, in9, K3, in5  \
+	VPSLLQ    $32, in5, in9      \
+	VPADDQ    in9, in5, in3      \
+	VPCMPUQ   $1, in5, in3, K4   \
+	VPSRLQ    $32, in3, in9      \
+	VPSUBQ    in9, in3, in3      \
+	VPADDQ    in12, in3, K4, in3 \
+	VPCMPUQ   $1, in3, in8, K4   \
+	VPSUBQ    in3, in8, in2      \
+	VPADDQ    in10, in2, K4, in2 \
+
+// innerDITWithTwiddles_avx512(a, twiddles *Element, n, m int)
+// n is the number of blocks of 8 butterflies to process
+TEXT ·innerDITWithTwiddles_avx512(SB), NOSPLIT, $0-32
+	LOAD_GOLDILOCKS(Z0, Z1, Z2)
+	MOVQ a+0(FP), R15
+	MOVQ twiddles+8(FP), DX
+	MOVQ n+16(FP), CX
+	MOVQ m+24(FP), BX
+	SHLQ $3, BX             // offset = m * 8bytes
+	ADDQ R15, BX
+
+loop_1:
+	TESTQ     CX, CX
+	JEQ       done_2
+	DECQ      CX
+	VMOVDQU64 0(R15), Z10 // load a[i]
+	VMOVDQU64 0(BX), Z11  // load a[i+m]
+	VMOVDQU64 0(DX), Z12  // load twiddles[i]
+	MUL_G(Z11, Z12, Z11, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	ADD_G(Z10, Z11, Z13, Z8, Z9, Z0, Z1)
+	SUB_G(Z10, Z11, Z11, Z0)
+	VMOVDQU64 Z13, 0(R15) // store a[i]
+	VMOVDQU64 Z11, 0(BX)  // store a[i+m]
+	ADDQ      $64, R15
+	ADDQ      $64, BX
+	ADDQ      $64, DX
+	JMP       loop_1
+
+done_2:
+	RET
+
+// innerDIFWithTwiddles_avx512(a, twiddles *Element, n, m int)
+// n is the number of blocks of 8 butterflies to process
+TEXT ·innerDIFWithTwiddles_avx512(SB), NOSPLIT, $0-32
+	LOAD_GOLDILOCKS(Z0, Z1, Z2)
+	MOVQ a+0(FP), R15
+	MOVQ twiddles+8(FP), DX
+	MOVQ n+16(FP), CX
+	MOVQ m+24(FP), BX
+	SHLQ $3, BX             // offset = m * 8bytes
+	ADDQ R15, BX
+
+loop_3:
+	TESTQ     CX, CX
+	JEQ       done_4
+	DECQ      CX
+	VMOVDQU64 0(R15), Z10 // load a[i]
+	VMOVDQU64 0(BX), Z11  // load a[i+m]
+	VMOVDQU64 0(DX), Z12  // load twiddles[i]
+	ADD_G(Z10, Z11, Z13, Z8, Z9, Z0, Z1)
+	SUB_G(Z10, Z11, Z11, Z0)
+	MUL_G(Z11, Z12, Z11, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	VMOVDQU64 Z13, 0(R15) // store a[i]
+	VMOVDQU64 Z11, 0(BX)  // store a[i+m]
+	ADDQ      $64, R15
+	ADDQ      $64, BX
+	ADDQ      $64, DX
+	JMP       loop_3
+
+done_4:
+	RET
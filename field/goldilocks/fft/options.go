@@ -17,8 +17,10 @@ import (
 type Option func(fftConfig) fftConfig
 
 type fftConfig struct {
-	coset   bool
-	nbTasks int
+	coset        bool
+	nbTasks      int
+	memoryBudget int
+	transpose    bool
 }
 
 // OnCoset if provided, FFT(a) returns the evaluation of a on a coset.
@@ -42,12 +44,32 @@ func WithNbTasks(nbTasks int) Option {
 	}
 }
 
+// WithMemoryBudget sets the memory used by the out-of-core FFTs for the blocks of data, in bytes.
+// Default is 256MiB.
+func WithMemoryBudget(nbBytes int) Option {
+	return func(opt fftConfig) fftConfig {
+		opt.memoryBudget = nbBytes
+		return opt
+	}
+}
+
+// WithTranspose makes FFTMatrix and FFTInverseMatrix copy the columns by blocks to contiguous
+// vectors and transform them with FFTBatch, instead of applying the butterflies on the rows of the matrix.
+// This is usually faster for the small fields, whose FFT kernels are vectorized.
+func WithTranspose() Option {
+	return func(opt fftConfig) fftConfig {
+		opt.transpose = true
+		return opt
+	}
+}
+
 // default options
 func fftOptions(opts []Option) fftConfig {
 	// apply options
 	opt := fftConfig{
-		coset:   false,
-		nbTasks: runtime.NumCPU(),
+		coset:        false,
+		nbTasks:      runtime.NumCPU(),
+		memoryBudget: defaultMemoryBudget,
 	}
 	for _, option := range opts {
 		opt = option(opt)
@@ -15,6 +15,7 @@ import (
 	"golang.org/x/crypto/sha3"
 
 	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+	"github.com/consensys/gnark-crypto/utils/cpu"
 )
 
 var (
@@ -47,6 +48,9 @@ type Parameters struct {
 
 	// derived round keys from the parameter seed and curve ID
 	RoundKeys [][]fr.Element
+	// indicates if we have a fast path (avx512)
+	hasFast8_6_17  bool
+	hasFast12_6_17 bool
 }
 
 // NewParameters returns a new set of parameters for the Poseidon2 permutation.
@@ -54,6 +58,8 @@ type Parameters struct {
 // from the seed which is a digest of the parameters and curve ID.
 func NewParameters(width, nbFullRounds, nbPartialRounds int) *Parameters {
 	p := Parameters{Width: width, NbFullRounds: nbFullRounds, NbPartialRounds: nbPartialRounds}
+	p.hasFast8_6_17 = width == 8 && nbFullRounds == 6 && nbPartialRounds == 17 && cpu.SupportAVX512
+	p.hasFast12_6_17 = width == 12 && nbFullRounds == 6 && nbPartialRounds == 17 && cpu.SupportAVX512
 	seed := p.String()
 	p.initRC(seed)
 	return &p
@@ -64,6 +70,8 @@ func NewParameters(width, nbFullRounds, nbPartialRounds int) *Parameters {
 // from the given seed.
 func NewParametersWithSeed(width, nbFullRounds, nbPartialRounds int, seed string) *Parameters {
 	p := Parameters{Width: width, NbFullRounds: nbFullRounds, NbPartialRounds: nbPartialRounds}
+	p.hasFast8_6_17 = width == 8 && nbFullRounds == 6 && nbPartialRounds == 17 && cpu.SupportAVX512
+	p.hasFast12_6_17 = width == 12 && nbFullRounds == 6 && nbPartialRounds == 17 && cpu.SupportAVX512
 	p.initRC(seed)
 	return &p
 }
@@ -250,6 +258,14 @@ func (h *Permutation) Permutation(input []fr.Element) error {
 	if len(input) != h.params.Width {
 		return ErrInvalidSizebuffer
 	}
+	if h.params.hasFast8_6_17 {
+		permutation8_avx512(input, h.params.RoundKeys)
+		return nil
+	}
+	if h.params.hasFast12_6_17 {
+		permutation12_avx512(input, h.params.RoundKeys)
+		return nil
+	}
 
 	// external matrix multiplication, cf https://eprint.iacr.org/2023/323.pdf page 14 (part 6)
 	h.matMulExternalInPlace(input)
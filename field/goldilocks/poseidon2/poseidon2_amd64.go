@@ -0,0 +1,18 @@
+//go:build !purego
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+//go:noescape
+func permutation12_avx512(input []fr.Element, roundKeys [][]fr.Element)
+
+//go:noescape
+func permutation8_avx512(input []fr.Element, roundKeys [][]fr.Element)
@@ -0,0 +1,596 @@
+//go:build !purego
+
+// Code generated by gnark-crypto/generator. DO NOT EDIT.
+// Refer to the generator for more documentation.
+
+#include "textflag.h"
+#include "funcdata.h"
+#include "go_asm.h"
+
+#define LOAD_GOLDILOCKS(in0, in1, in2) \
+	MOVQ         $0xffffffff00000001, AX            \
+	VPBROADCASTQ AX, in0                            \
+	MOVQ         $0x00000000ffffffff, AX            \
+	VPBROADCASTQ AX, in1                            \
+	VPTERNLOGD   $0x00000000000000ff, in2, in2, in2 \
+	MOVQ         $0x0000000000005555, AX            \
+	KMOVD        AX, K3                             \
+
+#define ADD_G(in0, in1, in2, in3, in4, in5, in6) \
+	VPADDQ  in0, in1, in3     \
+	VPCMPUQ $1, in1, in3, K4  \
+	VPADDQ  in6, in3, K4, in3 \
+	VPSUBQ  in5, in3, in4     \
+	VPMINUQ in3, in4, in2     \
+
+#define SUB_G(in0, in1, in2, in3) \
+	VPCMPUQ $1, in1, in0, K4  \
+	VPSUBQ  in1, in0, in2     \
+	VPADDQ  in3, in2, K4, in2 \
+
+#define MUL_G(in0, in1, in2, in3, in4, in5, in6, in7, in8, in9, in10, in11, in12) \
+	VPSRLQ    $32, in0, in3      \
+	VPSRLQ    $32, in1, in4      \
+	VPMULUDQ  in0, in1, in5      \
+	VPMULUDQ  in0, in4, in6      \
+	VPMULUDQ  in3, in1, in7      \
+	VPMULUDQ  in3, in4, in8      \
+	VPSRLQ    $32, in5, in9      \
+	VPADDQ    in9, in7, in7      \
+	VPANDQ    in11, in7, in9     \
+	VPADDQ    in9, in6, in6      \
+	VPSRLQ    $32, in7, in9      \
+	VPADDQ    in9, in8, in8      \
+	VPSRLQ    $32, in6, in9      \
+	VPADDQ    in9, in8, in8      \
+	VPSLLQ    $32, in6, in9      \
+	VPBLENDMD in5, in9, K3, in5  \
+	VPSLLQ    $32, in5, in9      \
+	VPADDQ    in9, in5, in3      \
+	VPCMPUQ   $1, in5, in3, K4   \
+	VPSRLQ    $32, in3, in9      \
+	VPSUBQ    in9, in3, in3      \
+	VPADDQ    in12, in3, K4, in3 \
+	VPCMPUQ   $1, in3, in8, K4   \
+	VPSUBQ    in3, in8, in2      \
+	VPADDQ    in10, in2, K4, in2 \
+
+// permutation12_avx512(input []fr.Element, roundKeys [][]fr.Element)
+TEXT ·permutation12_avx512(SB), NOSPLIT, $0-48
+	LOAD_GOLDILOCKS(Z0, Z1, Z2)
+	MOVQ          $1, AX
+	KMOVQ         AX, K1
+	MOVQ          $0x0000000000000055, AX
+	KMOVQ         AX, K2
+	MOVQ          input+0(FP), R15
+	MOVQ          roundKeys+24(FP), R14
+	VMOVDQU64     0(R15), Z10
+	VMOVDQU64     64(R15), Y11
+	MOVQ          ·diag12+0(SB), CX
+	VMOVDQU64     0(CX), Z18
+	VMOVDQU64     64(CX), Y19
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VPERMQ        $0x00000000000000b1, Y11, Y16
+	ADD_G(Y11, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y11, Y15, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x00000000000000d7, Y15, Y15
+	VPERMQ        $0x0000000000000028, Y14, Y16
+	ADD_G(Y15, Y16, Y15, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x0000000000000005, Y15, Y16
+	ADD_G(Y14, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y15, Y11, Y8, Y9, Y0, Y1)
+	VPBLENDMQ     Y11, Y14, K2, Y11
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y17, Y11, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y17, Y11, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MOVQ          0(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	VMOVDQU64     64(BX), Y14
+	ADD_G(Y11, Y14, Y11, Y8, Y9, Y0, Y1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Y11, Y11, Y12, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y12, Y12, Y13, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y12, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y13, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VPERMQ        $0x00000000000000b1, Y11, Y16
+	ADD_G(Y11, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y11, Y15, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x00000000000000d7, Y15, Y15
+	VPERMQ        $0x0000000000000028, Y14, Y16
+	ADD_G(Y15, Y16, Y15, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x0000000000000005, Y15, Y16
+	ADD_G(Y14, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y15, Y11, Y8, Y9, Y0, Y1)
+	VPBLENDMQ     Y11, Y14, K2, Y11
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y17, Y11, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y17, Y11, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MOVQ          24(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	VMOVDQU64     64(BX), Y14
+	ADD_G(Y11, Y14, Y11, Y8, Y9, Y0, Y1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Y11, Y11, Y12, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y12, Y12, Y13, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y12, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y13, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VPERMQ        $0x00000000000000b1, Y11, Y16
+	ADD_G(Y11, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y11, Y15, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x00000000000000d7, Y15, Y15
+	VPERMQ        $0x0000000000000028, Y14, Y16
+	ADD_G(Y15, Y16, Y15, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x0000000000000005, Y15, Y16
+	ADD_G(Y14, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y15, Y11, Y8, Y9, Y0, Y1)
+	VPBLENDMQ     Y11, Y14, K2, Y11
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y17, Y11, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y17, Y11, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MOVQ          48(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	VMOVDQU64     64(BX), Y14
+	ADD_G(Y11, Y14, Y11, Y8, Y9, Y0, Y1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Y11, Y11, Y12, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y12, Y12, Y13, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y12, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y13, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VPERMQ        $0x00000000000000b1, Y11, Y16
+	ADD_G(Y11, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y11, Y15, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x00000000000000d7, Y15, Y15
+	VPERMQ        $0x0000000000000028, Y14, Y16
+	ADD_G(Y15, Y16, Y15, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x0000000000000005, Y15, Y16
+	ADD_G(Y14, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y15, Y11, Y8, Y9, Y0, Y1)
+	VPBLENDMQ     Y11, Y14, K2, Y11
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y17, Y11, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y17, Y11, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+
+	// loop over the partial rounds
+	MOVQ $0x0000000000000011, SI // nb partial rounds --> 17
+	MOVQ R14, DI
+	ADDQ $0x0000000000000048, DI
+
+loop_1:
+	TESTQ         SI, SI
+	JEQ           done_2
+	DECQ          SI
+	MOVQ          0(DI), BX
+	VMOVQ         0(BX), X14
+	ADD_G(X10, X14, X16, X8, X9, X0, X1)
+	MUL_G(X16, X16, X12, X3, X4, X5, X6, X7, X8, X9, X0, X1, X2)
+	MUL_G(X12, X12, X13, X3, X4, X5, X6, X7, X8, X9, X0, X1, X2)
+	MUL_G(X16, X12, X16, X3, X4, X5, X6, X7, X8, X9, X0, X1, X2)
+	MUL_G(X16, X13, X16, X3, X4, X5, X6, X7, X8, X9, X0, X1, X2)
+	VPBLENDMQ     Z16, Z10, K1, Z10
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y17, Y11, Y17, Y8, Y9, Y0, Y1)
+	VEXTRACTI64X2 $1, Y17, X14
+	ADD_G(X17, X14, X17, X8, X9, X0, X1)
+	VPSHUFD       $0x000000000000004e, X17, X14
+	ADD_G(X17, X14, X17, X8, X9, X0, X1)
+	VPBROADCASTQ  X17, Z17
+	MUL_G(Z10, Z18, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MUL_G(Y11, Y19, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	ADD_G(Y11, Y17, Y11, Y8, Y9, Y0, Y1)
+	ADDQ          $24, DI
+	JMP           loop_1
+
+done_2:
+	MOVQ          480(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	VMOVDQU64     64(BX), Y14
+	ADD_G(Y11, Y14, Y11, Y8, Y9, Y0, Y1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Y11, Y11, Y12, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y12, Y12, Y13, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y12, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y13, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VPERMQ        $0x00000000000000b1, Y11, Y16
+	ADD_G(Y11, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y11, Y15, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x00000000000000d7, Y15, Y15
+	VPERMQ        $0x0000000000000028, Y14, Y16
+	ADD_G(Y15, Y16, Y15, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x0000000000000005, Y15, Y16
+	ADD_G(Y14, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y15, Y11, Y8, Y9, Y0, Y1)
+	VPBLENDMQ     Y11, Y14, K2, Y11
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y17, Y11, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y17, Y11, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MOVQ          504(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	VMOVDQU64     64(BX), Y14
+	ADD_G(Y11, Y14, Y11, Y8, Y9, Y0, Y1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Y11, Y11, Y12, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y12, Y12, Y13, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y12, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y13, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VPERMQ        $0x00000000000000b1, Y11, Y16
+	ADD_G(Y11, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y11, Y15, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x00000000000000d7, Y15, Y15
+	VPERMQ        $0x0000000000000028, Y14, Y16
+	ADD_G(Y15, Y16, Y15, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x0000000000000005, Y15, Y16
+	ADD_G(Y14, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y15, Y11, Y8, Y9, Y0, Y1)
+	VPBLENDMQ     Y11, Y14, K2, Y11
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y17, Y11, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y17, Y11, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MOVQ          528(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	VMOVDQU64     64(BX), Y14
+	ADD_G(Y11, Y14, Y11, Y8, Y9, Y0, Y1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Y11, Y11, Y12, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y12, Y12, Y13, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y12, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	MUL_G(Y11, Y13, Y11, Y3, Y4, Y5, Y6, Y7, Y8, Y9, Y0, Y1, Y2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VPERMQ        $0x00000000000000b1, Y11, Y16
+	ADD_G(Y11, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y11, Y15, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x00000000000000d7, Y15, Y15
+	VPERMQ        $0x0000000000000028, Y14, Y16
+	ADD_G(Y15, Y16, Y15, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y14, Y14, Y8, Y9, Y0, Y1)
+	VPERMQ        $0x0000000000000005, Y15, Y16
+	ADD_G(Y14, Y16, Y14, Y8, Y9, Y0, Y1)
+	ADD_G(Y14, Y15, Y11, Y8, Y9, Y0, Y1)
+	VPBLENDMQ     Y11, Y14, K2, Y11
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y17, Y11, Y17, Y8, Y9, Y0, Y1)
+	ADD_G(Y11, Y17, Y11, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	VMOVDQU64     Z10, 0(R15)
+	VMOVDQU64     Y11, 64(R15)
+	RET
+
+// permutation8_avx512(input []fr.Element, roundKeys [][]fr.Element)
+TEXT ·permutation8_avx512(SB), NOSPLIT, $0-48
+	LOAD_GOLDILOCKS(Z0, Z1, Z2)
+	MOVQ          $1, AX
+	KMOVQ         AX, K1
+	MOVQ          $0x0000000000000055, AX
+	KMOVQ         AX, K2
+	MOVQ          input+0(FP), R15
+	MOVQ          roundKeys+24(FP), R14
+	VMOVDQU64     0(R15), Z10
+	MOVQ          ·diag8+0(SB), CX
+	VMOVDQU64     0(CX), Z18
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MOVQ          0(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MOVQ          24(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MOVQ          48(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+
+	// loop over the partial rounds
+	MOVQ $0x0000000000000011, SI // nb partial rounds --> 17
+	MOVQ R14, DI
+	ADDQ $0x0000000000000048, DI
+
+loop_3:
+	TESTQ         SI, SI
+	JEQ           done_4
+	DECQ          SI
+	MOVQ          0(DI), BX
+	VMOVQ         0(BX), X14
+	ADD_G(X10, X14, X16, X8, X9, X0, X1)
+	MUL_G(X16, X16, X12, X3, X4, X5, X6, X7, X8, X9, X0, X1, X2)
+	MUL_G(X12, X12, X13, X3, X4, X5, X6, X7, X8, X9, X0, X1, X2)
+	MUL_G(X16, X12, X16, X3, X4, X5, X6, X7, X8, X9, X0, X1, X2)
+	MUL_G(X16, X13, X16, X3, X4, X5, X6, X7, X8, X9, X0, X1, X2)
+	VPBLENDMQ     Z16, Z10, K1, Z10
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	VEXTRACTI64X2 $1, Y17, X14
+	ADD_G(X17, X14, X17, X8, X9, X0, X1)
+	VPSHUFD       $0x000000000000004e, X17, X14
+	ADD_G(X17, X14, X17, X8, X9, X0, X1)
+	VPBROADCASTQ  X17, Z17
+	MUL_G(Z10, Z18, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	ADDQ          $24, DI
+	JMP           loop_3
+
+done_4:
+	MOVQ          480(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MOVQ          504(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	MOVQ          528(R14), BX
+	VMOVDQU64     0(BX), Z14
+	ADD_G(Z10, Z14, Z10, Z8, Z9, Z0, Z1)
+	MUL_G(Z10, Z10, Z12, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z12, Z12, Z13, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z12, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	MUL_G(Z10, Z13, Z10, Z3, Z4, Z5, Z6, Z7, Z8, Z9, Z0, Z1, Z2)
+	VPERMQ        $0x00000000000000b1, Z10, Z16
+	ADD_G(Z10, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z10, Z10, Z15, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x00000000000000d7, Z15, Z15
+	VPERMQ        $0x0000000000000028, Z14, Z16
+	ADD_G(Z15, Z16, Z15, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z14, Z14, Z8, Z9, Z0, Z1)
+	VPERMQ        $0x0000000000000005, Z15, Z16
+	ADD_G(Z14, Z16, Z14, Z8, Z9, Z0, Z1)
+	ADD_G(Z14, Z15, Z10, Z8, Z9, Z0, Z1)
+	VPBLENDMQ     Z10, Z14, K2, Z10
+	VEXTRACTI64X4 $1, Z10, Y17
+	ADD_G(Y17, Y10, Y17, Y8, Y9, Y0, Y1)
+	VINSERTI64X4  $1, Y17, Z17, Z17
+	ADD_G(Z10, Z17, Z10, Z8, Z9, Z0, Z1)
+	VMOVDQU64     Z10, 0(R15)
+	RET
@@ -0,0 +1,20 @@
+//go:build purego || !amd64
+
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package poseidon2
+
+import (
+	fr "github.com/consensys/gnark-crypto/field/goldilocks"
+)
+
+func permutation12_avx512(input []fr.Element, roundKeys [][]fr.Element) {
+	panic("permutation12_avx512 is not implemented")
+}
+
+func permutation8_avx512(input []fr.Element, roundKeys [][]fr.Element) {
+	panic("permutation8_avx512 is not implemented")
+}
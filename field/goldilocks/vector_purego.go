@@ -1,3 +1,5 @@
+//go:build purego || !amd64
+
 // Copyright 2020-2025 Consensys Software Inc.
 // Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
 
@@ -38,9 +38,23 @@ func main() {
 			generator.WithSIS(),
 			generator.WithPoseidon2(),
 			generator.WithExtensions(),
+			generator.WithFRI(),
 		); err != nil {
 			panic(err)
 		}
 		fmt.Println("successfully generated", f.name, "field")
 	}
+
+	// 2^31 - 1 ==> p+1 is a power of 2 but p-1 has 2-adicity 1, the FFT and the extensions
+	// are over the circle group (see m31/circle and m31/extensions)
+	fc, err := config.NewFieldConfig("m31", "Element", "0x7fffffff", true)
+	if err != nil {
+		panic(err)
+	}
+	if err := generator.GenerateFF(fc, filepath.Join("..", "m31"),
+		generator.WithASM(&config.Assembly{BuildDir: asmDirIncludePath, IncludeDir: asmDirIncludePath}),
+	); err != nil {
+		panic(err)
+	}
+	fmt.Println("successfully generated m31 field")
 }
@@ -0,0 +1,123 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package fri
+
+import (
+	"errors"
+
+	fr "github.com/consensys/gnark-crypto/field/koalabear"
+	"github.com/consensys/gnark-crypto/field/koalabear/poseidon2"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+var ErrMerklePath = errors.New("merkle path proof is wrong")
+
+var (
+	// compressPerm is the Poseidon2 permutation compressing two nodes of the Merkle trees.
+	compressPerm = poseidon2.NewPermutation(16, 6, 21)
+	// spongePerm is the Poseidon2 permutation hashing the leaves of the Merkle trees.
+	spongePerm = poseidon2.NewPermutation(24, 6, 21)
+)
+
+// Hash node of a Merkle tree.
+type Hash = [8]fr.Element
+
+// MerkleProof authentication path of a leaf, from the sibling of the leaf up
+// to the child of the root.
+type MerkleProof []Hash
+
+// merkleTree stores all the nodes of a Merkle tree whose number of leaves is a power
+// of two; levels[0] contains the root and levels[depth] the leaves.
+type merkleTree struct {
+	levels [][]Hash
+}
+
+// compress returns the Poseidon2 compression of two nodes.
+func compress(a, b Hash) Hash {
+	var x [16]fr.Element
+	copy(x[:], a[:])
+	copy(x[8:], b[:])
+	if err := compressPerm.Permutation(x[:]); err != nil {
+		// can't error (size is correct)
+		panic(err)
+	}
+	var res Hash
+	copy(res[:], x[:8])
+	return res
+}
+
+// hashLeaf returns the Poseidon2 sponge hash of x. The last block is zero-padded,
+// which is sound since all the leaves of a tree have the same length.
+func hashLeaf(x []fr.Element) Hash {
+	const rate = 24 - 8
+	var state [24]fr.Element
+	for i := 0; i < len(x); i += rate {
+		block := state[8:]
+		n := copy(block, x[i:])
+		for j := n; j < rate; j++ {
+			block[j].SetZero()
+		}
+		if err := spongePerm.Permutation(state[:]); err != nil {
+			// can't error (size is correct)
+			panic(err)
+		}
+	}
+	var res Hash
+	copy(res[:], state[:8])
+	return res
+}
+
+// newMerkleTree builds the Merkle tree whose leaves are the given hashes.
+// len(leaves) must be a power of two.
+func newMerkleTree(leaves []Hash) *merkleTree {
+	depth := 0
+	for (1 << depth) < len(leaves) {
+		depth++
+	}
+	levels := make([][]Hash, depth+1)
+	levels[depth] = leaves
+	for i := depth - 1; i >= 0; i-- {
+		levels[i] = make([]Hash, len(levels[i+1])/2)
+		parallel.Execute(len(levels[i]), func(start, end int) {
+			for k := start; k < end; k++ {
+				levels[i][k] = compress(levels[i+1][2*k], levels[i+1][2*k+1])
+			}
+		}, min(len(levels[i])/512+1, 64))
+	}
+	return &merkleTree{levels: levels}
+}
+
+// root returns the root of the tree.
+func (t *merkleTree) root() Hash {
+	return t.levels[0][0]
+}
+
+// open returns the authentication path of the i-th leaf.
+func (t *merkleTree) open(i int) MerkleProof {
+	res := make(MerkleProof, 0, len(t.levels)-1)
+	for level := len(t.levels) - 1; level > 0; level-- {
+		res = append(res, t.levels[level][i^1])
+		i >>= 1
+	}
+	return res
+}
+
+// verify checks that leaf is the i-th leaf of the tree of the given root.
+func (proof MerkleProof) verify(i int, leaf, root Hash) error {
+	cur := leaf
+	for _, h := range proof {
+		if i&1 == 1 {
+			cur = compress(h, cur)
+		} else {
+			cur = compress(cur, h)
+		}
+		i >>= 1
+	}
+	if cur != root {
+		return ErrMerklePath
+	}
+	return nil
+}
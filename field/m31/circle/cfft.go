@@ -0,0 +1,105 @@
+package circle
+
+import (
+	fr "github.com/consensys/gnark-crypto/field/m31"
+	"github.com/consensys/gnark-crypto/field/m31/extensions"
+	"github.com/consensys/gnark-crypto/internal/parallel"
+)
+
+// FFT evaluates on the domain the polynomial whose coefficients in the FFT basis are a.
+// a is overwritten with the evaluations, in bit-reversed order.
+func (d *Domain) FFT(a []fr.Element) {
+	if len(a) != d.Size() {
+		panic("len(a) must be the size of the domain")
+	}
+	for k := len(d.twiddles) - 1; k >= 0; k-- {
+		layer(a, k, d.twiddles[k], butterfly)
+	}
+}
+
+// FFTInverse interpolates the evaluations a on the domain, in bit-reversed order.
+// a is overwritten with the coefficients of the polynomial in the FFT basis.
+func (d *Domain) FFTInverse(a []fr.Element) {
+	if len(a) != d.Size() {
+		panic("len(a) must be the size of the domain")
+	}
+	for k := range d.twiddlesInv {
+		layer(a, k, d.twiddlesInv[k], butterflyInverse)
+	}
+	parallel.Execute(len(a), func(start, end int) {
+		for i := start; i < end; i++ {
+			a[i].Mul(&a[i], &d.cardinalityInv)
+		}
+	}, nbTasks(len(a)))
+}
+
+// layer applies the butterflies of the k-th layer: for each block r of size 2ᵏ⁺¹,
+// the entries at distance 2ᵏ are combined with the twiddle twiddles[r].
+func layer(a []fr.Element, k int, twiddles []fr.Element, bf func(a0, a1, t *fr.Element)) {
+	m := 1 << k
+	parallel.Execute(len(twiddles), func(start, end int) {
+		for r := start; r < end; r++ {
+			block := a[2*m*r : 2*m*(r+1)]
+			for c := 0; c < m; c++ {
+				bf(&block[c], &block[c+m], &twiddles[r])
+			}
+		}
+	}, nbTasks(len(a)))
+}
+
+// butterfly sets (a0, a1) = (a0 + t·a1, a0 - t·a1)
+func butterfly(a0, a1, t *fr.Element) {
+	var ta1 fr.Element
+	ta1.Mul(a1, t)
+	a1.Sub(a0, &ta1)
+	a0.Add(a0, &ta1)
+}
+
+// butterflyInverse sets (a0, a1) = (a0 + a1, (a0 - a1)·t)
+func butterflyInverse(a0, a1, t *fr.Element) {
+	var d fr.Element
+	d.Sub(a0, a1)
+	a0.Add(a0, a1)
+	a1.Mul(&d, t)
+}
+
+// nbTasks returns the number of tasks used for a layer of n elements
+func nbTasks(n int) int {
+	return min(n/(1<<13)+1, 64)
+}
+
+// EvalAtPoint returns the evaluation at p of the polynomial whose coefficients in
+// the FFT basis are coeffs. len(coeffs) must be a power of 2.
+func EvalAtPoint(coeffs []fr.Element, p *PointQM31) extensions.QM31 {
+	n := len(coeffs)
+	if n&(n-1) != 0 || n == 0 {
+		panic("len(coeffs) must be a power of 2")
+	}
+
+	// fold the j-th bit of the indices with y, x, π(x), π²(x), …
+	v := make([]extensions.QM31, n/2)
+	factor := p.Y
+	x := p.X
+	for i := range v {
+		v[i].MulByElement(&factor, &coeffs[2*i+1])
+		v[i].B0.A0.Add(&v[i].B0.A0, &coeffs[2*i])
+	}
+	for len(v) > 1 {
+		factor = x
+		x.Square(&x).Double(&x).Sub(&x, &oneQM31)
+		for i := 0; i < len(v)/2; i++ {
+			var t extensions.QM31
+			t.Mul(&v[2*i+1], &factor)
+			v[i].Add(&v[2*i], &t)
+		}
+		v = v[:len(v)/2]
+	}
+	if len(v) == 0 {
+		var res extensions.QM31
+		res.SetElement(&coeffs[0])
+		return res
+	}
+	return v[0]
+}
+
+var oneQM31 = *new(extensions.QM31).SetOne()
@@ -0,0 +1,228 @@
+package circle
+
+import (
+	"math/big"
+	"testing"
+
+	fr "github.com/consensys/gnark-crypto/field/m31"
+	"github.com/consensys/gnark-crypto/field/m31/extensions"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerator(t *testing.T) {
+	assert := require.New(t)
+
+	g := Generator()
+	assert.True(g.IsOnCircle())
+
+	var p, minusOne Point
+	p.ScalarMul(&g, 1<<(LogOrder-1))
+	minusOne.SetIdentity().Antipode(&minusOne)
+	assert.True(p.Equal(&minusOne), "the generator should be of order 2³¹")
+	p.Double(&p)
+	assert.True(p.IsIdentity())
+
+	var q Point
+	p = IndexToPoint(1234567)
+	q = IndexToPoint(7654321)
+	p.Add(&p, &q)
+	q = IndexToPoint(1234567 + 7654321)
+	assert.True(p.Equal(&q))
+	p.Neg(&q)
+	q = IndexToPoint(-(1234567 + 7654321) & indexMask)
+	assert.True(p.Equal(&q))
+}
+
+// SECURE_FIELD_CIRCLE_GEN from Stwo
+func TestGeneratorQM31(t *testing.T) {
+	assert := require.New(t)
+
+	g := GeneratorQM31()
+	assert.True(g.IsOnCircle())
+
+	// the circle group over QM31 is of order p⁴-1
+	var order big.Int
+	order.SetUint64(2147483647).Exp(&order, big.NewInt(4), nil).Sub(&order, big.NewInt(1))
+	var p PointQM31
+	p.ScalarMul(&g, &order)
+	assert.True(p.IsIdentity())
+	p.ScalarMul(&g, big.NewInt(1<<LogOrder))
+	assert.False(p.IsIdentity())
+}
+
+// test_coset_is_half_coset_with_conjugate from Stwo
+func TestCanonicCosetIsHalfCosetWithConjugate(t *testing.T) {
+	assert := require.New(t)
+
+	const logSize = 8
+	canonic := OddsCoset(logSize).Points()
+	domain := NewStandardDomain(logSize)
+
+	half := make(map[Point]bool)
+	for _, p := range domain.HalfCoset.Points() {
+		half[p] = true
+	}
+	for _, p := range domain.HalfCoset.Conjugate().Points() {
+		assert.False(half[p], "the half coset and its conjugate should be disjoint")
+	}
+
+	inCanonic := make(map[Point]bool)
+	for _, p := range canonic {
+		inCanonic[p] = true
+	}
+	points := domain.Points()
+	assert.Len(points, len(canonic))
+	for i, p := range points {
+		assert.True(inCanonic[p])
+		q := domain.At(i)
+		assert.True(p.Equal(&q))
+	}
+}
+
+// test_eval_at_point_with_4_coeffs and test_eval_at_point_with_8_coeffs from Stwo
+func TestEvalAtPointStwo(t *testing.T) {
+	assert := require.New(t)
+
+	var x, y, pi extensions.QM31
+	x.SetUint64(5, 0, 0, 0)
+	y.SetUint64(8, 0, 0, 0)
+	p := PointQM31{X: x, Y: y}
+	pi.Square(&x).Double(&pi).Sub(&pi, &oneQM31)
+
+	coeffs := make([]fr.Element, 8)
+	for i, c := range []uint64{1, 3, 2, 4, 5, 7, 6, 8} {
+		coeffs[i].SetUint64(c)
+	}
+
+	// c₀ + c₁·y + c₂·x + c₃·x·y (+ π(x)·(c₄ + c₅·y + c₆·x + c₇·x·y))
+	expected := func(c []fr.Element) extensions.QM31 {
+		var res, t extensions.QM31
+		res.SetElement(&c[0])
+		res.Add(&res, t.MulByElement(&y, &c[1]))
+		res.Add(&res, t.MulByElement(&x, &c[2]))
+		res.Add(&res, t.Mul(&x, &y).MulByElement(&t, &c[3]))
+		return res
+	}
+
+	res := EvalAtPoint(coeffs[:4], &p)
+	e := expected(coeffs[:4])
+	assert.True(res.Equal(&e))
+
+	res = EvalAtPoint(coeffs, &p)
+	e1 := expected(coeffs[4:])
+	e1.Mul(&e1, &pi).Add(&e1, &e)
+	assert.True(res.Equal(&e1))
+}
+
+func TestFFT(t *testing.T) {
+	assert := require.New(t)
+
+	for logSize := uint(1); logSize <= 10; logSize++ {
+		domain := NewStandardDomain(logSize)
+		coeffs := make([]fr.Element, domain.Size())
+		for i := range coeffs {
+			coeffs[i].MustSetRandom()
+		}
+		evals := make([]fr.Element, len(coeffs))
+		copy(evals, coeffs)
+		domain.FFT(evals)
+
+		// evaluations are in bit-reversed order
+		BitReverse(evals)
+		points := domain.Points()
+		for i := range points {
+			var p PointQM31
+			p.SetPoint(&points[i])
+			e := EvalAtPoint(coeffs, &p)
+			assert.True(e.B1.IsZero() && e.B0.A1.IsZero() && e.B0.A0.Equal(&evals[i]), "log size %d, point %d", logSize, i)
+		}
+
+		BitReverse(evals)
+		domain.FFTInverse(evals)
+		assert.Equal(coeffs, evals)
+	}
+}
+
+// test_interpolate_non_canonic from Stwo
+func TestFFTTwinCoset(t *testing.T) {
+	assert := require.New(t)
+
+	domain, err := NewDomain(NewCoset(1, 2))
+	assert.NoError(err)
+	assert.Equal(uint(3), domain.LogSize())
+
+	evals := make([]fr.Element, 8)
+	for i := range evals {
+		evals[i].SetUint64(uint64(i))
+	}
+	BitReverse(evals)
+	domain.FFTInverse(evals)
+	for i, point := range domain.Points() {
+		var p PointQM31
+		p.SetPoint(&point)
+		e := EvalAtPoint(evals, &p)
+		var expected extensions.QM31
+		expected.SetUint64(uint64(i), 0, 0, 0)
+		assert.True(e.Equal(&expected))
+	}
+
+	_, err = NewDomain(Subgroup(3))
+	assert.ErrorIs(err, ErrNotTwinCoset)
+	_, err = NewDomain(OddsCoset(3))
+	assert.ErrorIs(err, ErrNotTwinCoset)
+}
+
+func TestFFTExtend(t *testing.T) {
+	assert := require.New(t)
+
+	small := NewStandardDomain(4)
+	large := NewStandardDomain(7)
+
+	evals := make([]fr.Element, small.Size())
+	for i := range evals {
+		evals[i].MustSetRandom()
+	}
+	coeffs := make([]fr.Element, small.Size())
+	copy(coeffs, evals)
+	small.FFTInverse(coeffs)
+
+	// the first basis polynomials of a larger domain are the basis of the smaller one
+	extended := make([]fr.Element, large.Size())
+	copy(extended, coeffs)
+	large.FFT(extended)
+	BitReverse(extended)
+	for i, point := range large.Points() {
+		var p PointQM31
+		p.SetPoint(&point)
+		e := EvalAtPoint(coeffs, &p)
+		assert.True(e.B0.A0.Equal(&extended[i]))
+	}
+
+	// the interpolation matches the evaluations on the small domain
+	BitReverse(evals)
+	for i, point := range small.Points() {
+		var p PointQM31
+		p.SetPoint(&point)
+		e := EvalAtPoint(coeffs, &p)
+		assert.True(e.B0.A0.Equal(&evals[i]))
+	}
+}
+
+func BenchmarkFFT(b *testing.B) {
+	const logSize = 20
+	domain := NewStandardDomain(logSize)
+	a := make([]fr.Element, domain.Size())
+	for i := range a {
+		a[i].MustSetRandom()
+	}
+	b.Run("FFT", func(b *testing.B) {
+		for range b.N {
+			domain.FFT(a)
+		}
+	})
+	b.Run("FFTInverse", func(b *testing.B) {
+		for range b.N {
+			domain.FFTInverse(a)
+		}
+	})
+}
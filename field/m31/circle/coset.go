@@ -0,0 +1,103 @@
+package circle
+
+import (
+	"errors"
+)
+
+var (
+	ErrInvalidLogSize = errors.New("invalid log size")
+	ErrNotTwinCoset   = errors.New("the half coset intersects its conjugate")
+)
+
+// indexMask reduces an index modulo the order 2³¹ of the circle group
+const indexMask = 1<<LogOrder - 1
+
+// subgroupGeneratorIndex returns the index of a generator of the subgroup of order 2^logSize
+func subgroupGeneratorIndex(logSize uint) uint32 {
+	return 1 << (LogOrder - logSize)
+}
+
+// Coset coset {InitialIndex + i·StepIndex, 0 ⩽ i < 2^LogSize} of the circle group,
+// where StepIndex is the index of a generator of the subgroup of order 2^LogSize.
+type Coset struct {
+	InitialIndex uint32
+	StepIndex    uint32
+	LogSize      uint
+}
+
+// NewCoset returns the coset of the subgroup of order 2^logSize containing the point
+// of index initialIndex.
+func NewCoset(initialIndex uint32, logSize uint) Coset {
+	if logSize > LogOrder {
+		panic(ErrInvalidLogSize)
+	}
+	return Coset{
+		InitialIndex: initialIndex & indexMask,
+		StepIndex:    subgroupGeneratorIndex(logSize),
+		LogSize:      logSize,
+	}
+}
+
+// Subgroup returns the subgroup of order 2^logSize.
+func Subgroup(logSize uint) Coset {
+	return NewCoset(0, logSize)
+}
+
+// OddsCoset returns the coset G₂ₙ₊₁ + Gₙ of size 2ⁿ, n = logSize, where Gₖ is the
+// subgroup of order 2ᵏ; it is the canonic coset of size 2ⁿ of Stwo.
+func OddsCoset(logSize uint) Coset {
+	return NewCoset(subgroupGeneratorIndex(logSize+1), logSize)
+}
+
+// HalfOddsCoset returns the coset of size 2ⁿ, n = logSize, whose union with its
+// conjugate is OddsCoset(logSize+1).
+func HalfOddsCoset(logSize uint) Coset {
+	return NewCoset(subgroupGeneratorIndex(logSize+2), logSize)
+}
+
+// Size returns the number of points of the coset
+func (c Coset) Size() int {
+	return 1 << c.LogSize
+}
+
+// IndexAt returns the index of the i-th point of the coset
+func (c Coset) IndexAt(i int) uint32 {
+	return (c.InitialIndex + c.StepIndex*uint32(i)) & indexMask
+}
+
+// At returns the i-th point of the coset
+func (c Coset) At(i int) Point {
+	return IndexToPoint(c.IndexAt(i))
+}
+
+// Points returns the points of the coset, in natural order
+func (c Coset) Points() []Point {
+	res := make([]Point, c.Size())
+	step := IndexToPoint(c.StepIndex)
+	res[0] = IndexToPoint(c.InitialIndex)
+	for i := 1; i < len(res); i++ {
+		res[i].Add(&res[i-1], &step)
+	}
+	return res
+}
+
+// Conjugate returns the coset -c
+func (c Coset) Conjugate() Coset {
+	return Coset{
+		InitialIndex: -c.InitialIndex & indexMask,
+		StepIndex:    -c.StepIndex & indexMask,
+		LogSize:      c.LogSize,
+	}
+}
+
+// Double returns the coset 2c, of half size
+func (c Coset) Double() Coset {
+	if c.LogSize == 0 {
+		panic(ErrInvalidLogSize)
+	}
+	return Coset{
+		InitialIndex: (2 * c.InitialIndex) & indexMask,
+		StepIndex:    (2 * c.StepIndex) & indexMask,
+		LogSize:      c.LogSize - 1,
+	}
+}
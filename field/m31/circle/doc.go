@@ -0,0 +1,21 @@
+// Package circle implements the circle group over the Mersenne-31 field and
+// the circle FFT (CFFT) of [Circle STARKs] by Haböck, Levit and Papini.
+//
+// The circle x² + y² = 1 over 𝔽p, p = 2³¹-1, is a cyclic group of order p+1 = 2³¹.
+// As in Stwo, the group law is written additively and points are referred to
+// by their index in the group generated by Generator.
+//
+// A Domain is a twin coset H ∪ -H, where H is a coset of a subgroup of order
+// 2ⁿ⁻¹ such that H and its conjugate -H are disjoint. The standard domain of
+// size 2ⁿ is the circle domain of Stwo's canonic coset of size 2ⁿ.
+//
+// Polynomials are given by their coefficients in the FFT basis
+//
+//	bⱼ(x, y) = y^j₀ · x^j₁ · π(x)^j₂ · … · πⁿ⁻²(x)^jₙ₋₁
+//
+// where jₖ is the k-th bit of j and π(x) = 2x²-1 is the x-coordinate of the
+// doubling map. Evaluations are in bit-reversed order of the domain, the
+// conventions of the Stwo prover.
+//
+// [Circle STARKs]: https://eprint.iacr.org/2024/278
+package circle
@@ -0,0 +1,130 @@
+package circle
+
+import (
+	"math/bits"
+
+	fr "github.com/consensys/gnark-crypto/field/m31"
+)
+
+// Domain circle domain H ∪ -H of size 2ⁿ, where the half coset H is of size 2ⁿ⁻¹.
+//
+// The i-th point of the domain is the i-th point of H if i < 2ⁿ⁻¹, and the conjugate
+// of the (i-2ⁿ⁻¹)-th point of H otherwise.
+type Domain struct {
+	HalfCoset Coset
+
+	// twiddles[0] are the y-coordinates of the points of H, and twiddles[k] for k > 0
+	// the x-coordinates of half of the points of 2ᵏ⁻¹H, in bit-reversed order.
+	twiddles    [][]fr.Element
+	twiddlesInv [][]fr.Element
+
+	// cardinalityInv 2⁻ⁿ
+	cardinalityInv fr.Element
+}
+
+// NewDomain returns the domain halfCoset ∪ -halfCoset, or an error if halfCoset
+// and its conjugate intersect.
+func NewDomain(halfCoset Coset) (*Domain, error) {
+	if halfCoset.LogSize >= LogOrder-1 || (2*halfCoset.InitialIndex)%halfCoset.StepIndex == 0 {
+		return nil, ErrNotTwinCoset
+	}
+	d := &Domain{HalfCoset: halfCoset}
+	d.cardinalityInv.SetUint64(uint64(d.Size())).Inverse(&d.cardinalityInv)
+	d.preComputeTwiddles()
+	return d, nil
+}
+
+// NewStandardDomain returns the circle domain of size 2^logSize of the canonic
+// coset OddsCoset(logSize), i.e. the domain of HalfOddsCoset(logSize-1).
+func NewStandardDomain(logSize uint) *Domain {
+	if logSize < 1 || logSize >= LogOrder {
+		panic(ErrInvalidLogSize)
+	}
+	d, err := NewDomain(HalfOddsCoset(logSize - 1))
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// LogSize returns log₂ of the size of the domain
+func (d *Domain) LogSize() uint {
+	return d.HalfCoset.LogSize + 1
+}
+
+// Size returns the size of the domain
+func (d *Domain) Size() int {
+	return 2 * d.HalfCoset.Size()
+}
+
+// IndexAt returns the index of the i-th point of the domain
+func (d *Domain) IndexAt(i int) uint32 {
+	if m := d.HalfCoset.Size(); i >= m {
+		return -d.HalfCoset.IndexAt(i-m) & indexMask
+	}
+	return d.HalfCoset.IndexAt(i)
+}
+
+// At returns the i-th point of the domain
+func (d *Domain) At(i int) Point {
+	return IndexToPoint(d.IndexAt(i))
+}
+
+// Points returns the points of the domain, in natural order
+func (d *Domain) Points() []Point {
+	half := d.HalfCoset.Points()
+	res := make([]Point, 2*len(half))
+	copy(res, half)
+	for i := range half {
+		res[len(half)+i].Neg(&half[i])
+	}
+	return res
+}
+
+func (d *Domain) preComputeTwiddles() {
+	n := d.LogSize()
+	d.twiddles = make([][]fr.Element, n)
+
+	// circle layer: y-coordinates of H
+	coset := d.HalfCoset
+	points := coset.Points()
+	BitReverse(points)
+	d.twiddles[0] = make([]fr.Element, len(points))
+	for i := range points {
+		d.twiddles[0][i] = points[i].Y
+	}
+
+	// line layers: x-coordinates of 2ᵏ⁻¹H, up to the antipode
+	for k := 1; k < int(n); k++ {
+		if k > 1 {
+			coset = coset.Double()
+			points = coset.Points()
+			BitReverse(points)
+		}
+		d.twiddles[k] = make([]fr.Element, len(points)/2)
+		for i := range d.twiddles[k] {
+			d.twiddles[k][i] = points[2*i].X
+		}
+	}
+
+	d.twiddlesInv = make([][]fr.Element, n)
+	for k := range d.twiddles {
+		d.twiddlesInv[k] = fr.BatchInvert(d.twiddles[k])
+	}
+}
+
+// BitReverse applies the bit-reversal permutation to v.
+// len(v) must be a power of 2
+func BitReverse[T any](v []T) {
+	n := uint64(len(v))
+	if bits.OnesCount64(n) != 1 {
+		panic("len(v) must be a power of 2")
+	}
+	nn := uint64(64 - bits.TrailingZeros64(n))
+	for i := uint64(0); i < n; i++ {
+		iRev := bits.Reverse64(i) >> nn
+		if iRev > i {
+			v[i], v[iRev] = v[iRev], v[i]
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package circle
+
+import (
+	"math/big"
+
+	fr "github.com/consensys/gnark-crypto/field/m31"
+	"github.com/consensys/gnark-crypto/field/m31/extensions"
+)
+
+// LogOrder log₂ of the order of the circle group over 𝔽p.
+const LogOrder = 31
+
+// Point point (x, y) of the circle x² + y² = 1 over 𝔽p.
+type Point struct {
+	X, Y fr.Element
+}
+
+// PointQM31 point (x, y) of the circle x² + y² = 1 over QM31.
+type PointQM31 struct {
+	X, Y extensions.QM31
+}
+
+// Generator returns the generator (2, 1268011823) of the circle group over 𝔽p,
+// of order 2³¹.
+func Generator() Point {
+	var res Point
+	res.X.SetUint64(2)
+	res.Y.SetUint64(1268011823)
+	return res
+}
+
+// GeneratorQM31 returns the generator of the circle group over QM31 used by Stwo
+// to sample out of domain points.
+func GeneratorQM31() PointQM31 {
+	var res PointQM31
+	res.X.SetUint64(1, 0, 478637715, 513582971)
+	res.Y.SetUint64(992285211, 649143431, 740191619, 1186584352)
+	return res
+}
+
+// IndexToPoint returns the point index·Generator().
+func IndexToPoint(index uint32) Point {
+	g := Generator()
+	var res Point
+	return *res.ScalarMul(&g, uint64(index%(1<<LogOrder)))
+}
+
+// SetIdentity sets p to the neutral element (1, 0) and returns p
+func (p *Point) SetIdentity() *Point {
+	p.X.SetOne()
+	p.Y.SetZero()
+	return p
+}
+
+// IsIdentity returns true if p is the neutral element
+func (p *Point) IsIdentity() bool {
+	return p.X.IsOne() && p.Y.IsZero()
+}
+
+// Equal returns true if p equals q
+func (p *Point) Equal(q *Point) bool {
+	return p.X.Equal(&q.X) && p.Y.Equal(&q.Y)
+}
+
+// IsOnCircle returns true if x² + y² = 1
+func (p *Point) IsOnCircle() bool {
+	var a, b fr.Element
+	a.Square(&p.X)
+	b.Square(&p.Y)
+	return a.Add(&a, &b).IsOne()
+}
+
+// Add sets p = q + r = (x_q·x_r - y_q·y_r, x_q·y_r + y_q·x_r) and returns p
+func (p *Point) Add(q, r *Point) *Point {
+	var x, y, t fr.Element
+	x.Mul(&q.X, &r.X)
+	t.Mul(&q.Y, &r.Y)
+	x.Sub(&x, &t)
+	y.Mul(&q.X, &r.Y)
+	t.Mul(&q.Y, &r.X)
+	y.Add(&y, &t)
+	p.X, p.Y = x, y
+	return p
+}
+
+// Double sets p = 2q = (2x²-1, 2xy) and returns p
+func (p *Point) Double(q *Point) *Point {
+	var x, y fr.Element
+	x.Square(&q.X).Double(&x).Sub(&x, &one)
+	y.Mul(&q.X, &q.Y).Double(&y)
+	p.X, p.Y = x, y
+	return p
+}
+
+// Neg sets p = -q = (x, -y), the conjugate of q, and returns p
+func (p *Point) Neg(q *Point) *Point {
+	p.X.Set(&q.X)
+	p.Y.Neg(&q.Y)
+	return p
+}
+
+// Antipode sets p = q + (-1, 0) = (-x, -y) and returns p
+func (p *Point) Antipode(q *Point) *Point {
+	p.X.Neg(&q.X)
+	p.Y.Neg(&q.Y)
+	return p
+}
+
+// ScalarMul sets p = k·q and returns p
+func (p *Point) ScalarMul(q *Point, k uint64) *Point {
+	var res, acc Point
+	res.SetIdentity()
+	acc = *q
+	for ; k != 0; k >>= 1 {
+		if k&1 == 1 {
+			res.Add(&res, &acc)
+		}
+		acc.Double(&acc)
+	}
+	*p = res
+	return p
+}
+
+// SetPoint sets p to q, embedded from 𝔽p, and returns p
+func (p *PointQM31) SetPoint(q *Point) *PointQM31 {
+	p.X.SetElement(&q.X)
+	p.Y.SetElement(&q.Y)
+	return p
+}
+
+// SetIdentity sets p to the neutral element (1, 0) and returns p
+func (p *PointQM31) SetIdentity() *PointQM31 {
+	p.X.SetOne()
+	p.Y.SetZero()
+	return p
+}
+
+// IsIdentity returns true if p is the neutral element
+func (p *PointQM31) IsIdentity() bool {
+	return p.X.IsOne() && p.Y.IsZero()
+}
+
+// Equal returns true if p equals q
+func (p *PointQM31) Equal(q *PointQM31) bool {
+	return p.X.Equal(&q.X) && p.Y.Equal(&q.Y)
+}
+
+// IsOnCircle returns true if x² + y² = 1
+func (p *PointQM31) IsOnCircle() bool {
+	var a, b extensions.QM31
+	a.Square(&p.X)
+	b.Square(&p.Y)
+	return a.Add(&a, &b).IsOne()
+}
+
+// Add sets p = q + r and returns p
+func (p *PointQM31) Add(q, r *PointQM31) *PointQM31 {
+	var x, y, t extensions.QM31
+	x.Mul(&q.X, &r.X)
+	t.Mul(&q.Y, &r.Y)
+	x.Sub(&x, &t)
+	y.Mul(&q.X, &r.Y)
+	t.Mul(&q.Y, &r.X)
+	y.Add(&y, &t)
+	p.X, p.Y = x, y
+	return p
+}
+
+// Neg sets p = -q = (x, -y) and returns p
+func (p *PointQM31) Neg(q *PointQM31) *PointQM31 {
+	p.X.Set(&q.X)
+	p.Y.Neg(&q.Y)
+	return p
+}
+
+// ScalarMul sets p = k·q and returns p
+func (p *PointQM31) ScalarMul(q *PointQM31, k *big.Int) *PointQM31 {
+	var res, acc PointQM31
+	res.SetIdentity()
+	acc = *q
+	if k.Sign() < 0 {
+		acc.Neg(&acc)
+	}
+	for i := 0; i < k.BitLen(); i++ {
+		if k.Bit(i) == 1 {
+			res.Add(&res, &acc)
+		}
+		acc.Add(&acc, &acc)
+	}
+	*p = res
+	return p
+}
+
+var one = fr.One()
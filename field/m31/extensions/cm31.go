@@ -0,0 +1,214 @@
+package extensions
+
+import (
+	"math/big"
+
+	fr "github.com/consensys/gnark-crypto/field/m31"
+)
+
+// CM31 is the complex extension a0 + a1·i of the Mersenne-31 field, with i² = -1.
+type CM31 struct {
+	A0, A1 fr.Element
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *CM31) Equal(x *CM31) bool {
+	return z.A0.Equal(&x.A0) && z.A1.Equal(&x.A1)
+}
+
+// String returns the element as a0+a1*i
+func (z *CM31) String() string {
+	return z.A0.String() + "+" + z.A1.String() + "*i"
+}
+
+// SetString sets a CM31 element from strings
+func (z *CM31) SetString(s0, s1 string) *CM31 {
+	z.A0.SetString(s0)
+	z.A1.SetString(s1)
+	return z
+}
+
+// SetUint64 sets z to a0 + a1·i
+func (z *CM31) SetUint64(a0, a1 uint64) *CM31 {
+	z.A0.SetUint64(a0)
+	z.A1.SetUint64(a1)
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *CM31) Set(x *CM31) *CM31 {
+	*z = *x
+	return z
+}
+
+// SetZero sets z to 0 and returns z
+func (z *CM31) SetZero() *CM31 {
+	z.A0.SetZero()
+	z.A1.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *CM31) SetOne() *CM31 {
+	z.A0.SetOne()
+	z.A1.SetZero()
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *CM31) IsZero() bool {
+	return z.A0.IsZero() && z.A1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *CM31) IsOne() bool {
+	return z.A0.IsOne() && z.A1.IsZero()
+}
+
+// SetRandom sets z to a random element
+func (z *CM31) SetRandom() (*CM31, error) {
+	if _, err := z.A0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.A1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// MustSetRandom sets the element to a random value.
+// It panics if reading from crypto/rand fails.
+func (z *CM31) MustSetRandom() *CM31 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// Add sets z = x + y and returns z
+func (z *CM31) Add(x, y *CM31) *CM31 {
+	z.A0.Add(&x.A0, &y.A0)
+	z.A1.Add(&x.A1, &y.A1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *CM31) Sub(x, y *CM31) *CM31 {
+	z.A0.Sub(&x.A0, &y.A0)
+	z.A1.Sub(&x.A1, &y.A1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *CM31) Double(x *CM31) *CM31 {
+	z.A0.Double(&x.A0)
+	z.A1.Double(&x.A1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *CM31) Neg(x *CM31) *CM31 {
+	z.A0.Neg(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Conjugate sets z = a0 - a1·i where x = a0 + a1·i and returns z
+func (z *CM31) Conjugate(x *CM31) *CM31 {
+	z.A0.Set(&x.A0)
+	z.A1.Neg(&x.A1)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *CM31) Mul(x, y *CM31) *CM31 {
+	var a, b, c fr.Element
+	a.Add(&x.A0, &x.A1)
+	b.Add(&y.A0, &y.A1)
+	a.Mul(&a, &b)
+	b.Mul(&x.A0, &y.A0)
+	c.Mul(&x.A1, &y.A1)
+	z.A1.Sub(&a, &b).Sub(&z.A1, &c)
+	z.A0.Sub(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *CM31) Square(x *CM31) *CM31 {
+	// (a0 + a1·i)² = (a0 + a1)(a0 - a1) + 2·a0·a1·i
+	var a, b fr.Element
+	a.Add(&x.A0, &x.A1)
+	b.Sub(&x.A0, &x.A1)
+	a.Mul(&a, &b)
+	z.A1.Mul(&x.A0, &x.A1).Double(&z.A1)
+	z.A0.Set(&a)
+	return z
+}
+
+// MulByElement sets z = x·y where y is in the base field and returns z
+func (z *CM31) MulByElement(x *CM31, y *fr.Element) *CM31 {
+	z.A0.Mul(&x.A0, y)
+	z.A1.Mul(&x.A1, y)
+	return z
+}
+
+// MulByNonResidue sets z = x·(2+i), the non residue defining QM31, and returns z
+func (z *CM31) MulByNonResidue(x *CM31) *CM31 {
+	// (a0 + a1·i)(2 + i) = 2·a0 - a1 + (a0 + 2·a1)·i
+	var a0, a1 fr.Element
+	a0.Double(&x.A0).Sub(&a0, &x.A1)
+	a1.Double(&x.A1).Add(&a1, &x.A0)
+	z.A0, z.A1 = a0, a1
+	return z
+}
+
+// Norm returns a0² + a1², the norm of x over the base field
+func (z *CM31) Norm() fr.Element {
+	var a, b fr.Element
+	a.Square(&z.A0)
+	b.Square(&z.A1)
+	return *a.Add(&a, &b)
+}
+
+// Inverse sets z = x⁻¹ and returns z; the inverse of 0 is 0
+func (z *CM31) Inverse(x *CM31) *CM31 {
+	n := x.Norm()
+	n.Inverse(&n)
+	z.Conjugate(x)
+	z.MulByElement(z, &n)
+	return z
+}
+
+// Exp sets z = xᵏ (mod q²) and returns it
+func (z *CM31) Exp(x CM31, k *big.Int) *CM31 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod q²) == (x⁻¹)ᵏ (mod q²)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
@@ -0,0 +1,7 @@
+// Package extensions implements the arithmetic of the complex extension CM31
+// and of the degree 4 extension QM31 of the Mersenne-31 field, with the
+// same towering as Stwo:
+//
+//	CM31 = 𝔽p[i]/(i²+1)
+//	QM31 = CM31[u]/(u²-2-i)
+package extensions
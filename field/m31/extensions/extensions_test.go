@@ -0,0 +1,138 @@
+package extensions
+
+import (
+	"math/big"
+	"testing"
+
+	fr "github.com/consensys/gnark-crypto/field/m31"
+	"github.com/stretchr/testify/require"
+)
+
+const p = 2147483647
+
+// test vectors from the CM31 tests of Stwo
+func TestCM31Stwo(t *testing.T) {
+	assert := require.New(t)
+
+	var cm0, cm1, cm, expected, res CM31
+	cm0.SetUint64(1, 2)
+	cm1.SetUint64(4, 5)
+	var m fr.Element
+	m.SetUint64(8)
+	cm.A0.Set(&m)
+
+	assert.True(res.Add(&cm0, &cm1).Equal(expected.SetUint64(5, 7)))
+	assert.True(res.Mul(&cm0, &cm1).Equal(expected.SetUint64(p-6, 13)))
+	var a, b CM31
+	assert.True(a.MulByElement(&cm1, &m).Equal(b.Mul(&cm1, &cm)))
+	assert.True(res.Neg(&cm0).Equal(expected.SetUint64(p-1, p-2)))
+	assert.True(res.Sub(&cm0, &cm1).Equal(expected.SetUint64(p-3, p-3)))
+	res.Inverse(&cm1)
+	res.Mul(&res, expected.SetUint64(p-6, 13))
+	assert.True(res.Equal(&cm0))
+}
+
+// test vectors from the QM31 tests of Stwo
+func TestQM31Stwo(t *testing.T) {
+	assert := require.New(t)
+
+	var qm0, qm1, qm, expected, res QM31
+	qm0.SetUint64(1, 2, 3, 4)
+	qm1.SetUint64(4, 5, 6, 7)
+	var m fr.Element
+	m.SetUint64(8)
+	qm.SetElement(&m)
+	var qm0xqm1 QM31
+	qm0xqm1.SetUint64(p-71, 93, p-16, 50)
+
+	var a, b QM31
+	assert.True(res.Add(&qm0, &qm1).Equal(expected.SetUint64(5, 7, 9, 11)))
+	assert.True(res.Mul(&qm0, &qm1).Equal(&qm0xqm1))
+	assert.True(a.MulByElement(&qm1, &m).Equal(b.Mul(&qm1, &qm)))
+	assert.True(res.Neg(&qm0).Equal(expected.SetUint64(p-1, p-2, p-3, p-4)))
+	assert.True(res.Sub(&qm0, &qm1).Equal(expected.SetUint64(p-3, p-3, p-3, p-3)))
+	assert.True(res.Div(&qm0xqm1, &qm1).Equal(&qm0))
+}
+
+func TestCM31Arithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for range 100 {
+		var x, y, z, s, one CM31
+		x.MustSetRandom()
+		y.MustSetRandom()
+
+		// i² = -1
+		var i CM31
+		i.A1.SetOne()
+		s.Square(&i)
+		one.SetOne()
+		assert.True(s.Neg(&s).Equal(&one))
+
+		s.Square(&x)
+		z.Mul(&x, &x)
+		assert.True(s.Equal(&z))
+
+		z.Mul(&x, &y).Mul(&z, y.Inverse(&y))
+		assert.True(z.Equal(&x))
+
+		var nr, r CM31
+		r.SetUint64(2, 1)
+		nr.MulByNonResidue(&x)
+		assert.True(nr.Equal(z.Mul(&x, &r)))
+
+		// x^(q²-1) = 1
+		var e big.Int
+		e.SetUint64(p).Mul(&e, &e).Sub(&e, big.NewInt(1))
+		z.Exp(x, &e)
+		assert.True(z.IsOne())
+	}
+}
+
+func TestQM31Arithmetic(t *testing.T) {
+	assert := require.New(t)
+
+	for range 100 {
+		var x, y, z, s QM31
+		x.MustSetRandom()
+		y.MustSetRandom()
+
+		// u² = 2+i
+		var u, r QM31
+		u.B1.SetOne()
+		r.SetUint64(2, 1, 0, 0)
+		assert.True(s.Square(&u).Equal(&r))
+
+		s.Square(&x)
+		z.Mul(&x, &x)
+		assert.True(s.Equal(&z))
+
+		z.Mul(&x, &y).Mul(&z, y.Inverse(&y))
+		assert.True(z.Equal(&x))
+
+		// x^(q⁴-1) = 1
+		var e big.Int
+		e.SetUint64(p).Exp(&e, big.NewInt(4), nil).Sub(&e, big.NewInt(1))
+		z.Exp(x, &e)
+		assert.True(z.IsOne())
+
+		z.Exp(x, big.NewInt(-3)).Mul(&z, s.Mul(&s, &x))
+		assert.True(z.IsOne())
+	}
+
+	a := make([]QM31, 10)
+	for i := range a {
+		if i != 4 {
+			a[i].MustSetRandom()
+		}
+	}
+	inv := BatchInvertQM31(a)
+	for i := range a {
+		var z QM31
+		if i == 4 {
+			assert.True(inv[i].IsZero())
+			continue
+		}
+		assert.True(z.Inverse(&a[i]).Equal(&inv[i]))
+	}
+}
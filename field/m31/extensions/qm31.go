@@ -0,0 +1,259 @@
+package extensions
+
+import (
+	"math/big"
+
+	fr "github.com/consensys/gnark-crypto/field/m31"
+)
+
+// QM31 is the degree 4 extension b0 + b1·u of the Mersenne-31 field, with
+// b0, b1 in CM31 and u² = 2+i.
+type QM31 struct {
+	B0, B1 CM31
+}
+
+// Equal returns true if z equals x, false otherwise
+func (z *QM31) Equal(x *QM31) bool {
+	return z.B0.Equal(&x.B0) && z.B1.Equal(&x.B1)
+}
+
+// String returns the element as (b0)+(b1)*u
+func (z *QM31) String() string {
+	return "(" + z.B0.String() + ")+(" + z.B1.String() + ")*u"
+}
+
+// SetString sets a QM31 element from strings, in the order of the coordinates
+// of (a0 + a1·i) + (a2 + a3·i)·u
+func (z *QM31) SetString(s0, s1, s2, s3 string) *QM31 {
+	z.B0.SetString(s0, s1)
+	z.B1.SetString(s2, s3)
+	return z
+}
+
+// SetUint64 sets z to (a0 + a1·i) + (a2 + a3·i)·u
+func (z *QM31) SetUint64(a0, a1, a2, a3 uint64) *QM31 {
+	z.B0.SetUint64(a0, a1)
+	z.B1.SetUint64(a2, a3)
+	return z
+}
+
+// SetElement sets z to x, embedded from the base field, and returns z
+func (z *QM31) SetElement(x *fr.Element) *QM31 {
+	z.SetZero()
+	z.B0.A0.Set(x)
+	return z
+}
+
+// Set sets z to x and returns z
+func (z *QM31) Set(x *QM31) *QM31 {
+	*z = *x
+	return z
+}
+
+// SetZero sets z to 0 and returns z
+func (z *QM31) SetZero() *QM31 {
+	z.B0.SetZero()
+	z.B1.SetZero()
+	return z
+}
+
+// SetOne sets z to 1 and returns z
+func (z *QM31) SetOne() *QM31 {
+	z.B0.SetOne()
+	z.B1.SetZero()
+	return z
+}
+
+// IsZero returns true if z is zero, false otherwise
+func (z *QM31) IsZero() bool {
+	return z.B0.IsZero() && z.B1.IsZero()
+}
+
+// IsOne returns true if z is one, false otherwise
+func (z *QM31) IsOne() bool {
+	return z.B0.IsOne() && z.B1.IsZero()
+}
+
+// SetRandom sets z to a random element
+func (z *QM31) SetRandom() (*QM31, error) {
+	if _, err := z.B0.SetRandom(); err != nil {
+		return nil, err
+	}
+	if _, err := z.B1.SetRandom(); err != nil {
+		return nil, err
+	}
+	return z, nil
+}
+
+// MustSetRandom sets the element to a random value.
+// It panics if reading from crypto/rand fails.
+func (z *QM31) MustSetRandom() *QM31 {
+	if _, err := z.SetRandom(); err != nil {
+		panic(err)
+	}
+	return z
+}
+
+// Add sets z = x + y and returns z
+func (z *QM31) Add(x, y *QM31) *QM31 {
+	z.B0.Add(&x.B0, &y.B0)
+	z.B1.Add(&x.B1, &y.B1)
+	return z
+}
+
+// Sub sets z = x - y and returns z
+func (z *QM31) Sub(x, y *QM31) *QM31 {
+	z.B0.Sub(&x.B0, &y.B0)
+	z.B1.Sub(&x.B1, &y.B1)
+	return z
+}
+
+// Double sets z = 2x and returns z
+func (z *QM31) Double(x *QM31) *QM31 {
+	z.B0.Double(&x.B0)
+	z.B1.Double(&x.B1)
+	return z
+}
+
+// Neg sets z = -x and returns z
+func (z *QM31) Neg(x *QM31) *QM31 {
+	z.B0.Neg(&x.B0)
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Conjugate sets z = b0 - b1·u where x = b0 + b1·u and returns z
+func (z *QM31) Conjugate(x *QM31) *QM31 {
+	z.B0.Set(&x.B0)
+	z.B1.Neg(&x.B1)
+	return z
+}
+
+// Mul sets z = x·y and returns z
+func (z *QM31) Mul(x, y *QM31) *QM31 {
+	// (b0 + b1·u)(c0 + c1·u) = b0·c0 + (2+i)·b1·c1 + (b0·c1 + b1·c0)·u
+	var a, b, c CM31
+	a.Add(&x.B0, &x.B1)
+	b.Add(&y.B0, &y.B1)
+	a.Mul(&a, &b)
+	b.Mul(&x.B0, &y.B0)
+	c.Mul(&x.B1, &y.B1)
+	z.B1.Sub(&a, &b).Sub(&z.B1, &c)
+	c.MulByNonResidue(&c)
+	z.B0.Add(&b, &c)
+	return z
+}
+
+// Square sets z = x² and returns z
+func (z *QM31) Square(x *QM31) *QM31 {
+	var a, b CM31
+	a.Square(&x.B0)
+	b.Square(&x.B1)
+	b.MulByNonResidue(&b)
+	z.B1.Mul(&x.B0, &x.B1).Double(&z.B1)
+	z.B0.Add(&a, &b)
+	return z
+}
+
+// MulByElement sets z = x·y where y is in the base field and returns z
+func (z *QM31) MulByElement(x *QM31, y *fr.Element) *QM31 {
+	z.B0.MulByElement(&x.B0, y)
+	z.B1.MulByElement(&x.B1, y)
+	return z
+}
+
+// MulByCM31 sets z = x·y where y is in CM31 and returns z
+func (z *QM31) MulByCM31(x *QM31, y *CM31) *QM31 {
+	z.B0.Mul(&x.B0, y)
+	z.B1.Mul(&x.B1, y)
+	return z
+}
+
+// Inverse sets z = x⁻¹ and returns z; the inverse of 0 is 0
+func (z *QM31) Inverse(x *QM31) *QM31 {
+	// (b0 + b1·u)⁻¹ = (b0 - b1·u)/(b0² - (2+i)·b1²)
+	var a, b CM31
+	a.Square(&x.B0)
+	b.Square(&x.B1)
+	b.MulByNonResidue(&b)
+	a.Sub(&a, &b)
+	a.Inverse(&a)
+	z.Conjugate(x)
+	z.MulByCM31(z, &a)
+	return z
+}
+
+// Div sets z = x/y and returns z
+func (z *QM31) Div(x, y *QM31) *QM31 {
+	var r QM31
+	r.Inverse(y)
+	return z.Mul(x, &r)
+}
+
+// Exp sets z = xᵏ (mod q⁴) and returns it
+func (z *QM31) Exp(x QM31, k *big.Int) *QM31 {
+	if k.IsUint64() && k.Uint64() == 0 {
+		return z.SetOne()
+	}
+
+	e := k
+	if k.Sign() == -1 {
+		// negative k, we invert
+		// if k < 0: xᵏ (mod q⁴) == (x⁻¹)ᵏ (mod q⁴)
+		x.Inverse(&x)
+
+		// we negate k in a temp big.Int since
+		// Int.Bit(_) of k and -k is different
+		e = bigIntPool.Get().(*big.Int)
+		defer bigIntPool.Put(e)
+		e.Neg(k)
+	}
+
+	z.SetOne()
+	b := e.Bytes()
+	for i := 0; i < len(b); i++ {
+		w := b[i]
+		for j := 0; j < 8; j++ {
+			z.Square(z)
+			if (w & (0b10000000 >> j)) != 0 {
+				z.Mul(z, &x)
+			}
+		}
+	}
+
+	return z
+}
+
+// BatchInvertQM31 returns a new slice with every element in a inverted.
+// It uses Montgomery batch inversion trick; zero elements are left as zero.
+func BatchInvertQM31(a []QM31) []QM31 {
+	res := make([]QM31, len(a))
+	if len(a) == 0 {
+		return res
+	}
+
+	zeroes := make([]bool, len(a))
+	var accumulator QM31
+	accumulator.SetOne()
+
+	for i := 0; i < len(a); i++ {
+		if a[i].IsZero() {
+			zeroes[i] = true
+			continue
+		}
+		res[i].Set(&accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	accumulator.Inverse(&accumulator)
+
+	for i := len(a) - 1; i >= 0; i-- {
+		if zeroes[i] {
+			continue
+		}
+		res[i].Mul(&res[i], &accumulator)
+		accumulator.Mul(&accumulator, &a[i])
+	}
+
+	return res
+}
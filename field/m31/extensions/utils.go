@@ -0,0 +1,12 @@
+package extensions
+
+import (
+	"math/big"
+	"sync"
+)
+
+var bigIntPool = sync.Pool{
+	New: func() interface{} {
+		return new(big.Int)
+	},
+}
@@ -13,6 +13,8 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 	entries := []bavard.Entry{
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fri.go"), Templates: []string{"fri.go.tmpl"}},
+		{File: filepath.Join(baseDir, "config.go"), Templates: []string{"config.go.tmpl"}},
+		{File: filepath.Join(baseDir, "configurable.go"), Templates: []string{"configurable.go.tmpl"}},
 		{File: filepath.Join(baseDir, "fri_test.go"), Templates: []string{"fri.test.go.tmpl"}},
 	}
 	return bgen.Generate(conf, conf.Package, "./fri/template/", entries...)
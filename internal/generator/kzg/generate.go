@@ -14,10 +14,43 @@ func Generate(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) er
 		{File: filepath.Join(baseDir, "doc.go"), Templates: []string{"doc.go.tmpl"}},
 		{File: filepath.Join(baseDir, "kzg.go"), Templates: []string{"kzg.go.tmpl"}},
 		{File: filepath.Join(baseDir, "kzg_test.go"), Templates: []string{"kzg.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "lagrange.go"), Templates: []string{"lagrange.go.tmpl"}},
+		{File: filepath.Join(baseDir, "lagrange_test.go"), Templates: []string{"lagrange.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fk20.go"), Templates: []string{"fk20.go.tmpl"}},
+		{File: filepath.Join(baseDir, "fk20_test.go"), Templates: []string{"fk20.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hiding.go"), Templates: []string{"hiding.go.tmpl"}},
+		{File: filepath.Join(baseDir, "hiding_test.go"), Templates: []string{"hiding.test.go.tmpl"}},
+		{File: filepath.Join(baseDir, "check.go"), Templates: []string{"check.go.tmpl"}},
+		{File: filepath.Join(baseDir, "check_test.go"), Templates: []string{"check.test.go.tmpl"}},
 		{File: filepath.Join(baseDir, "marshal.go"), Templates: []string{"marshal.go.tmpl"}},
 		{File: filepath.Join(baseDir, "utils.go"), Templates: []string{"utils.go.tmpl"}},
 		{File: filepath.Join(baseDir, "mpcsetup.go"), Templates: []string{"mpcsetup.go.tmpl"}},
 	}
+
+	// import of public ceremonies
+	if conf.Name == "bn254" || conf.Name == "bls12-381" {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "ptau.go"), Templates: []string{"ptau.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "ptau_test.go"), Templates: []string{"ptau.test.go.tmpl"}},
+		)
+	}
+	if conf.Name == "bn254" {
+		entries = append(entries,
+			bavard.Entry{File: filepath.Join(baseDir, "ignition.go"), Templates: []string{"ignition.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "ignition_test.go"), Templates: []string{"ignition.test.go.tmpl"}},
+			bavard.Entry{File: filepath.Join(baseDir, "ceremony_test.go"), Templates: []string{"ceremony.test.go.tmpl"}},
+		)
+	}
 	return bgen.Generate(conf, conf.Package, "./kzg/template/", entries...)
 
 }
+
+// GenerateScheme generates the backend of the curve-agnostic KZG scheme in the
+// root kzg package
+func GenerateScheme(conf config.Curve, baseDir string, bgen *bavard.BatchGenerator) error {
+	conf.Package = "kzg"
+	entries := []bavard.Entry{
+		{File: filepath.Join(baseDir, conf.Name+".go"), Templates: []string{"scheme.go.tmpl"}},
+	}
+	return bgen.Generate(conf, conf.Package, "./kzg/template/", entries...)
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/consensys/gnark-crypto/internal/generator/polynomial"
 	"github.com/consensys/gnark-crypto/internal/generator/shplonk"
 	"github.com/consensys/gnark-crypto/internal/generator/tower"
+	"github.com/consensys/gnark-crypto/internal/generator/zeromorph"
 )
 
 const (
@@ -79,15 +80,22 @@ func main() {
 				ElementType:      "fr.Element",
 			}
 
-			frOpts := []generator.Option{generator.WithASM(asmConfig)}
+			frOpts := []generator.Option{generator.WithASM(asmConfig), generator.WithLimbs32()}
 			if !(conf.Equal(config.STARK_CURVE) || conf.Equal(config.SECP256K1) || conf.Equal(config.GRUMPKIN)) {
 				frOpts = append(frOpts, generator.WithFFT(fftConfig))
+			} else {
+				// r - 1 has no large power of 2 factor
+				frOpts = append(frOpts, generator.WithECFFT(nil))
 			}
 			if conf.Equal(config.BLS12_377) {
 				frOpts = append(frOpts, generator.WithSIS())
 			}
 			assertNoError(generator.GenerateFF(conf.Fr, filepath.Join(curveDir, "fr"), frOpts...))
-			assertNoError(generator.GenerateFF(conf.Fp, filepath.Join(curveDir, "fp"), generator.WithASM(asmConfig)))
+			fpOpts := []generator.Option{generator.WithASM(asmConfig), generator.WithLimbs32()}
+			if conf.Equal(config.SECP256K1) {
+				fpOpts = append(fpOpts, generator.WithECFFT(nil))
+			}
+			assertNoError(generator.GenerateFF(conf.Fp, filepath.Join(curveDir, "fp"), fpOpts...))
 
 			// generate ecdsa
 			assertNoError(ecdsa.Generate(conf, curveDir, bgen))
@@ -146,6 +154,7 @@ func main() {
 
 			// generate kzg on fr
 			assertNoError(kzg.Generate(conf, filepath.Join(curveDir, "kzg"), bgen))
+			assertNoError(kzg.GenerateScheme(conf, filepath.Join(baseDir, "kzg"), bgen))
 
 			// generate shplonk on fr
 			assertNoError(shplonk.Generate(conf, filepath.Join(curveDir, "shplonk"), bgen))
@@ -153,6 +162,9 @@ func main() {
 			// generate fflonk on fr
 			assertNoError(fflonk.Generate(conf, filepath.Join(curveDir, "fflonk"), bgen))
 
+			// generate zeromorph on fr
+			assertNoError(zeromorph.Generate(conf, filepath.Join(curveDir, "zeromorph"), bgen))
+
 			// generate plookup on fr
 			assertNoError(plookup.Generate(conf, filepath.Join(curveDir, "fr", "plookup"), bgen))
 
@@ -0,0 +1,210 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Code generated by consensys/gnark-crypto DO NOT EDIT
+
+package kzg
+
+import (
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315"
+	"github.com/consensys/gnark-crypto/ecc/bls24-315/fr"
+	kzg_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/kzg"
+)
+
+func init() {
+	RegisterScheme(ecc.BLS24_315, func(srs SRS) (Scheme, error) {
+		s, ok := srs.(*kzg_bls24315.SRS)
+		if !ok {
+			return nil, ErrSRSCurve
+		}
+		return schemeBLS24315{srs: s}, nil
+	})
+}
+
+// schemeBLS24315 implements Scheme on bls24-315
+type schemeBLS24315 struct {
+	srs *kzg_bls24315.SRS
+}
+
+func (schemeBLS24315) Curve() ecc.ID {
+	return ecc.BLS24_315
+}
+
+func (s schemeBLS24315) Commit(p []*big.Int) ([]byte, error) {
+	_p, err := s.toPolynomial(p)
+	if err != nil {
+		return nil, err
+	}
+	digest, err := kzg_bls24315.Commit(_p, s.srs.Pk)
+	if err != nil {
+		return nil, err
+	}
+	res := digest.Bytes()
+	return res[:], nil
+}
+
+func (s schemeBLS24315) Open(p []*big.Int, point *big.Int) (OpeningProof, error) {
+	_p, err := s.toPolynomial(p)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	_point, err := s.toElement(point)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	proof, err := kzg_bls24315.Open(_p, _point, s.srs.Pk)
+	if err != nil {
+		return OpeningProof{}, err
+	}
+	return s.fromOpeningProof(&proof), nil
+}
+
+func (s schemeBLS24315) Verify(digest []byte, proof OpeningProof, point *big.Int) error {
+	_digest, err := s.toDigest(digest)
+	if err != nil {
+		return err
+	}
+	_proof, err := s.toOpeningProof(proof)
+	if err != nil {
+		return err
+	}
+	_point, err := s.toElement(point)
+	if err != nil {
+		return err
+	}
+	return kzg_bls24315.Verify(&_digest, &_proof, _point, s.srs.Vk)
+}
+
+func (s schemeBLS24315) BatchOpenSinglePoint(polynomials [][]*big.Int, digests [][]byte, point *big.Int, hf hash.Hash, dataTranscript ...[]byte) (BatchOpeningProof, error) {
+	_polynomials := make([][]fr.Element, len(polynomials))
+	for i := range polynomials {
+		var err error
+		if _polynomials[i], err = s.toPolynomial(polynomials[i]); err != nil {
+			return BatchOpeningProof{}, err
+		}
+	}
+	_digests, err := s.toDigests(digests)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+	_point, err := s.toElement(point)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+	proof, err := kzg_bls24315.BatchOpenSinglePoint(_polynomials, _digests, _point, hf, s.srs.Pk, dataTranscript...)
+	if err != nil {
+		return BatchOpeningProof{}, err
+	}
+	h := proof.H.Bytes()
+	res := BatchOpeningProof{
+		H:             h[:],
+		ClaimedValues: make([]*big.Int, len(proof.ClaimedValues)),
+	}
+	for i := range proof.ClaimedValues {
+		res.ClaimedValues[i] = proof.ClaimedValues[i].BigInt(new(big.Int))
+	}
+	return res, nil
+}
+
+func (s schemeBLS24315) BatchVerifySinglePoint(digests [][]byte, proof BatchOpeningProof, point *big.Int, hf hash.Hash, dataTranscript ...[]byte) error {
+	_digests, err := s.toDigests(digests)
+	if err != nil {
+		return err
+	}
+	var _proof kzg_bls24315.BatchOpeningProof
+	if _proof.H, err = s.toDigest(proof.H); err != nil {
+		return err
+	}
+	if _proof.ClaimedValues, err = s.toPolynomial(proof.ClaimedValues); err != nil {
+		return err
+	}
+	_point, err := s.toElement(point)
+	if err != nil {
+		return err
+	}
+	return kzg_bls24315.BatchVerifySinglePoint(_digests, &_proof, _point, hf, s.srs.Vk, dataTranscript...)
+}
+
+func (s schemeBLS24315) BatchVerifyMultiPoints(digests [][]byte, proofs []OpeningProof, points []*big.Int) error {
+	_digests, err := s.toDigests(digests)
+	if err != nil {
+		return err
+	}
+	_proofs := make([]kzg_bls24315.OpeningProof, len(proofs))
+	for i := range proofs {
+		if _proofs[i], err = s.toOpeningProof(proofs[i]); err != nil {
+			return err
+		}
+	}
+	_points, err := s.toPolynomial(points)
+	if err != nil {
+		return err
+	}
+	return kzg_bls24315.BatchVerifyMultiPoints(_digests, _proofs, _points, s.srs.Vk)
+}
+
+// toElement converts v to a field element, v must be in [0, r)
+func (schemeBLS24315) toElement(v *big.Int) (fr.Element, error) {
+	var res fr.Element
+	if v == nil || v.Sign() < 0 || v.Cmp(fr.Modulus()) >= 0 {
+		return res, ErrInvalidFieldElement
+	}
+	res.SetBigInt(v)
+	return res, nil
+}
+
+func (s schemeBLS24315) toPolynomial(p []*big.Int) ([]fr.Element, error) {
+	res := make([]fr.Element, len(p))
+	for i := range p {
+		var err error
+		if res[i], err = s.toElement(p[i]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// toDigest decodes a compressed G₁ point, with a subgroup check
+func (schemeBLS24315) toDigest(b []byte) (kzg_bls24315.Digest, error) {
+	var res kzg_bls24315.Digest
+	if len(b) != bls24315.SizeOfG1AffineCompressed {
+		return res, ErrInvalidEncodedDigest
+	}
+	if _, err := res.SetBytes(b); err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+func (s schemeBLS24315) toDigests(b [][]byte) ([]kzg_bls24315.Digest, error) {
+	res := make([]kzg_bls24315.Digest, len(b))
+	for i := range b {
+		var err error
+		if res[i], err = s.toDigest(b[i]); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (s schemeBLS24315) toOpeningProof(proof OpeningProof) (kzg_bls24315.OpeningProof, error) {
+	var res kzg_bls24315.OpeningProof
+	var err error
+	if res.H, err = s.toDigest(proof.H); err != nil {
+		return res, err
+	}
+	res.ClaimedValue, err = s.toElement(proof.ClaimedValue)
+	return res, err
+}
+
+func (schemeBLS24315) fromOpeningProof(proof *kzg_bls24315.OpeningProof) OpeningProof {
+	h := proof.H.Bytes()
+	return OpeningProof{
+		H:            h[:],
+		ClaimedValue: proof.ClaimedValue.BigInt(new(big.Int)),
+	}
+}
@@ -1,4 +1,5 @@
-// Package kzg provides constructor for curved-typed KZG SRS
+// Package kzg provides constructor for curved-typed KZG SRS, and a curve-agnostic
+// KZG commitment scheme (see NewScheme)
 //
 // For more details, see ecc/XXX/fr/kzg package
 package kzg
@@ -0,0 +1,84 @@
+package kzg
+
+import (
+	"errors"
+	"hash"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc"
+)
+
+var (
+	ErrUnknownCurve         = errors.New("no KZG scheme registered for this curve")
+	ErrSRSCurve             = errors.New("the SRS is not on the requested curve")
+	ErrInvalidFieldElement  = errors.New("the value is not a canonical scalar field element")
+	ErrInvalidEncodedDigest = errors.New("invalid encoded digest")
+)
+
+// OpeningProof curve-agnostic KZG proof for opening a polynomial at a single point.
+type OpeningProof struct {
+	// H compressed quotient commitment [(f(α) - f(a))/(α - a)]G₁
+	H []byte
+
+	// ClaimedValue purported value f(a)
+	ClaimedValue *big.Int
+}
+
+// BatchOpeningProof curve-agnostic KZG proof for opening a list of polynomials at a single point.
+type BatchOpeningProof struct {
+	// H compressed quotient commitment of the folded polynomial
+	H []byte
+
+	// ClaimedValues purported values fᵢ(a)
+	ClaimedValues []*big.Int
+}
+
+// Scheme is a curve-agnostic KZG commitment scheme, backed by the SRS of one of the
+// ecc/{curve}/kzg packages.
+//
+// Polynomials are given by their coefficients in the canonical basis, and scalars must be
+// in [0, r) where r is the order of the scalar field of the curve. Digests are G₁ points in
+// compressed form (see G1Affine.Bytes), and are checked to be in the correct subgroup when decoded.
+type Scheme interface {
+	// Curve returns the curve of the scheme
+	Curve() ecc.ID
+
+	// Commit commits to a polynomial
+	Commit(p []*big.Int) ([]byte, error)
+
+	// Open computes an opening proof of p at point
+	Open(p []*big.Int, point *big.Int) (OpeningProof, error)
+
+	// Verify verifies an opening proof of the polynomial committed in digest at point
+	Verify(digest []byte, proof OpeningProof, point *big.Int) error
+
+	// BatchOpenSinglePoint opens a list of polynomials at point. The folding challenge
+	// is derived with hf from the digests, the claimed values and dataTranscript.
+	BatchOpenSinglePoint(polynomials [][]*big.Int, digests [][]byte, point *big.Int, hf hash.Hash, dataTranscript ...[]byte) (BatchOpeningProof, error)
+
+	// BatchVerifySinglePoint verifies a proof produced by BatchOpenSinglePoint
+	BatchVerifySinglePoint(digests [][]byte, proof BatchOpeningProof, point *big.Int, hf hash.Hash, dataTranscript ...[]byte) error
+
+	// BatchVerifyMultiPoints verifies openings of the polynomials committed in digests
+	// at different points, with a single pairing check
+	BatchVerifyMultiPoints(digests [][]byte, proofs []OpeningProof, points []*big.Int) error
+}
+
+var schemes = make(map[ecc.ID]func(srs SRS) (Scheme, error))
+
+// RegisterScheme registers the constructor of the KZG scheme of a curve.
+// Should be called in the init function of the backend.
+func RegisterScheme(curveID ecc.ID, newScheme func(srs SRS) (Scheme, error)) {
+	schemes[curveID] = newScheme
+}
+
+// NewScheme returns the KZG scheme on curveID using srs, which must be the SRS type
+// of the corresponding ecc/{curve}/kzg package (as returned by NewSRS(curveID)).
+// The proving key of srs may be empty if the scheme is only used to verify proofs.
+func NewScheme(curveID ecc.ID, srs SRS) (Scheme, error) {
+	newScheme, ok := schemes[curveID]
+	if !ok {
+		return nil, ErrUnknownCurve
+	}
+	return newScheme(srs)
+}
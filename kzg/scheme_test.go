@@ -0,0 +1,128 @@
+package kzg
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	kzg_bls12377 "github.com/consensys/gnark-crypto/ecc/bls12-377/kzg"
+	kzg_bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/kzg"
+	kzg_bls24315 "github.com/consensys/gnark-crypto/ecc/bls24-315/kzg"
+	kzg_bls24317 "github.com/consensys/gnark-crypto/ecc/bls24-317/kzg"
+	kzg_bn254 "github.com/consensys/gnark-crypto/ecc/bn254/kzg"
+	kzg_bw6633 "github.com/consensys/gnark-crypto/ecc/bw6-633/kzg"
+	kzg_bw6761 "github.com/consensys/gnark-crypto/ecc/bw6-761/kzg"
+)
+
+const testSrsSize = 32
+
+func newTestSRS(t *testing.T, curveID ecc.ID) SRS {
+	alpha := big.NewInt(42)
+	var (
+		srs SRS
+		err error
+	)
+	switch curveID {
+	case ecc.BN254:
+		srs, err = kzg_bn254.NewSRS(testSrsSize, alpha)
+	case ecc.BLS12_377:
+		srs, err = kzg_bls12377.NewSRS(testSrsSize, alpha)
+	case ecc.BLS12_381:
+		srs, err = kzg_bls12381.NewSRS(testSrsSize, alpha)
+	case ecc.BLS24_315:
+		srs, err = kzg_bls24315.NewSRS(testSrsSize, alpha)
+	case ecc.BLS24_317:
+		srs, err = kzg_bls24317.NewSRS(testSrsSize, alpha)
+	case ecc.BW6_761:
+		srs, err = kzg_bw6761.NewSRS(testSrsSize, alpha)
+	case ecc.BW6_633:
+		srs, err = kzg_bw6633.NewSRS(testSrsSize, alpha)
+	default:
+		t.Fatal("unexpected curve")
+	}
+	require.NoError(t, err)
+	return srs
+}
+
+func randomPolynomial(t *testing.T, modulus *big.Int, size int) []*big.Int {
+	res := make([]*big.Int, size)
+	for i := range res {
+		var err error
+		res[i], err = rand.Int(rand.Reader, modulus)
+		require.NoError(t, err)
+	}
+	return res
+}
+
+func TestScheme(t *testing.T) {
+	curves := []ecc.ID{ecc.BN254, ecc.BLS12_377, ecc.BLS12_381, ecc.BLS24_315, ecc.BLS24_317, ecc.BW6_761, ecc.BW6_633}
+	for _, curveID := range curves {
+		t.Run(curveID.String(), func(t *testing.T) {
+			assert := require.New(t)
+
+			scheme, err := NewScheme(curveID, newTestSRS(t, curveID))
+			assert.NoError(err)
+			assert.Equal(curveID, scheme.Curve())
+			modulus := curveID.ScalarField()
+
+			// single opening
+			p := randomPolynomial(t, modulus, 20)
+			point := randomPolynomial(t, modulus, 1)[0]
+			digest, err := scheme.Commit(p)
+			assert.NoError(err)
+			proof, err := scheme.Open(p, point)
+			assert.NoError(err)
+			assert.NoError(scheme.Verify(digest, proof, point))
+
+			wrongProof := proof
+			wrongProof.ClaimedValue = new(big.Int).Add(proof.ClaimedValue, big.NewInt(1))
+			assert.Error(scheme.Verify(digest, wrongProof, point))
+
+			// batch opening at a single point
+			const nbPolys = 3
+			polynomials := make([][]*big.Int, nbPolys)
+			digests := make([][]byte, nbPolys)
+			for i := range polynomials {
+				polynomials[i] = randomPolynomial(t, modulus, 10+i)
+				digests[i], err = scheme.Commit(polynomials[i])
+				assert.NoError(err)
+			}
+			batchProof, err := scheme.BatchOpenSinglePoint(polynomials, digests, point, sha256.New(), []byte("test"))
+			assert.NoError(err)
+			assert.Len(batchProof.ClaimedValues, nbPolys)
+			assert.NoError(scheme.BatchVerifySinglePoint(digests, batchProof, point, sha256.New(), []byte("test")))
+			assert.Error(scheme.BatchVerifySinglePoint(digests, batchProof, point, sha256.New()))
+
+			// batch verification at several points
+			proofs := make([]OpeningProof, nbPolys)
+			points := randomPolynomial(t, modulus, nbPolys)
+			for i := range polynomials {
+				proofs[i], err = scheme.Open(polynomials[i], points[i])
+				assert.NoError(err)
+			}
+			assert.NoError(scheme.BatchVerifyMultiPoints(digests, proofs, points))
+			proofs[1].ClaimedValue = proofs[0].ClaimedValue
+			assert.Error(scheme.BatchVerifyMultiPoints(digests, proofs, points))
+
+			// invalid inputs
+			_, err = scheme.Commit([]*big.Int{modulus})
+			assert.ErrorIs(err, ErrInvalidFieldElement)
+			_, err = scheme.Open(p, big.NewInt(-1))
+			assert.ErrorIs(err, ErrInvalidFieldElement)
+			assert.ErrorIs(scheme.Verify(digest[1:], proof, point), ErrInvalidEncodedDigest)
+			otherCurve := ecc.BN254
+			if curveID == ecc.BN254 {
+				otherCurve = ecc.BLS12_381
+			}
+			_, err = NewScheme(curveID, NewSRS(otherCurve))
+			assert.ErrorIs(err, ErrSRSCurve)
+		})
+	}
+
+	_, err := NewScheme(ecc.SECP256K1, NewSRS(ecc.BN254))
+	require.ErrorIs(t, err, ErrUnknownCurve)
+}
@@ -0,0 +1,182 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls
+
+import (
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+// Aggregate returns the sum of the signatures, which must all be of the given variant.
+//
+// draft-irtf-cfrg-bls-signature-05, Section 2.8
+func Aggregate(variant Variant, signatures [][]byte) ([]byte, error) {
+	if len(signatures) == 0 {
+		return nil, errNoSignature
+	}
+	switch variant {
+	case MinPk:
+		var acc bls12381.G2Jac
+		for _, s := range signatures {
+			p, err := signatureG2(s)
+			if err != nil {
+				return nil, err
+			}
+			acc.AddMixed(&p)
+		}
+		var res bls12381.G2Affine
+		b := res.FromJacobian(&acc).Bytes()
+		return b[:], nil
+	case MinSig:
+		var acc bls12381.G1Jac
+		for _, s := range signatures {
+			p, err := signatureG1(s)
+			if err != nil {
+				return nil, err
+			}
+			acc.AddMixed(&p)
+		}
+		var res bls12381.G1Affine
+		b := res.FromJacobian(&acc).Bytes()
+		return b[:], nil
+	default:
+		return nil, errUnknownVariant
+	}
+}
+
+// AggregateVerify checks an aggregated signature of messages[i] by publicKeys[i].
+// All the public keys must have the same variant and scheme:
+//   - with Basic, the messages must be distinct,
+//   - with MessageAugmentation, each message is prefixed with its public key,
+//   - with ProofOfPossession, the public keys must have been checked with PopVerify.
+//
+// draft-irtf-cfrg-bls-signature-05, Section 3
+func AggregateVerify(publicKeys []*PublicKey, messages [][]byte, aggregatedSignature []byte) (bool, error) {
+	if len(publicKeys) != len(messages) {
+		return false, errLengthMismatch
+	}
+	if len(publicKeys) == 0 {
+		return false, nil
+	}
+	if err := sameCiphersuite(publicKeys); err != nil {
+		return false, err
+	}
+
+	variant, scheme := publicKeys[0].Variant, publicKeys[0].Scheme
+	switch scheme {
+	case Basic:
+		seen := make(map[string]struct{}, len(messages))
+		for _, m := range messages {
+			if _, ok := seen[string(m)]; ok {
+				return false, nil
+			}
+			seen[string(m)] = struct{}{}
+		}
+	case MessageAugmentation:
+		augmented := make([][]byte, len(messages))
+		for i := range messages {
+			augmented[i] = append(publicKeys[i].Bytes(), messages[i]...)
+		}
+		messages = augmented
+	}
+	return coreAggregateVerify(publicKeys, messages, aggregatedSignature, dst(variant, scheme))
+}
+
+// FastAggregateVerify checks an aggregated signature of the same message by all the public
+// keys. It is only available with the ProofOfPossession scheme: the public keys must have been
+// checked with PopVerify.
+//
+// draft-irtf-cfrg-bls-signature-05, Section 3.3.4
+func FastAggregateVerify(publicKeys []*PublicKey, message, aggregatedSignature []byte) (bool, error) {
+	if len(publicKeys) == 0 {
+		return false, nil
+	}
+	if err := sameCiphersuite(publicKeys); err != nil {
+		return false, err
+	}
+	if publicKeys[0].Scheme != ProofOfPossession {
+		return false, errNotPoP
+	}
+	for _, pk := range publicKeys {
+		if err := pk.validate(); err != nil {
+			return false, nil
+		}
+	}
+
+	aggregated := PublicKey{Variant: publicKeys[0].Variant, Scheme: ProofOfPossession}
+	if aggregated.Variant == MinPk {
+		var acc bls12381.G1Jac
+		for _, pk := range publicKeys {
+			acc.AddMixed(&pk.g1)
+		}
+		aggregated.g1.FromJacobian(&acc)
+	} else {
+		var acc bls12381.G2Jac
+		for _, pk := range publicKeys {
+			acc.AddMixed(&pk.g2)
+		}
+		aggregated.g2.FromJacobian(&acc)
+	}
+	return coreAggregateVerify([]*PublicKey{&aggregated}, [][]byte{message}, aggregatedSignature, dst(aggregated.Variant, ProofOfPossession))
+}
+
+// coreAggregateVerify checks
+//
+//	∏ e(pkᵢ, H(mᵢ)) ?= e(g, signature)
+//
+// with a single pairing check, the pairing arguments being swapped for MinSig.
+func coreAggregateVerify(publicKeys []*PublicKey, messages [][]byte, sigBin, dst []byte) (bool, error) {
+	for _, pk := range publicKeys {
+		if err := pk.validate(); err != nil {
+			return false, nil
+		}
+	}
+	n := len(publicKeys)
+	_, _, g1, g2 := bls12381.Generators()
+
+	switch publicKeys[0].Variant {
+	case MinPk:
+		sig, err := signatureG2(sigBin)
+		if err != nil {
+			return false, err
+		}
+		P := make([]bls12381.G1Affine, n+1)
+		Q := make([]bls12381.G2Affine, n+1)
+		for i := range publicKeys {
+			if Q[i], err = bls12381.HashToG2(messages[i], dst); err != nil {
+				return false, err
+			}
+			P[i] = publicKeys[i].g1
+		}
+		P[n].Neg(&g1)
+		Q[n] = sig
+		return bls12381.PairingCheck(P, Q)
+	case MinSig:
+		sig, err := signatureG1(sigBin)
+		if err != nil {
+			return false, err
+		}
+		P := make([]bls12381.G1Affine, n+1)
+		Q := make([]bls12381.G2Affine, n+1)
+		for i := range publicKeys {
+			if P[i], err = bls12381.HashToG1(messages[i], dst); err != nil {
+				return false, err
+			}
+			Q[i] = publicKeys[i].g2
+		}
+		P[n] = sig
+		Q[n].Neg(&g2)
+		return bls12381.PairingCheck(P, Q)
+	default:
+		return false, errUnknownVariant
+	}
+}
+
+func sameCiphersuite(publicKeys []*PublicKey) error {
+	for _, pk := range publicKeys[1:] {
+		if pk.Variant != publicKeys[0].Variant || pk.Scheme != publicKeys[0].Scheme {
+			return errMixedKeys
+		}
+	}
+	return nil
+}
@@ -0,0 +1,292 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"hash"
+	"io"
+	"math/big"
+
+	"golang.org/x/crypto/hkdf"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/consensys/gnark-crypto/signature"
+)
+
+const (
+	sizeFr = fr.Bytes
+	sizeG1 = bls12381.SizeOfG1AffineCompressed
+	sizeG2 = bls12381.SizeOfG2AffineCompressed
+)
+
+// Variant selects the groups of the public keys and of the signatures.
+type Variant uint8
+
+const (
+	// MinPk has public keys in G1 (48 bytes) and signatures in G2 (96 bytes).
+	MinPk Variant = iota
+	// MinSig has public keys in G2 (96 bytes) and signatures in G1 (48 bytes).
+	MinSig
+)
+
+// Scheme selects how the signatures are protected against rogue key attacks when aggregated.
+type Scheme uint8
+
+const (
+	// Basic requires the aggregated messages to be distinct.
+	Basic Scheme = iota
+	// MessageAugmentation prepends the public key to the signed message.
+	MessageAugmentation
+	// ProofOfPossession requires the public keys to come with a proof of possession
+	// of the private key (see PrivateKey.PopProve), and allows FastAggregateVerify.
+	ProofOfPossession
+)
+
+var (
+	errUnknownVariant = errors.New("bls: unknown variant")
+	errInvalidKey     = errors.New("bls: invalid public key")
+	errInvalidScalar  = errors.New("bls: invalid private key scalar")
+	errShortIKM       = errors.New("bls: the input keying material must be at least 32 bytes")
+	errMixedKeys      = errors.New("bls: the public keys must have the same variant and scheme")
+	errLengthMismatch = errors.New("bls: the number of public keys and of messages differ")
+	errNoSignature    = errors.New("bls: no signature to aggregate")
+	errNotPoP         = errors.New("bls: FastAggregateVerify requires the ProofOfPossession scheme")
+	errWrongSignature = errors.New("bls: wrong signature size")
+	errNotCompressed  = errors.New("bls: the point must be compressed")
+)
+
+var order = fr.Modulus()
+
+// dst returns the domain separation tag used to hash the messages to the signature group
+func dst(variant Variant, scheme Scheme) []byte {
+	var tag string
+	switch scheme {
+	case Basic:
+		tag = "NUL"
+	case MessageAugmentation:
+		tag = "AUG"
+	default:
+		tag = "POP"
+	}
+	return []byte("BLS_SIG_BLS12381" + signatureGroup(variant) + "_XMD:SHA-256_SSWU_RO_" + tag + "_")
+}
+
+// dstPop returns the domain separation tag of the proofs of possession
+func dstPop(variant Variant) []byte {
+	return []byte("BLS_POP_BLS12381" + signatureGroup(variant) + "_XMD:SHA-256_SSWU_RO_POP_")
+}
+
+func signatureGroup(variant Variant) string {
+	if variant == MinSig {
+		return "G1"
+	}
+	return "G2"
+}
+
+// PublicKey represents a BLS public key.
+//
+// The Variant and Scheme must be set before calling SetBytes; the zero value is a MinPk key
+// with the Basic scheme.
+type PublicKey struct {
+	Variant Variant
+	Scheme  Scheme
+	g1      bls12381.G1Affine // public key with MinPk
+	g2      bls12381.G2Affine // public key with MinSig
+}
+
+// PrivateKey represents a BLS private key
+type PrivateKey struct {
+	PublicKey PublicKey
+	scalar    [sizeFr]byte // secret scalar, in big Endian
+}
+
+// KeyGen derives a private key from the input keying material ikm, which must be at least
+// 32 bytes of secret randomness, and the optional keyInfo.
+//
+// draft-irtf-cfrg-bls-signature-05, Section 2.3
+func KeyGen(ikm, keyInfo []byte, variant Variant, scheme Scheme) (*PrivateKey, error) {
+	if len(ikm) < 32 {
+		return nil, errShortIKM
+	}
+	if variant != MinPk && variant != MinSig {
+		return nil, errUnknownVariant
+	}
+
+	// L = ceil((3 * ceil(log2(r))) / 16)
+	const l = 48
+	salt := []byte("BLS-SIG-KEYGEN-SALT-")
+	info := make([]byte, len(keyInfo)+2)
+	copy(info, keyInfo)
+	info[len(keyInfo)+1] = l
+	secret := make([]byte, len(ikm)+1)
+	copy(secret, ikm)
+
+	k := new(big.Int)
+	for k.Sign() == 0 {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+		okm := make([]byte, l)
+		if _, err := io.ReadFull(hkdf.New(sha256.New, secret, salt, info), okm); err != nil {
+			return nil, err
+		}
+		k.SetBytes(okm).Mod(k, order)
+	}
+
+	privateKey := &PrivateKey{PublicKey: PublicKey{Variant: variant, Scheme: scheme}}
+	k.FillBytes(privateKey.scalar[:])
+	privateKey.PublicKey.fromScalar(k)
+	return privateKey, nil
+}
+
+// GenerateKey generates a public and private key pair from 32 bytes read from rand.
+func GenerateKey(variant Variant, scheme Scheme, rand io.Reader) (*PrivateKey, error) {
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(rand, ikm); err != nil {
+		return nil, err
+	}
+	return KeyGen(ikm, nil, variant, scheme)
+}
+
+// fromScalar sets the public key to sk ⋅ g, with g the generator of the public key group
+func (pub *PublicKey) fromScalar(sk *big.Int) {
+	if pub.Variant == MinSig {
+		pub.g2.ScalarMultiplicationBase(sk)
+	} else {
+		pub.g1.ScalarMultiplicationBase(sk)
+	}
+}
+
+// validate implements KeyValidate: the point must not be the identity, and is known to be
+// in the prime order subgroup since it was either decoded or derived from a scalar.
+func (pub *PublicKey) validate() error {
+	switch pub.Variant {
+	case MinPk:
+		if pub.g1.IsInfinity() {
+			return errInvalidKey
+		}
+	case MinSig:
+		if pub.g2.IsInfinity() {
+			return errInvalidKey
+		}
+	default:
+		return errUnknownVariant
+	}
+	return nil
+}
+
+// Equal compares 2 public keys
+func (pub *PublicKey) Equal(x signature.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	if pub.Variant != xx.Variant || pub.Scheme != xx.Scheme {
+		return false
+	}
+	bpk := pub.Bytes()
+	bxx := xx.Bytes()
+	return subtle.ConstantTimeCompare(bpk, bxx) == 1
+}
+
+// Public returns the public key associated to the private key.
+func (privKey *PrivateKey) Public() signature.PublicKey {
+	pub := privKey.PublicKey
+	return &pub
+}
+
+// Sign performs the BLS signature
+//
+// H = hash_to_curve(m)
+// signature = sk ⋅ H
+//
+// With the MessageAugmentation scheme, m is prefixed with the public key.
+// If hFunc is provided, the message is first hashed with it.
+//
+// draft-irtf-cfrg-bls-signature-05, Sections 2.6 and 3
+func (privKey *PrivateKey) Sign(message []byte, hFunc hash.Hash) ([]byte, error) {
+	message, err := prehash(message, hFunc)
+	if err != nil {
+		return nil, err
+	}
+	pub := &privKey.PublicKey
+	if pub.Scheme == MessageAugmentation {
+		message = append(pub.Bytes(), message...)
+	}
+	return privKey.coreSign(message, dst(pub.Variant, pub.Scheme))
+}
+
+// PopProve returns a proof of possession of the private key, that is a signature of the
+// public key with a dedicated domain separation tag.
+//
+// draft-irtf-cfrg-bls-signature-05, Section 3.3.2
+func (privKey *PrivateKey) PopProve() ([]byte, error) {
+	pub := &privKey.PublicKey
+	return privKey.coreSign(pub.Bytes(), dstPop(pub.Variant))
+}
+
+func (privKey *PrivateKey) coreSign(message, dst []byte) ([]byte, error) {
+	sk := new(big.Int).SetBytes(privKey.scalar[:])
+	switch privKey.PublicKey.Variant {
+	case MinPk:
+		h, err := bls12381.HashToG2(message, dst)
+		if err != nil {
+			return nil, err
+		}
+		h.ScalarMultiplication(&h, sk)
+		b := h.Bytes()
+		return b[:], nil
+	case MinSig:
+		h, err := bls12381.HashToG1(message, dst)
+		if err != nil {
+			return nil, err
+		}
+		h.ScalarMultiplication(&h, sk)
+		b := h.Bytes()
+		return b[:], nil
+	default:
+		return nil, errUnknownVariant
+	}
+}
+
+// Verify validates the BLS signature
+//
+// e(pk, H(m)) ?= e(g, signature)
+//
+// with the pairing arguments swapped for MinSig. If hFunc is provided, the message is first
+// hashed with it.
+//
+// draft-irtf-cfrg-bls-signature-05, Sections 2.7 and 3
+func (pub *PublicKey) Verify(sigBin, message []byte, hFunc hash.Hash) (bool, error) {
+	message, err := prehash(message, hFunc)
+	if err != nil {
+		return false, err
+	}
+	if pub.Scheme == MessageAugmentation {
+		message = append(pub.Bytes(), message...)
+	}
+	return coreAggregateVerify([]*PublicKey{pub}, [][]byte{message}, sigBin, dst(pub.Variant, pub.Scheme))
+}
+
+// PopVerify checks a proof of possession of the private key associated to pub.
+//
+// draft-irtf-cfrg-bls-signature-05, Section 3.3.3
+func (pub *PublicKey) PopVerify(proof []byte) (bool, error) {
+	return coreAggregateVerify([]*PublicKey{pub}, [][]byte{pub.Bytes()}, proof, dstPop(pub.Variant))
+}
+
+// prehash returns hFunc(message), or message if hFunc is nil
+func prehash(message []byte, hFunc hash.Hash) ([]byte, error) {
+	if hFunc == nil {
+		return message, nil
+	}
+	hFunc.Reset()
+	if _, err := hFunc.Write(message); err != nil {
+		return nil, err
+	}
+	return hFunc.Sum(nil), nil
+}
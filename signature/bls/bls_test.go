@@ -0,0 +1,425 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math/big"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+var variants = []Variant{MinPk, MinSig}
+var schemes = []Scheme{Basic, MessageAugmentation, ProofOfPossession}
+
+func TestKeyGen(t *testing.T) {
+	// EIP-2333 test case 0: derive_master_SK is KeyGen with an empty key_info
+	ikm, _ := hex.DecodeString("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04")
+	expected, _ := new(big.Int).SetString("6083874454709270928345386274498605044986640685124978867557563392430687146096", 10)
+
+	privKey, err := KeyGen(ikm, nil, MinPk, ProofOfPossession)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if new(big.Int).SetBytes(privKey.scalar[:]).Cmp(expected) != 0 {
+		t.Fatal("wrong secret key")
+	}
+
+	if _, err := KeyGen(ikm[:31], nil, MinPk, Basic); err == nil {
+		t.Fatal("expected an error for a short input keying material")
+	}
+}
+
+// ethereum consensus spec tests, bls/sign and bls/verify (min-pk, proof of possession)
+func TestEthereumVectors(t *testing.T) {
+	vectors := []struct {
+		privKey, publicKey, message, signature string
+	}{
+		{
+			"47b8192d77bf871b62e87859d653922725724a5c031afeabc60bcef5ff665138",
+			"b301803f8b5ac4a1133581fc676dfedc60d891dd5fa99028805e5ea5b08d3491af75d0707adab3b70c6a6a580217bf81",
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"b23c46be3a001c63ca711f87a005c200cc550b9429d5f4eb38d74322144f1b63926da3388979e5321012fb1a0526bcd100b5ef5fe72628ce4cd5e904aeaa3279527843fae5ca9ca675f4f51ed8f83bbf7155da9ecc9663100a885d5dc6df96d9",
+		},
+		{
+			"263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3",
+			"a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a",
+			"5656565656565656565656565656565656565656565656565656565656565656",
+			"882730e5d03f6b42c3abc26d3372625034e1d871b65a8a6b900a56dae22da98abbe1b68f85e49fe7652a55ec3d0591c20767677e33e5cbb1207315c41a9ac03be39c2e7668edc043d6cb1d9fd93033caa8a1c5b0e84bedaeb6c64972503a43eb",
+		},
+		{
+			"263dbd792f5b1be47ed85f8938c0f29586af0d3ac7b977f21c278fe1462040e3",
+			"a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a",
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55",
+		},
+	}
+
+	for i, v := range vectors {
+		buf, _ := hex.DecodeString(v.publicKey + v.privKey)
+		privKey := PrivateKey{PublicKey: PublicKey{Variant: MinPk, Scheme: ProofOfPossession}}
+		if _, err := privKey.SetBytes(buf); err != nil {
+			t.Fatalf("vector %d: %v", i, err)
+		}
+		message, _ := hex.DecodeString(v.message)
+		sig, err := privKey.Sign(message, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(sig) != v.signature {
+			t.Fatalf("vector %d: wrong signature", i)
+		}
+		if ok, err := privKey.PublicKey.Verify(sig, message, nil); !ok || err != nil {
+			t.Fatalf("vector %d: valid signature rejected", i)
+		}
+		message[0] ^= 1
+		if ok, _ := privKey.PublicKey.Verify(sig, message, nil); ok {
+			t.Fatalf("vector %d: signature of another message accepted", i)
+		}
+	}
+
+	// verify_infinity_pubkey_and_infinity_signature
+	infinityPk, _ := hex.DecodeString("c" + fmt.Sprintf("%095d", 0))
+	infinitySig, _ := hex.DecodeString("c" + fmt.Sprintf("%0191d", 0))
+	pub := PublicKey{Scheme: ProofOfPossession}
+	if _, err := pub.SetBytes(infinityPk); err == nil {
+		t.Fatal("the point at infinity is not a valid public key")
+	}
+	if ok, _ := pub.Verify(infinitySig, make([]byte, 32), nil); ok {
+		t.Fatal("infinity signature accepted for the infinity public key")
+	}
+}
+
+// ethereum consensus spec tests, bls/aggregate, bls/aggregate_verify and bls/fast_aggregate_verify
+func TestEthereumAggregateVectors(t *testing.T) {
+	decode := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return b
+	}
+	// public keys of the secret keys of TestEthereumVectors, and of
+	// 328388aff0d4a5b7dc9205abd374e7e98f3cd9f3418edb4eafda5fb16473d216
+	publicKeys := make([]*PublicKey, 3)
+	for i, s := range []string{
+		"a491d1b0ecd9bb917989f0e74f0dea0422eac4a873e5e2644f368dffb9a6e20fd6e10c1b77654d067c0618f6e5a7f79a",
+		"b301803f8b5ac4a1133581fc676dfedc60d891dd5fa99028805e5ea5b08d3491af75d0707adab3b70c6a6a580217bf81",
+		"b53d21a4cfd562c469cc81514d4ce5a6b577d8403d32a394dc265dd190b47fa9f829fdd7963afdf972e5e77854051f6f",
+	} {
+		publicKeys[i] = &PublicKey{Variant: MinPk, Scheme: ProofOfPossession}
+		if _, err := publicKeys[i].SetBytes(decode(s)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	messages := [][]byte{
+		decode("0000000000000000000000000000000000000000000000000000000000000000"),
+		decode("5656565656565656565656565656565656565656565656565656565656565656"),
+		decode("abababababababababababababababababababababababababababababababab"),
+	}
+	infinitySig := decode("c" + fmt.Sprintf("%0191d", 0))
+
+	// aggregate_0x0000000000000000000000000000000000000000000000000000000000000000
+	aggregated, err := Aggregate(MinPk, [][]byte{
+		decode("b6ed936746e01f8ecf281f020953fbf1f01debd5657c4a383940b020b26507f6076334f91e2366c96e9ab279fb5158090352ea1c5b0c9274504f4f0e7053af24802e51e4568d164fe986834f41e55c8e850ce1f98458c0cfc9ab380b55285a55"),
+		decode("b23c46be3a001c63ca711f87a005c200cc550b9429d5f4eb38d74322144f1b63926da3388979e5321012fb1a0526bcd100b5ef5fe72628ce4cd5e904aeaa3279527843fae5ca9ca675f4f51ed8f83bbf7155da9ecc9663100a885d5dc6df96d9"),
+		decode("948a7cb99f76d616c2c564ce9bf4a519f1bea6b0a624a02276443c245854219fabb8d4ce061d255af5330b078d5380681751aa7053da2c98bae898edc218c75f07e24d8802a17cd1f6833b71e58f5eb5b94208b4d0bb3848cecb075ea21be115"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hex.EncodeToString(aggregated) != "9683b3e6701f9a4b706709577963110043af78a5b41991b998475a3d3fd62abf35ce03b33908418efc95a058494a8ae504354b9f626231f6b3f3c849dfdeaf5017c4780e2aee1850ceaf4b4d9ce70971a3d2cfcd97b7e5ecf6759f8da5f76d31" {
+		t.Fatal("wrong aggregated signature")
+	}
+	// aggregate_na_signatures
+	if _, err := Aggregate(MinPk, nil); err == nil {
+		t.Fatal("expected an error for an empty aggregation")
+	}
+	// aggregate_infinity_signature
+	if res, err := Aggregate(MinPk, [][]byte{infinitySig}); err != nil || !bytes.Equal(res, infinitySig) {
+		t.Fatal("the aggregation of the infinity signature must be the infinity signature")
+	}
+
+	// aggregate_verify_valid
+	sig := decode("9104e74b9dfd3ad502f25d6a5ef57db0ed7d9a0e00f3500586d8ce44231212542fcfaf87840539b398bf07626705cf1105d246ca1062c6c2e1a53029a0f790ed5e3cb1f52f8234dc5144c45fc847c0cd37a92d68e7c5ba7c648a8a339f171244")
+	if ok, err := AggregateVerify(publicKeys, messages, sig); !ok || err != nil {
+		t.Fatal("valid aggregated signature rejected")
+	}
+	// aggregate_verify_tampered_signature
+	tampered := append(append([]byte{}, sig[:len(sig)-4]...), 0xff, 0xff, 0xff, 0xff)
+	if ok, _ := AggregateVerify(publicKeys, messages, tampered); ok {
+		t.Fatal("tampered signature accepted")
+	}
+	// aggregate_verify_na_pubkeys_and_infinity_signature, aggregate_verify_na_pubkeys_and_na_signature
+	if ok, _ := AggregateVerify(nil, nil, infinitySig); ok {
+		t.Fatal("infinity signature accepted without public keys")
+	}
+	if ok, _ := AggregateVerify(nil, nil, nil); ok {
+		t.Fatal("empty aggregation accepted")
+	}
+	// aggregate_verify_infinity_pubkey: the point at infinity can't be decoded as a public key,
+	// and is rejected if it is built otherwise
+	infinityPk := &PublicKey{Variant: MinPk, Scheme: ProofOfPossession}
+	if _, err := infinityPk.SetBytes(decode("c" + fmt.Sprintf("%095d", 0))); err == nil {
+		t.Fatal("the point at infinity is not a valid public key")
+	}
+	withInfinity := append(append([]*PublicKey{}, publicKeys...), infinityPk)
+	if ok, _ := AggregateVerify(withInfinity, append(messages, messages[0]), sig); ok {
+		t.Fatal("aggregated signature accepted with an infinity public key")
+	}
+
+	// fast_aggregate_verify_valid
+	if ok, err := FastAggregateVerify(publicKeys, messages[0], aggregated); !ok || err != nil {
+		t.Fatal("valid aggregated signature rejected")
+	}
+	// fast_aggregate_verify_extra_pubkey
+	if ok, _ := FastAggregateVerify(append(publicKeys[:3:3], publicKeys[0]), messages[0], aggregated); ok {
+		t.Fatal("aggregated signature accepted with an extra public key")
+	}
+	// fast_aggregate_verify_tampered_signature
+	tampered = append(append([]byte{}, aggregated[:len(aggregated)-4]...), 0xff, 0xff, 0xff, 0xff)
+	if ok, _ := FastAggregateVerify(publicKeys, messages[0], tampered); ok {
+		t.Fatal("tampered signature accepted")
+	}
+	// fast_aggregate_verify_na_pubkeys_and_infinity_signature, fast_aggregate_verify_na_pubkeys_and_na_signature
+	if ok, _ := FastAggregateVerify(nil, messages[0], infinitySig); ok {
+		t.Fatal("infinity signature accepted without public keys")
+	}
+	if ok, _ := FastAggregateVerify(nil, messages[0], nil); ok {
+		t.Fatal("empty aggregation accepted")
+	}
+	// fast_aggregate_verify_infinity_pubkey
+	if ok, _ := FastAggregateVerify(withInfinity, messages[0], infinitySig); ok {
+		t.Fatal("infinity signature accepted with an infinity public key")
+	}
+}
+
+// With the secret key 1, a signature is the hash of the message to the curve: the test vectors of
+// RFC 9380, Appendix J.9.1 (G1, MinSig) and J.10.1 (G2, MinPk) are known answers for the signers.
+func TestHashToCurveVectors(t *testing.T) {
+	const (
+		// compressed generators of G2 and G1, the public keys of the secret key 1
+		g2 = "93e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8"
+		g1 = "97f1d3a73197d7942695638c4fa9ac0fc3688c4f9774b905a14e3a3f171bac586c55e83ff97a1aeffb3af00adb22c6bb"
+		sk = "0000000000000000000000000000000000000000000000000000000000000001"
+	)
+	vectors := []struct {
+		variant Variant
+		dst     string
+		msg     string
+		x, y    []string
+	}{
+		{
+			MinSig, "QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_", "",
+			[]string{"0x052926add2207b76ca4fa57a8734416c8dc95e24501772c814278700eed6d1e4e8cf62d9c09db0fac349612b759e79a1"},
+			[]string{"0x08ba738453bfed09cb546dbb0783dbb3a5f1f566ed67bb6be0e8c67e2e81a4cc68ee29813bb7994998f3eae0c9c6a265"},
+		},
+		{
+			MinSig, "QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_", "abc",
+			[]string{"0x03567bc5ef9c690c2ab2ecdf6a96ef1c139cc0b2f284dca0a9a7943388a49a3aee664ba5379a7655d3c68900be2f6903"},
+			[]string{"0x0b9c15f3fe6e5cf4211f346271d7b01c8f3b28be689c8429c85b67af215533311f0b8dfaaa154fa6b88176c229f2885d"},
+		},
+		{
+			MinPk, "QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_", "",
+			[]string{"0x0141ebfbdca40eb85b87142e130ab689c673cf60f1a3e98d69335266f30d9b8d4ac44c1038e9dcdd5393faf5c41fb78a", "0x05cb8437535e20ecffaef7752baddf98034139c38452458baeefab379ba13dff5bf5dd71b72418717047f5b0f37da03d"},
+			[]string{"0x0503921d7f6a12805e72940b963c0cf3471c7b2a524950ca195d11062ee75ec076daf2d4bc358c4b190c0c98064fdd92", "0x12424ac32561493f3fe3c260708a12b7c620e7be00099a974e259ddc7d1f6395c3c811cdd19f1e8dbf3e9ecfdcbab8d6"},
+		},
+		{
+			MinPk, "QUUX-V01-CS02-with-BLS12381G2_XMD:SHA-256_SSWU_RO_", "abc",
+			[]string{"0x02c2d18e033b960562aae3cab37a27ce00d80ccd5ba4b7fe0e7a210245129dbec7780ccc7954725f4168aff2787776e6", "0x139cddbccdc5e91b9623efd38c49f81a6f83f175e80b06fc374de9eb4b41dfe4ca3a230ed250fbe3a2acf73a41177fd8"},
+			[]string{"0x1787327b68159716a37440985269cf584bcb1e621d3a7202be6ea05c4cfe244aeb197642555a0645fb87bf7466b2ba48", "0x00aa65dae3c8d732d10ecd2c50f8a1baf3001578f71c694e03866e9f3d49ac1e1ce70dd94a733534f106d4cec0eddd16"},
+		},
+	}
+
+	for i, v := range vectors {
+		publicKey := g1
+		if v.variant == MinSig {
+			publicKey = g2
+		}
+		buf, _ := hex.DecodeString(publicKey + sk)
+		privKey := PrivateKey{PublicKey: PublicKey{Variant: v.variant, Scheme: Basic}}
+		if _, err := privKey.SetBytes(buf); err != nil {
+			t.Fatalf("vector %d: %v", i, err)
+		}
+		sig, err := privKey.coreSign([]byte(v.msg), []byte(v.dst))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var expected []byte
+		if v.variant == MinSig {
+			var p bls12381.G1Affine
+			p.X.SetString(v.x[0])
+			p.Y.SetString(v.y[0])
+			b := p.Bytes()
+			expected = b[:]
+		} else {
+			var p bls12381.G2Affine
+			p.X.A0.SetString(v.x[0])
+			p.X.A1.SetString(v.x[1])
+			p.Y.A0.SetString(v.y[0])
+			p.Y.A1.SetString(v.y[1])
+			b := p.Bytes()
+			expected = b[:]
+		}
+		if !bytes.Equal(sig, expected) {
+			t.Fatalf("vector %d: wrong signature", i)
+		}
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	for _, variant := range variants {
+		for _, scheme := range schemes {
+			t.Run(fmt.Sprintf("variant=%d/scheme=%d", variant, scheme), func(t *testing.T) {
+				privKey, err := GenerateKey(variant, scheme, rand.Reader)
+				if err != nil {
+					t.Fatal(err)
+				}
+				publicKey := privKey.Public()
+
+				msg := []byte("testing BLS")
+				for _, h := range []hash.Hash{nil, sha256.New()} {
+					sig, err := privKey.Sign(msg, h)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if ok, err := publicKey.Verify(sig, msg, h); !ok || err != nil {
+						t.Fatal("valid signature rejected")
+					}
+					if ok, _ := publicKey.Verify(sig, []byte("testing BLT"), h); ok {
+						t.Fatal("signature of another message accepted")
+					}
+				}
+
+				// serialization
+				pub := PublicKey{Variant: variant, Scheme: scheme}
+				if _, err := pub.SetBytes(publicKey.Bytes()); err != nil {
+					t.Fatal(err)
+				}
+				if !pub.Equal(publicKey) {
+					t.Fatal("public key serialization round trip failed")
+				}
+				priv := PrivateKey{PublicKey: PublicKey{Variant: variant, Scheme: scheme}}
+				if n, err := priv.SetBytes(privKey.Bytes()); err != nil || n != len(privKey.Bytes()) {
+					t.Fatal("private key serialization round trip failed")
+				}
+				if priv.scalar != privKey.scalar {
+					t.Fatal("private key serialization round trip failed")
+				}
+
+				// proof of possession
+				proof, err := privKey.PopProve()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if ok, err := pub.PopVerify(proof); !ok || err != nil {
+					t.Fatal("valid proof of possession rejected")
+				}
+				sig, _ := privKey.Sign(pub.Bytes(), nil)
+				if ok, _ := pub.PopVerify(sig); ok {
+					t.Fatal("a signature of the public key is not a proof of possession")
+				}
+			})
+		}
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	const n = 4
+	for _, variant := range variants {
+		for _, scheme := range schemes {
+			t.Run(fmt.Sprintf("variant=%d/scheme=%d", variant, scheme), func(t *testing.T) {
+				publicKeys := make([]*PublicKey, n)
+				messages := make([][]byte, n)
+				signatures := make([][]byte, n)
+				sameMessage := make([][]byte, n)
+				for i := range publicKeys {
+					privKey, _ := GenerateKey(variant, scheme, rand.Reader)
+					publicKeys[i] = &privKey.PublicKey
+					messages[i] = []byte(fmt.Sprintf("message %d", i))
+					signatures[i], _ = privKey.Sign(messages[i], nil)
+					sameMessage[i], _ = privKey.Sign([]byte("message"), nil)
+				}
+
+				sig, err := Aggregate(variant, signatures)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if ok, err := AggregateVerify(publicKeys, messages, sig); !ok || err != nil {
+					t.Fatal("valid aggregated signature rejected")
+				}
+				messages[0], messages[1] = messages[1], messages[0]
+				if ok, _ := AggregateVerify(publicKeys, messages, sig); ok {
+					t.Fatal("aggregated signature accepted for swapped messages")
+				}
+
+				sig, _ = Aggregate(variant, sameMessage)
+				same := [][]byte{[]byte("message"), []byte("message"), []byte("message"), []byte("message")}
+				ok, err := AggregateVerify(publicKeys, same, sig)
+				if ok != (scheme != Basic) || err != nil {
+					t.Fatal("the Basic scheme must reject duplicated messages, and only it")
+				}
+				ok, err = FastAggregateVerify(publicKeys, []byte("message"), sig)
+				if scheme == ProofOfPossession {
+					if !ok || err != nil {
+						t.Fatal("valid aggregated signature rejected")
+					}
+					if ok, _ := FastAggregateVerify(publicKeys[1:], []byte("message"), sig); ok {
+						t.Fatal("aggregated signature accepted for a subset of the signers")
+					}
+				} else if err == nil {
+					t.Fatal("FastAggregateVerify requires the ProofOfPossession scheme")
+				}
+			})
+		}
+	}
+
+	if _, err := Aggregate(MinPk, nil); err == nil {
+		t.Fatal("expected an error for an empty aggregation")
+	}
+	if ok, _ := FastAggregateVerify(nil, nil, nil); ok {
+		t.Fatal("empty aggregation accepted")
+	}
+	a, _ := GenerateKey(MinPk, Basic, rand.Reader)
+	b, _ := GenerateKey(MinSig, Basic, rand.Reader)
+	if _, err := AggregateVerify([]*PublicKey{&a.PublicKey, &b.PublicKey}, [][]byte{{0}, {1}}, nil); err == nil {
+		t.Fatal("expected an error for mixed variants")
+	}
+}
+
+func TestNonMalleability(t *testing.T) {
+	privKey, _ := GenerateKey(MinPk, Basic, rand.Reader)
+	msg := []byte("testing BLS")
+	sig, _ := privKey.Sign(msg, nil)
+
+	// wrong size
+	if _, err := privKey.PublicKey.Verify(append(sig, 0), msg, nil); err == nil {
+		t.Fatal("expected an error for a too long signature")
+	}
+	// compression flag not set
+	sig[0] &= 0x7f
+	if _, err := privKey.PublicKey.Verify(sig, msg, nil); err == nil {
+		t.Fatal("expected an error for a non compressed signature")
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	for _, variant := range variants {
+		privKey, _ := GenerateKey(variant, ProofOfPossession, rand.Reader)
+		msg := []byte("benchmarking BLS")
+		sig, _ := privKey.Sign(msg, nil)
+		b.Run(fmt.Sprintf("variant=%d", variant), func(b *testing.B) {
+			for j := 0; j < b.N; j++ {
+				privKey.PublicKey.Verify(sig, msg, nil)
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package bls provides BLS signatures on the bls12-381 curve, as specified in
+// draft-irtf-cfrg-bls-signature-05.
+//
+// Both variants are supported: with MinPk the public keys are in G1 and the signatures in G2
+// (as in the Ethereum consensus layer), with MinSig the public keys are in G2 and the signatures in G1.
+// Each variant is available with the three schemes of the specification: Basic,
+// MessageAugmentation and ProofOfPossession. The messages are hashed to the curve with
+// the ciphersuites BLS_SIG_BLS12381G{1,2}_XMD:SHA-256_SSWU_RO_{NUL,AUG,POP}_.
+//
+// Signatures on the same message, or on distinct messages, can be aggregated into a single
+// signature (see Aggregate, AggregateVerify and FastAggregateVerify).
+//
+// Documentation:
+// - IETF draft: https://datatracker.ietf.org/doc/html/draft-irtf-cfrg-bls-signature-05
+// - Ethereum consensus specs: https://github.com/ethereum/consensus-specs/blob/dev/specs/phase0/beacon-chain.md#bls-signatures
+package bls
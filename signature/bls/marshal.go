@@ -0,0 +1,131 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package bls
+
+import (
+	"crypto/subtle"
+	"io"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+)
+
+// size returns the size of the public key in bytes
+func (pub *PublicKey) size() int {
+	if pub.Variant == MinSig {
+		return sizeG2
+	}
+	return sizeG1
+}
+
+// Bytes returns the binary representation of the public key, as a compressed
+// point of G1 (MinPk) or G2 (MinSig) in the zcash format.
+func (pub *PublicKey) Bytes() []byte {
+	if pub.Variant == MinSig {
+		b := pub.g2.Bytes()
+		return b[:]
+	}
+	b := pub.g1.Bytes()
+	return b[:]
+}
+
+// SetBytes sets pub from its compressed binary representation in buf, according to
+// pub.Variant. The point must be in the prime order subgroup and not be the identity.
+// It returns the number of bytes read from the buffer.
+func (pub *PublicKey) SetBytes(buf []byte) (int, error) {
+	size := pub.size()
+	if len(buf) < size {
+		return 0, io.ErrShortBuffer
+	}
+	var err error
+	switch pub.Variant {
+	case MinPk:
+		pub.g1, err = pointG1(buf[:size])
+	case MinSig:
+		pub.g2, err = pointG2(buf[:size])
+	default:
+		return 0, errUnknownVariant
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := pub.validate(); err != nil {
+		return 0, err
+	}
+	return size, nil
+}
+
+// Bytes returns the binary representation of privKey,
+// as byte array publicKey||scalar
+// where publicKey is as publicKey.Bytes(), and
+// scalar is in big endian, of size sizeFr.
+func (privKey *PrivateKey) Bytes() []byte {
+	res := privKey.PublicKey.Bytes()
+	res = append(res, make([]byte, sizeFr)...)
+	subtle.ConstantTimeCopy(1, res[len(res)-sizeFr:], privKey.scalar[:])
+	return res
+}
+
+// SetBytes sets privKey from buf, where buf is interpreted
+// as  publicKey||scalar
+// where publicKey is as publicKey.Bytes() according to privKey.PublicKey.Variant, and
+// scalar is in big endian, of size sizeFr.
+// It returns the number byte read.
+func (privKey *PrivateKey) SetBytes(buf []byte) (int, error) {
+	n, err := privKey.PublicKey.SetBytes(buf)
+	if err != nil {
+		return 0, err
+	}
+	if len(buf) < n+sizeFr {
+		return 0, io.ErrShortBuffer
+	}
+	sk := new(big.Int).SetBytes(buf[n : n+sizeFr])
+	if sk.Sign() == 0 || sk.Cmp(order) >= 0 {
+		return 0, errInvalidScalar
+	}
+	var pub PublicKey
+	pub.Variant = privKey.PublicKey.Variant
+	pub.fromScalar(sk)
+	if subtle.ConstantTimeCompare(pub.Bytes(), buf[:n]) != 1 {
+		return 0, errInvalidKey
+	}
+	subtle.ConstantTimeCopy(1, privKey.scalar[:], buf[n:n+sizeFr])
+	return n + sizeFr, nil
+}
+
+// pointG1 decodes a compressed point of G1 of sizeG1 bytes, with a subgroup check
+func pointG1(buf []byte) (bls12381.G1Affine, error) {
+	var p bls12381.G1Affine
+	if buf[0]&0x80 == 0 {
+		return p, errNotCompressed
+	}
+	_, err := p.SetBytes(buf)
+	return p, err
+}
+
+// pointG2 decodes a compressed point of G2 of sizeG2 bytes, with a subgroup check
+func pointG2(buf []byte) (bls12381.G2Affine, error) {
+	var p bls12381.G2Affine
+	if buf[0]&0x80 == 0 {
+		return p, errNotCompressed
+	}
+	_, err := p.SetBytes(buf)
+	return p, err
+}
+
+// signatureG1 decodes a MinSig signature
+func signatureG1(buf []byte) (bls12381.G1Affine, error) {
+	if len(buf) != sizeG1 {
+		return bls12381.G1Affine{}, errWrongSignature
+	}
+	return pointG1(buf)
+}
+
+// signatureG2 decodes a MinPk signature
+func signatureG2(buf []byte) (bls12381.G2Affine, error) {
+	if len(buf) != sizeG2 {
+		return bls12381.G2Affine{}, errWrongSignature
+	}
+	return pointG2(buf)
+}
@@ -0,0 +1,150 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package frost
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/field/hash"
+)
+
+var (
+	errInvalidElement = errors.New("frost: invalid group element")
+	errInvalidScalar  = errors.New("frost: invalid scalar")
+	errIdentity       = errors.New("frost: the identity element can't be serialized")
+)
+
+// Element is an element of the prime order group of a Ciphersuite.
+type Element interface {
+	// Bytes returns the serialization of the element (SerializeElement).
+	Bytes() []byte
+	// Equal returns true if the elements are equal.
+	Equal(Element) bool
+}
+
+// group is the prime order group abstraction of RFC 9591, Section 3.1
+type group interface {
+	order() *big.Int
+	identity() Element
+	generator() Element
+	add(a, b Element) Element
+	scalarMul(a Element, s *big.Int) Element
+	isIdentity(a Element) bool
+	elementSize() int
+	deserializeElement(buf []byte) (Element, error)
+}
+
+// Ciphersuite is a prime order group with the hash functions H1 to H5 of RFC 9591, Section 6.
+type Ciphersuite struct {
+	contextString string
+	group
+}
+
+// ContextString returns the context string of the ciphersuite, which prefixes the domain
+// separation tags of all its hash functions.
+func (cs *Ciphersuite) ContextString() string {
+	return cs.contextString
+}
+
+// Order returns the order of the group.
+func (cs *Ciphersuite) Order() *big.Int {
+	return new(big.Int).Set(cs.order())
+}
+
+// DeserializeElement decodes an element of the group, which can't be the identity.
+func (cs *Ciphersuite) DeserializeElement(buf []byte) (Element, error) {
+	return cs.deserializeElement(buf)
+}
+
+// ScalarBaseMultiplication returns s ⋅ G, with G the generator of the group.
+func (cs *Ciphersuite) ScalarBaseMultiplication(s *big.Int) Element {
+	return cs.scalarMul(cs.generator(), s)
+}
+
+// serializeScalar returns the 32 bytes big endian encoding of s
+func (cs *Ciphersuite) serializeScalar(s *big.Int) []byte {
+	return s.FillBytes(make([]byte, scalarSize))
+}
+
+// deserializeScalar decodes a 32 bytes big endian scalar, which must be reduced
+func (cs *Ciphersuite) deserializeScalar(buf []byte) (*big.Int, error) {
+	if len(buf) != scalarSize {
+		return nil, errInvalidScalar
+	}
+	s := new(big.Int).SetBytes(buf)
+	if s.Cmp(cs.order()) >= 0 {
+		return nil, errInvalidScalar
+	}
+	return s, nil
+}
+
+// randomScalar returns a random non-zero scalar
+func (cs *Ciphersuite) randomScalar(rand io.Reader) (*big.Int, error) {
+	b := make([]byte, scalarSize+16)
+	n := new(big.Int).Sub(cs.order(), big.NewInt(1))
+	if _, err := io.ReadFull(rand, b); err != nil {
+		return nil, err
+	}
+	s := new(big.Int).SetBytes(b)
+	s.Mod(s, n).Add(s, big.NewInt(1))
+	return s, nil
+}
+
+// const scalarSize is the size of the serialized scalars of all the ciphersuites
+const scalarSize = 32
+
+// hashToScalar implements hash_to_field(msg, 1) on the scalar field, with expand_message_xmd
+// (SHA-256) and L = 48 bytes.
+func (cs *Ciphersuite) hashToScalar(dst string, msgs ...[]byte) *big.Int {
+	var msg []byte
+	for _, m := range msgs {
+		msg = append(msg, m...)
+	}
+	b, err := hash.ExpandMsgXmd(msg, []byte(cs.contextString+dst), 48)
+	if err != nil {
+		panic(err) // the domain separation tags and lengths are constant and valid
+	}
+	s := new(big.Int).SetBytes(b)
+	return s.Mod(s, cs.order())
+}
+
+// h1 hashes to the binding factors
+func (cs *Ciphersuite) h1(msgs ...[]byte) *big.Int {
+	return cs.hashToScalar("rho", msgs...)
+}
+
+// h2 hashes to the challenge
+func (cs *Ciphersuite) h2(msgs ...[]byte) *big.Int {
+	return cs.hashToScalar("chal", msgs...)
+}
+
+// h3 hashes to the nonces
+func (cs *Ciphersuite) h3(msgs ...[]byte) *big.Int {
+	return cs.hashToScalar("nonce", msgs...)
+}
+
+// h4 hashes the message
+func (cs *Ciphersuite) h4(msg []byte) []byte {
+	return cs.sha256("msg", msg)
+}
+
+// h5 hashes the commitment list
+func (cs *Ciphersuite) h5(msg []byte) []byte {
+	return cs.sha256("com", msg)
+}
+
+// hdkg hashes to the challenge of the proofs of knowledge of the distributed key generation
+func (cs *Ciphersuite) hdkg(msgs ...[]byte) *big.Int {
+	return cs.hashToScalar("dkg", msgs...)
+}
+
+func (cs *Ciphersuite) sha256(tag string, msg []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte(cs.contextString + tag))
+	h.Write(msg)
+	return h.Sum(nil)
+}
@@ -0,0 +1,24 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+// Package frost provides t-of-n threshold Schnorr signatures, as specified in RFC 9591
+// (Flexible Round-Optimized Schnorr Threshold signatures).
+//
+// The protocol is generic over the prime order group of a Ciphersuite:
+//   - Secp256k1 is the FROST(secp256k1, SHA-256) ciphersuite of RFC 9591;
+//   - TwistedEdwards returns a ciphersuite on the prime order subgroup of the bn254 or
+//     bandersnatch twisted Edwards curves, built the same way (SHA-256, hash to field with
+//     expand_message_xmd), with 32 bytes compressed points and big endian scalars.
+//
+// The keys are generated by a trusted dealer (TrustedDealerKeyGen) or with the distributed key
+// generation of the FROST paper (DKGPart1, DKGPart2, DKGPart3). A signature then takes two rounds:
+//  1. each signer generates nonces and commitments with Commit, and sends the commitments;
+//  2. each signer computes a signature share with Sign from the commitments of all the signers;
+//     the shares are checked with VerifySignatureShare and combined with Aggregate.
+//
+// The resulting signatures are verified with Verify.
+//
+// Documentation:
+// - RFC 9591: https://www.rfc-editor.org/rfc/rfc9591.html
+// - FROST paper: https://eprint.iacr.org/2020/852
+package frost
@@ -0,0 +1,431 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package frost
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+)
+
+var (
+	errInvalidIdentifier  = errors.New("frost: invalid identifier")
+	errDuplicate          = errors.New("frost: duplicated identifier")
+	errNotParticipant     = errors.New("frost: the signer is not in the commitment list")
+	errUsedNonces         = errors.New("frost: the nonces were already used")
+	errCommitmentMismatch = errors.New("frost: the commitment of the signer doesn't match its nonces")
+	errWrongSize          = errors.New("frost: wrong size buffer")
+	errTooFewSigners      = errors.New("frost: fewer commitments than the minimum number of signers")
+)
+
+// Identifier identifies a participant; it is a non-zero scalar, usually the index of the
+// participant in [1, n].
+type Identifier uint64
+
+func (id Identifier) scalar() *big.Int {
+	return new(big.Int).SetUint64(uint64(id))
+}
+
+// KeyPackage holds the long-lived key of a participant.
+type KeyPackage struct {
+	Ciphersuite    *Ciphersuite
+	Identifier     Identifier
+	SecretShare    *big.Int // sk_i, share of the group secret key
+	PublicShare    Element  // PK_i = sk_i ⋅ G
+	GroupPublicKey Element  // PK = sk ⋅ G
+	MinSigners     int
+}
+
+// PublicKeyPackage holds the public shares of all the participants and the group public key,
+// to verify the signature shares.
+type PublicKeyPackage struct {
+	Ciphersuite    *Ciphersuite
+	PublicShares   map[Identifier]Element
+	GroupPublicKey Element
+	MinSigners     int
+}
+
+// SigningNonces are the secret nonces of a signer for one signature.
+type SigningNonces struct {
+	hiding, binding *big.Int
+	commitments     SigningCommitments
+}
+
+// SigningCommitments are the public commitments of a signer to its nonces, sent in round one.
+type SigningCommitments struct {
+	Identifier Identifier
+	Hiding     Element // D_i = hiding_nonce ⋅ G
+	Binding    Element // E_i = binding_nonce ⋅ G
+}
+
+// Signature is a Schnorr signature (R, z), verified by z ⋅ G = R + c ⋅ PK.
+type Signature struct {
+	R Element
+	Z *big.Int
+}
+
+// Commit generates the nonces of the signer and their commitments (round one).
+//
+// RFC 9591, Section 5.1
+func Commit(key *KeyPackage, rand io.Reader) (*SigningNonces, *SigningCommitments, error) {
+	cs := key.Ciphersuite
+	hiding, err := cs.nonceGenerate(key.SecretShare, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	binding, err := cs.nonceGenerate(key.SecretShare, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	nonces := &SigningNonces{
+		hiding:  hiding,
+		binding: binding,
+		commitments: SigningCommitments{
+			Identifier: key.Identifier,
+			Hiding:     cs.ScalarBaseMultiplication(hiding),
+			Binding:    cs.ScalarBaseMultiplication(binding),
+		},
+	}
+	commitments := nonces.commitments
+	return nonces, &commitments, nil
+}
+
+// nonceGenerate returns H3(random_bytes || SerializeScalar(secret))
+//
+// RFC 9591, Section 4.1
+func (cs *Ciphersuite) nonceGenerate(secret *big.Int, rand io.Reader) (*big.Int, error) {
+	r := make([]byte, 32)
+	if _, err := io.ReadFull(rand, r); err != nil {
+		return nil, err
+	}
+	return cs.h3(r, cs.serializeScalar(secret)), nil
+}
+
+// Sign computes the signature share of the signer on msg (round two), from the commitments of all
+// the signers, at least key.MinSigners. The nonces are erased, so that they can't be used again.
+//
+// z_i = hiding_nonce + binding_nonce ⋅ ρ_i + λ_i ⋅ sk_i ⋅ c
+//
+// RFC 9591, Section 5.2
+func Sign(key *KeyPackage, nonces *SigningNonces, commitments []SigningCommitments, msg []byte) (*big.Int, error) {
+	if nonces.hiding == nil {
+		return nil, errUsedNonces
+	}
+	hiding, binding := nonces.hiding, nonces.binding
+	nonces.hiding, nonces.binding = nil, nil
+
+	cs := key.Ciphersuite
+	if len(commitments) < key.MinSigners {
+		return nil, errTooFewSigners
+	}
+	list, err := sortCommitments(commitments)
+	if err != nil {
+		return nil, err
+	}
+	i := list.index(key.Identifier)
+	if i < 0 {
+		return nil, errNotParticipant
+	}
+	if !list[i].Hiding.Equal(nonces.commitments.Hiding) || !list[i].Binding.Equal(nonces.commitments.Binding) {
+		return nil, errCommitmentMismatch
+	}
+
+	bindingFactors, err := cs.computeBindingFactors(key.GroupPublicKey, list, msg)
+	if err != nil {
+		return nil, err
+	}
+	groupCommitment := cs.computeGroupCommitment(list, bindingFactors)
+	lambda, err := cs.deriveInterpolatingValue(list.identifiers(), key.Identifier)
+	if err != nil {
+		return nil, err
+	}
+	c, err := cs.computeChallenge(groupCommitment, key.GroupPublicKey, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	order := cs.order()
+	z := new(big.Int).Mul(lambda, key.SecretShare)
+	z.Mul(z, c)
+	z.Add(z, hiding)
+	t := new(big.Int).Mul(binding, bindingFactors[i])
+	z.Add(z, t).Mod(z, order)
+	return z, nil
+}
+
+// VerifySignatureShare checks the signature share of the signer with the given identifier
+//
+// z_i ⋅ G ?= D_i + ρ_i ⋅ E_i + (c ⋅ λ_i) ⋅ PK_i
+//
+// RFC 9591, Section 5.4
+func VerifySignatureShare(pub *PublicKeyPackage, id Identifier, share *big.Int, commitments []SigningCommitments, msg []byte) (bool, error) {
+	cs := pub.Ciphersuite
+	publicShare, ok := pub.PublicShares[id]
+	if !ok {
+		return false, errInvalidIdentifier
+	}
+	if share.Sign() < 0 || share.Cmp(cs.order()) >= 0 {
+		return false, errInvalidScalar
+	}
+	list, err := sortCommitments(commitments)
+	if err != nil {
+		return false, err
+	}
+	i := list.index(id)
+	if i < 0 {
+		return false, errNotParticipant
+	}
+
+	bindingFactors, err := cs.computeBindingFactors(pub.GroupPublicKey, list, msg)
+	if err != nil {
+		return false, err
+	}
+	groupCommitment := cs.computeGroupCommitment(list, bindingFactors)
+	lambda, err := cs.deriveInterpolatingValue(list.identifiers(), id)
+	if err != nil {
+		return false, err
+	}
+	c, err := cs.computeChallenge(groupCommitment, pub.GroupPublicKey, msg)
+	if err != nil {
+		return false, err
+	}
+
+	commShare := cs.add(list[i].Hiding, cs.scalarMul(list[i].Binding, bindingFactors[i]))
+	c.Mul(c, lambda).Mod(c, cs.order())
+	r := cs.add(commShare, cs.scalarMul(publicShare, c))
+	l := cs.ScalarBaseMultiplication(share)
+	return l.Equal(r), nil
+}
+
+// Aggregate combines the signature shares of the signers into a signature of msg by the group
+// public key, from the commitments of at least pub.MinSigners signers. The shares should be
+// checked with VerifySignatureShare before, to identify the signers that misbehave if the
+// signature is invalid.
+//
+// RFC 9591, Section 5.3
+func Aggregate(pub *PublicKeyPackage, commitments []SigningCommitments, shares map[Identifier]*big.Int, msg []byte) (*Signature, error) {
+	cs := pub.Ciphersuite
+	if len(commitments) < pub.MinSigners {
+		return nil, errTooFewSigners
+	}
+	list, err := sortCommitments(commitments)
+	if err != nil {
+		return nil, err
+	}
+	bindingFactors, err := cs.computeBindingFactors(pub.GroupPublicKey, list, msg)
+	if err != nil {
+		return nil, err
+	}
+	groupCommitment := cs.computeGroupCommitment(list, bindingFactors)
+
+	z := new(big.Int)
+	for _, c := range list {
+		share, ok := shares[c.Identifier]
+		if !ok {
+			return nil, fmt.Errorf("frost: missing signature share of %d", c.Identifier)
+		}
+		z.Add(z, share)
+	}
+	z.Mod(z, cs.order())
+	return &Signature{R: groupCommitment, Z: z}, nil
+}
+
+// Verify checks a signature of msg by the group public key
+//
+// z ⋅ G ?= R + c ⋅ PK, with c = H2(R || PK || msg)
+//
+// RFC 9591, Appendix B
+func Verify(cs *Ciphersuite, groupPublicKey Element, sig *Signature, msg []byte) bool {
+	if cs.isIdentity(sig.R) || sig.Z.Sign() < 0 || sig.Z.Cmp(cs.order()) >= 0 {
+		return false
+	}
+	c, err := cs.computeChallenge(sig.R, groupPublicKey, msg)
+	if err != nil {
+		return false
+	}
+	l := cs.ScalarBaseMultiplication(sig.Z)
+	r := cs.add(sig.R, cs.scalarMul(groupPublicKey, c))
+	return l.Equal(r)
+}
+
+// commitmentList is a list of commitments sorted by identifier
+type commitmentList []SigningCommitments
+
+func sortCommitments(commitments []SigningCommitments) (commitmentList, error) {
+	list := make(commitmentList, len(commitments))
+	copy(list, commitments)
+	sort.Slice(list, func(i, j int) bool { return list[i].Identifier < list[j].Identifier })
+	for i := range list {
+		if list[i].Identifier == 0 {
+			return nil, errInvalidIdentifier
+		}
+		if i > 0 && list[i].Identifier == list[i-1].Identifier {
+			return nil, errDuplicate
+		}
+	}
+	return list, nil
+}
+
+func (list commitmentList) index(id Identifier) int {
+	for i := range list {
+		if list[i].Identifier == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func (list commitmentList) identifiers() []Identifier {
+	ids := make([]Identifier, len(list))
+	for i := range list {
+		ids[i] = list[i].Identifier
+	}
+	return ids
+}
+
+// encode returns SerializeScalar(identifier) || SerializeElement(D) || SerializeElement(E)
+// for all the commitments
+//
+// RFC 9591, Section 4.3, encode_group_commitment_list
+func (cs *Ciphersuite) encode(list commitmentList) []byte {
+	var res []byte
+	for _, c := range list {
+		res = append(res, c.Bytes(cs)...)
+	}
+	return res
+}
+
+// computeBindingFactors returns the binding factors ρ_i of the signers, in the order of list.
+// The group public key and the commitments can't be the identity, which has no serialization.
+//
+// RFC 9591, Section 4.4
+func (cs *Ciphersuite) computeBindingFactors(groupPublicKey Element, list commitmentList, msg []byte) ([]*big.Int, error) {
+	if cs.isIdentity(groupPublicKey) {
+		return nil, errInvalidElement
+	}
+	for i := range list {
+		if cs.isIdentity(list[i].Hiding) || cs.isIdentity(list[i].Binding) {
+			return nil, errInvalidElement
+		}
+	}
+	prefix := groupPublicKey.Bytes()
+	prefix = append(prefix, cs.h4(msg)...)
+	prefix = append(prefix, cs.h5(cs.encode(list))...)
+
+	res := make([]*big.Int, len(list))
+	for i := range list {
+		res[i] = cs.h1(prefix, cs.serializeScalar(list[i].Identifier.scalar()))
+	}
+	return res, nil
+}
+
+// computeGroupCommitment returns R = Σ D_i + ρ_i ⋅ E_i
+//
+// RFC 9591, Section 4.5
+func (cs *Ciphersuite) computeGroupCommitment(list commitmentList, bindingFactors []*big.Int) Element {
+	res := cs.identity()
+	for i := range list {
+		res = cs.add(res, list[i].Hiding)
+		res = cs.add(res, cs.scalarMul(list[i].Binding, bindingFactors[i]))
+	}
+	return res
+}
+
+// computeChallenge returns H2(SerializeElement(R) || SerializeElement(PK) || msg), or an error if
+// R or PK is the identity
+//
+// RFC 9591, Section 4.6
+func (cs *Ciphersuite) computeChallenge(groupCommitment, groupPublicKey Element, msg []byte) (*big.Int, error) {
+	if cs.isIdentity(groupCommitment) || cs.isIdentity(groupPublicKey) {
+		return nil, errInvalidElement
+	}
+	return cs.h2(groupCommitment.Bytes(), groupPublicKey.Bytes(), msg), nil
+}
+
+// deriveInterpolatingValue returns the Lagrange coefficient λ_i = Π x_j / (x_j - x_i) for j ≠ i
+//
+// RFC 9591, Section 4.2
+func (cs *Ciphersuite) deriveInterpolatingValue(ids []Identifier, id Identifier) (*big.Int, error) {
+	order := cs.order()
+	xi := id.scalar()
+	num, den := big.NewInt(1), big.NewInt(1)
+	found := false
+	for _, j := range ids {
+		if j == id {
+			if found {
+				return nil, errDuplicate
+			}
+			found = true
+			continue
+		}
+		xj := j.scalar()
+		num.Mul(num, xj).Mod(num, order)
+		t := new(big.Int).Sub(xj, xi)
+		den.Mul(den, t).Mod(den, order)
+	}
+	if !found {
+		return nil, errNotParticipant
+	}
+	if den.ModInverse(den, order) == nil {
+		return nil, errDuplicate
+	}
+	return num.Mul(num, den).Mod(num, order), nil
+}
+
+// Bytes returns the serialization of the commitments,
+// SerializeScalar(identifier) || SerializeElement(D) || SerializeElement(E).
+func (c *SigningCommitments) Bytes(cs *Ciphersuite) []byte {
+	res := cs.serializeScalar(c.Identifier.scalar())
+	res = append(res, c.Hiding.Bytes()...)
+	return append(res, c.Binding.Bytes()...)
+}
+
+// SetBytes decodes commitments serialized with Bytes.
+// It returns the number of bytes read from buf.
+func (c *SigningCommitments) SetBytes(cs *Ciphersuite, buf []byte) (int, error) {
+	n := scalarSize + 2*cs.elementSize()
+	if len(buf) < n {
+		return 0, io.ErrShortBuffer
+	}
+	id, err := cs.deserializeScalar(buf[:scalarSize])
+	if err != nil || id.Sign() == 0 || !id.IsUint64() {
+		return 0, errInvalidIdentifier
+	}
+	hiding, err := cs.deserializeElement(buf[scalarSize : scalarSize+cs.elementSize()])
+	if err != nil {
+		return 0, err
+	}
+	binding, err := cs.deserializeElement(buf[scalarSize+cs.elementSize() : n])
+	if err != nil {
+		return 0, err
+	}
+	c.Identifier, c.Hiding, c.Binding = Identifier(id.Uint64()), hiding, binding
+	return n, nil
+}
+
+// Bytes returns the serialization of the signature, SerializeElement(R) || SerializeScalar(z).
+func (sig *Signature) Bytes(cs *Ciphersuite) []byte {
+	return append(sig.R.Bytes(), cs.serializeScalar(sig.Z)...)
+}
+
+// SetBytes decodes a signature serialized with Bytes.
+// It returns the number of bytes read from buf.
+func (sig *Signature) SetBytes(cs *Ciphersuite, buf []byte) (int, error) {
+	n := cs.elementSize() + scalarSize
+	if len(buf) != n {
+		return 0, errWrongSize
+	}
+	r, err := cs.deserializeElement(buf[:cs.elementSize()])
+	if err != nil {
+		return 0, err
+	}
+	z, err := cs.deserializeScalar(buf[cs.elementSize():])
+	if err != nil {
+		return 0, err
+	}
+	sig.R, sig.Z = r, z
+	return n, nil
+}
@@ -0,0 +1,421 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package frost
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/gnark-crypto/ecc/twistededwards"
+	"github.com/leanovate/gopter"
+	"github.com/leanovate/gopter/prop"
+)
+
+func decode(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func ciphersuites(t *testing.T) map[string]*Ciphersuite {
+	res := map[string]*Ciphersuite{"SECP256K1": Secp256k1()}
+	for name, id := range map[string]twistededwards.ID{
+		"BN254":        twistededwards.BN254,
+		"BANDERSNATCH": twistededwards.BLS12_381_BANDERSNATCH,
+	} {
+		cs, err := TwistedEdwards(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res[name] = cs
+	}
+	return res
+}
+
+// RFC 9591, Appendix E.5, FROST(secp256k1, SHA-256)
+func TestSecp256k1Vectors(t *testing.T) {
+	cs := Secp256k1()
+	secret := new(big.Int).SetBytes(decode(t, "0d004150d27c3bf2a42f312683d35fac7394b1e9e318249c1bfe7f0795a83114"))
+	coefficient := new(big.Int).SetBytes(decode(t, "fbf85eadae3058ea14f19148bb72b45e4399c0b16028acaf0395c9b03c823579"))
+	coefficients := []*big.Int{secret, coefficient}
+
+	commitment := cs.vssCommit(coefficients)
+	if !bytes.Equal(commitment[0].Bytes(), decode(t, "02f37c34b66ced1fb51c34a90bdae006901f10625cc06c4f64663b0eae87d87b4f")) {
+		t.Fatal("wrong group public key")
+	}
+	for i, expected := range []string{
+		"08f89ffe80ac94dcb920c26f3f46140bfc7f95b493f8310f5fc1ea2b01f4254c",
+		"04f0feac2edcedc6ce1253b7fab8c86b856a797f44d83d82a385554e6e401984",
+		"00e95d59dd0d46b0e303e500b62b7ccb0e555d49f5b849f5e748c071da8c0dbc",
+	} {
+		share := SecretShare{Identifier: Identifier(i + 1), Value: cs.evaluate(coefficients, Identifier(i+1))}
+		if !bytes.Equal(cs.serializeScalar(share.Value), decode(t, expected)) {
+			t.Fatalf("wrong share of participant %d", i+1)
+		}
+		if !VSSVerify(cs, share, commitment) {
+			t.Fatalf("share of participant %d doesn't match the commitment", i+1)
+		}
+	}
+
+	// round one of participant 1
+	key := &KeyPackage{
+		Ciphersuite:    cs,
+		Identifier:     1,
+		SecretShare:    cs.evaluate(coefficients, 1),
+		GroupPublicKey: commitment[0],
+	}
+	randomness := append(
+		decode(t, "7ea5ed09af19f6ff21040c07ec2d2adbd35b759da5a401d4c99dd26b82391cb2"),
+		decode(t, "47acab018f116020c10cb9b9abdc7ac10aae1b48ca6e36dc15acb6ec9be5cdc5")...)
+	nonces, commitments, err := Commit(key, bytes.NewReader(randomness))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(cs.serializeScalar(nonces.hiding), decode(t, "841d3a6450d7580b4da83c8e618414d0f024391f2aeb511d7579224420aa81f0")) {
+		t.Fatal("wrong hiding nonce")
+	}
+	if !bytes.Equal(cs.serializeScalar(nonces.binding), decode(t, "8d2624f532af631377f33cf44b5ac5f849067cae2eacb88680a31e77c79b5a80")) {
+		t.Fatal("wrong binding nonce")
+	}
+	if !bytes.Equal(commitments.Hiding.Bytes(), decode(t, "03c699af97d26bb4d3f05232ec5e1938c12f1e6ae97643c8f8f11c9820303f1904")) {
+		t.Fatal("wrong hiding nonce commitment")
+	}
+	if !bytes.Equal(commitments.Binding.Bytes(), decode(t, "02fa2aaccd51b948c9dc1a325d77226e98a5a3fe65fe9ba213761a60123040a45e")) {
+		t.Fatal("wrong binding nonce commitment")
+	}
+}
+
+// sign runs the two rounds of the protocol with the given signers, and aggregates the shares
+func sign(t *testing.T, signers []*KeyPackage, pub *PublicKeyPackage, msg []byte) (*Signature, bool) {
+	nonces := make([]*SigningNonces, len(signers))
+	commitments := make([]SigningCommitments, len(signers))
+	for i, key := range signers {
+		n, c, err := Commit(key, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		nonces[i], commitments[i] = n, *c
+	}
+	shares := make(map[Identifier]*big.Int, len(signers))
+	for i, key := range signers {
+		share, err := Sign(key, nonces[i], commitments, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ok, err := VerifySignatureShare(pub, key.Identifier, share, commitments, msg)
+		if err != nil || !ok {
+			return nil, false
+		}
+		shares[key.Identifier] = share
+	}
+	sig, err := Aggregate(pub, commitments, shares, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig, true
+}
+
+func TestFrost(t *testing.T) {
+
+	t.Parallel()
+	parameters := gopter.DefaultTestParameters()
+	parameters.MinSuccessfulTests = 4
+	properties := gopter.NewProperties(parameters)
+
+	msg := []byte("testing FROST")
+
+	for name, cs := range ciphersuites(t) {
+		properties.Property("["+name+"] test the signing and verification with a trusted dealer", prop.ForAll(
+			func() bool {
+				keys, pub, _, err := TrustedDealerKeyGen(cs, nil, 5, 3, rand.Reader)
+				if err != nil {
+					return false
+				}
+				sig, ok := sign(t, []*KeyPackage{keys[4], keys[0], keys[2]}, pub, msg)
+				return ok && Verify(cs, pub.GroupPublicKey, sig, msg) && !Verify(cs, pub.GroupPublicKey, sig, []byte("wrong"))
+			},
+		))
+
+		properties.Property("["+name+"] test the signing and verification with a distributed key generation", prop.ForAll(
+			func() bool {
+				const n, minSigners = 4, 2
+				secrets := make(map[Identifier]*DKGSecret, n)
+				round1 := make(map[Identifier]*DKGRound1Package, n)
+				for id := Identifier(1); id <= n; id++ {
+					s, p, err := DKGPart1(cs, id, n, minSigners, rand.Reader)
+					if err != nil {
+						return false
+					}
+					secrets[id], round1[id] = s, p
+				}
+				others := func(id Identifier) map[Identifier]*DKGRound1Package {
+					res := make(map[Identifier]*DKGRound1Package, n-1)
+					for j, p := range round1 {
+						if j != id {
+							res[j] = p
+						}
+					}
+					return res
+				}
+				received := make(map[Identifier]map[Identifier]*big.Int, n)
+				for id := range secrets {
+					received[id] = make(map[Identifier]*big.Int, n-1)
+				}
+				for id, s := range secrets {
+					shares, err := DKGPart2(s, others(id))
+					if err != nil {
+						return false
+					}
+					for j, share := range shares {
+						received[j][id] = share
+					}
+				}
+				keys := make(map[Identifier]*KeyPackage, n)
+				var pub *PublicKeyPackage
+				for id, s := range secrets {
+					key, p, err := DKGPart3(s, others(id), received[id])
+					if err != nil {
+						return false
+					}
+					if pub != nil && !pub.GroupPublicKey.Equal(p.GroupPublicKey) {
+						return false
+					}
+					keys[id], pub = key, p
+				}
+				sig, ok := sign(t, []*KeyPackage{keys[2], keys[4]}, pub, msg)
+				return ok && Verify(cs, pub.GroupPublicKey, sig, msg)
+			},
+		))
+
+		properties.Property("["+name+"] test the serialization round trip", prop.ForAll(
+			func() bool {
+				keys, pub, _, err := TrustedDealerKeyGen(cs, nil, 3, 2, rand.Reader)
+				if err != nil {
+					return false
+				}
+				_, commitments, err := Commit(keys[1], rand.Reader)
+				if err != nil {
+					return false
+				}
+				var c SigningCommitments
+				if _, err := c.SetBytes(cs, commitments.Bytes(cs)); err != nil {
+					return false
+				}
+				if c.Identifier != commitments.Identifier || !c.Hiding.Equal(commitments.Hiding) || !c.Binding.Equal(commitments.Binding) {
+					return false
+				}
+				sig, ok := sign(t, keys[:2], pub, msg)
+				if !ok {
+					return false
+				}
+				var sig2 Signature
+				if _, err := sig2.SetBytes(cs, sig.Bytes(cs)); err != nil {
+					return false
+				}
+				return Verify(cs, pub.GroupPublicKey, &sig2, msg)
+			},
+		))
+	}
+
+	properties.TestingRun(t, gopter.ConsoleReporter(false))
+}
+
+func TestInvalidSignatureShare(t *testing.T) {
+	t.Parallel()
+	msg := []byte("testing FROST")
+	for name, cs := range ciphersuites(t) {
+		keys, pub, _, err := TrustedDealerKeyGen(cs, nil, 3, 2, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signers := keys[1:]
+		nonces := make([]*SigningNonces, len(signers))
+		commitments := make([]SigningCommitments, len(signers))
+		for i, key := range signers {
+			n, c, err := Commit(key, rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			nonces[i], commitments[i] = n, *c
+		}
+		share, err := Sign(signers[0], nonces[0], commitments, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Sign(signers[0], nonces[0], commitments, msg); err == nil {
+			t.Fatalf("%s: the nonces can be used twice", name)
+		}
+		share.Add(share, big.NewInt(1)).Mod(share, cs.Order())
+		if ok, _ := VerifySignatureShare(pub, signers[0].Identifier, share, commitments, msg); ok {
+			t.Fatalf("%s: an invalid signature share is accepted", name)
+		}
+		if ok, _ := VerifySignatureShare(pub, signers[1].Identifier, share, commitments, msg); ok {
+			t.Fatalf("%s: a signature share is accepted for the wrong signer", name)
+		}
+	}
+}
+
+func TestTooFewSigners(t *testing.T) {
+	t.Parallel()
+	msg := []byte("testing FROST")
+	for name, cs := range ciphersuites(t) {
+		keys, pub, _, err := TrustedDealerKeyGen(cs, nil, 5, 3, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signers := keys[:2]
+		nonces := make([]*SigningNonces, len(signers))
+		commitments := make([]SigningCommitments, len(signers))
+		for i, key := range signers {
+			n, c, err := Commit(key, rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			nonces[i], commitments[i] = n, *c
+		}
+		if _, err := Sign(signers[0], nonces[0], commitments, msg); err != errTooFewSigners {
+			t.Fatalf("%s: expected %v, got %v", name, errTooFewSigners, err)
+		}
+		shares := map[Identifier]*big.Int{1: big.NewInt(1), 2: big.NewInt(2)}
+		if _, err := Aggregate(pub, commitments, shares, msg); err != errTooFewSigners {
+			t.Fatalf("%s: expected %v, got %v", name, errTooFewSigners, err)
+		}
+	}
+}
+
+func TestInvalidDKGShare(t *testing.T) {
+	t.Parallel()
+	for name, cs := range ciphersuites(t) {
+		s1, p1, err := DKGPart1(cs, 1, 2, 2, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s2, p2, err := DKGPart1(cs, 2, 2, 2, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// a wrong proof of knowledge is rejected
+		forged := *p2
+		forged.ProofOfKnowledge.Z = new(big.Int).Add(p2.ProofOfKnowledge.Z, big.NewInt(1))
+		if _, err := DKGPart2(s1, map[Identifier]*DKGRound1Package{2: &forged}); err == nil {
+			t.Fatalf("%s: an invalid proof of knowledge is accepted", name)
+		}
+
+		// a share that doesn't match the commitment is rejected
+		shares, err := DKGPart2(s2, map[Identifier]*DKGRound1Package{1: p1})
+		if err != nil {
+			t.Fatal(err)
+		}
+		shares[1].Add(shares[1], big.NewInt(1))
+		if _, _, err := DKGPart3(s1, map[Identifier]*DKGRound1Package{2: p2}, map[Identifier]*big.Int{2: shares[1]}); err == nil {
+			t.Fatalf("%s: an invalid share is accepted", name)
+		}
+	}
+}
+
+func TestIdentityElement(t *testing.T) {
+	t.Parallel()
+	msg := []byte("testing FROST")
+	for name, cs := range ciphersuites(t) {
+		keys, pub, _, err := TrustedDealerKeyGen(cs, nil, 3, 2, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		signers := keys[:2]
+		nonces := make([]*SigningNonces, len(signers))
+		commitments := make([]SigningCommitments, len(signers))
+		for i, key := range signers {
+			n, c, err := Commit(key, rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			nonces[i], commitments[i] = n, *c
+		}
+		share, err := Sign(signers[0], nonces[0], commitments, msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// a commitment to the identity is rejected, instead of panicking when serialized
+		forged := append([]SigningCommitments{}, commitments...)
+		forged[0].Binding = cs.identity()
+		if _, err := Sign(signers[1], nonces[1], forged, msg); err != errInvalidElement {
+			t.Fatalf("%s: Sign: expected %v, got %v", name, errInvalidElement, err)
+		}
+		if _, err := VerifySignatureShare(pub, signers[0].Identifier, share, forged, msg); err != errInvalidElement {
+			t.Fatalf("%s: VerifySignatureShare: expected %v, got %v", name, errInvalidElement, err)
+		}
+		shares := map[Identifier]*big.Int{1: share, 2: share}
+		if _, err := Aggregate(pub, forged, shares, msg); err != errInvalidElement {
+			t.Fatalf("%s: Aggregate: expected %v, got %v", name, errInvalidElement, err)
+		}
+
+		// so is the identity as group public key or commitment of a signature
+		sig := &Signature{R: commitments[0].Hiding, Z: big.NewInt(1)}
+		if Verify(cs, cs.identity(), sig, msg) {
+			t.Fatalf("%s: a signature is accepted for the identity", name)
+		}
+		if _, err := cs.computeChallenge(cs.identity(), pub.GroupPublicKey, msg); err != errInvalidElement {
+			t.Fatalf("%s: computeChallenge: expected %v, got %v", name, errInvalidElement, err)
+		}
+
+		// and in the packages of the distributed key generation
+		s1, _, err := DKGPart1(cs, 1, 2, 2, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, p2, err := DKGPart1(cs, 2, 2, 2, rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		shares2 := map[Identifier]*big.Int{2: big.NewInt(1)}
+		for _, forge := range []func(p *DKGRound1Package){
+			func(p *DKGRound1Package) { p.Commitment[0] = cs.identity() },
+			func(p *DKGRound1Package) { p.ProofOfKnowledge.R = cs.identity() },
+		} {
+			forged := *p2
+			forged.Commitment = append(VSSCommitment{}, p2.Commitment...)
+			forge(&forged)
+			round1 := map[Identifier]*DKGRound1Package{2: &forged}
+			if _, err := DKGPart2(s1, round1); !errors.Is(err, errInvalidElement) {
+				t.Fatalf("%s: DKGPart2: expected %v, got %v", name, errInvalidElement, err)
+			}
+			if _, _, err := DKGPart3(s1, round1, shares2); !errors.Is(err, errInvalidElement) {
+				t.Fatalf("%s: DKGPart3: expected %v, got %v", name, errInvalidElement, err)
+			}
+		}
+	}
+}
+
+func TestInvalidElement(t *testing.T) {
+	t.Parallel()
+	for name, cs := range ciphersuites(t) {
+		g := cs.ScalarBaseMultiplication(big.NewInt(1)).Bytes()
+		if _, err := cs.DeserializeElement(g); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		if _, err := cs.DeserializeElement(g[1:]); err == nil {
+			t.Fatalf("%s: a truncated element is accepted", name)
+		}
+		// find an encoding that doesn't decode to a point of the group
+		invalid := false
+		for i := 0; i < 16 && !invalid; i++ {
+			buf := bytes.Clone(g)
+			buf[len(buf)/2] ^= byte(i + 1)
+			_, err := cs.DeserializeElement(buf)
+			invalid = err != nil
+		}
+		if !invalid {
+			t.Fatalf("%s: all the encodings are accepted", name)
+		}
+	}
+}
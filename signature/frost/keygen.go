@@ -0,0 +1,296 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package frost
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+var (
+	errThreshold       = errors.New("frost: the number of signers must satisfy 2 ≤ minSigners ≤ maxSigners")
+	errInvalidShare    = errors.New("frost: the share doesn't match the commitment")
+	errMissingPackages = errors.New("frost: a package of each other participant is required")
+)
+
+// SecretShare is the share of the group secret key sent to a participant by the dealer, or the
+// sum of the shares sent by all the participants of a distributed key generation.
+type SecretShare struct {
+	Identifier Identifier
+	Value      *big.Int
+}
+
+// VSSCommitment is the commitment C_j = a_j ⋅ G to the coefficients of a secret sharing
+// polynomial f(x) = a_0 + a_1 x + ... + a_{t-1} x^{t-1}.
+type VSSCommitment []Element
+
+// TrustedDealerKeyGen splits secret (or a random secret if nil) into maxSigners shares, any
+// minSigners of which can sign. It returns the key packages of the participants with identifiers
+// 1 to maxSigners, the public key package and the commitment to the sharing polynomial.
+//
+// RFC 9591, Appendix C
+func TrustedDealerKeyGen(cs *Ciphersuite, secret *big.Int, maxSigners, minSigners int, rand io.Reader) ([]*KeyPackage, *PublicKeyPackage, VSSCommitment, error) {
+	if minSigners < 2 || minSigners > maxSigners {
+		return nil, nil, nil, errThreshold
+	}
+	coefficients, err := cs.randomPolynomial(secret, minSigners, rand)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	commitment := cs.vssCommit(coefficients)
+
+	pub := &PublicKeyPackage{
+		Ciphersuite:    cs,
+		PublicShares:   make(map[Identifier]Element, maxSigners),
+		GroupPublicKey: commitment[0],
+		MinSigners:     minSigners,
+	}
+	keys := make([]*KeyPackage, maxSigners)
+	for i := range keys {
+		id := Identifier(i + 1)
+		share := cs.evaluate(coefficients, id)
+		keys[i] = &KeyPackage{
+			Ciphersuite:    cs,
+			Identifier:     id,
+			SecretShare:    share,
+			PublicShare:    cs.ScalarBaseMultiplication(share),
+			GroupPublicKey: commitment[0],
+			MinSigners:     minSigners,
+		}
+		pub.PublicShares[id] = keys[i].PublicShare
+	}
+	return keys, pub, commitment, nil
+}
+
+// VSSVerify checks that the share matches the commitment to the sharing polynomial
+//
+// share ⋅ G ?= Σ C_j ⋅ idʲ
+//
+// RFC 9591, Appendix C.2
+func VSSVerify(cs *Ciphersuite, share SecretShare, commitment VSSCommitment) bool {
+	return cs.ScalarBaseMultiplication(share.Value).Equal(cs.evaluateCommitment(commitment, share.Identifier))
+}
+
+// DKGSecret holds the secret state of a participant between the rounds of the distributed key
+// generation.
+type DKGSecret struct {
+	cs           *Ciphersuite
+	identifier   Identifier
+	coefficients []*big.Int
+	commitment   VSSCommitment
+	maxSigners   int
+}
+
+// DKGRound1Package is broadcast by each participant in the first round of the distributed key
+// generation: the commitment to its sharing polynomial and a proof of knowledge of its secret.
+type DKGRound1Package struct {
+	Commitment       VSSCommitment
+	ProofOfKnowledge Signature
+}
+
+// DKGPart1 starts the distributed key generation of the FROST paper (Figure 1) for the participant
+// with the given identifier: it samples a random polynomial, and returns the package to
+// broadcast to the other participants.
+//
+// The proof of knowledge of the secret a_0 is a Schnorr signature (R, μ) with the challenge
+// c = HDKG(identifier || C_0 || R).
+func DKGPart1(cs *Ciphersuite, id Identifier, maxSigners, minSigners int, rand io.Reader) (*DKGSecret, *DKGRound1Package, error) {
+	if minSigners < 2 || minSigners > maxSigners {
+		return nil, nil, errThreshold
+	}
+	if id == 0 {
+		return nil, nil, errInvalidIdentifier
+	}
+	coefficients, err := cs.randomPolynomial(nil, minSigners, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	commitment := cs.vssCommit(coefficients)
+
+	k, err := cs.randomScalar(rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	R := cs.ScalarBaseMultiplication(k)
+	c, err := cs.dkgChallenge(id, commitment[0], R)
+	if err != nil {
+		return nil, nil, err
+	}
+	mu := c.Mul(c, coefficients[0])
+	mu.Add(mu, k).Mod(mu, cs.order())
+
+	secret := &DKGSecret{
+		cs:           cs,
+		identifier:   id,
+		coefficients: coefficients,
+		commitment:   commitment,
+		maxSigners:   maxSigners,
+	}
+	return secret, &DKGRound1Package{Commitment: commitment, ProofOfKnowledge: Signature{R: R, Z: mu}}, nil
+}
+
+// DKGPart2 checks the packages broadcast by the other participants, and returns the shares
+// f(l) to send privately to each other participant l.
+func DKGPart2(secret *DKGSecret, round1 map[Identifier]*DKGRound1Package) (map[Identifier]*big.Int, error) {
+	cs := secret.cs
+	if err := secret.checkRound1(round1); err != nil {
+		return nil, err
+	}
+	shares := make(map[Identifier]*big.Int, len(round1))
+	for id := range round1 {
+		shares[id] = cs.evaluate(secret.coefficients, id)
+	}
+	return shares, nil
+}
+
+// DKGPart3 checks the shares received from the other participants, and returns the key package
+// of the participant and the public key package of the group.
+func DKGPart3(secret *DKGSecret, round1 map[Identifier]*DKGRound1Package, shares map[Identifier]*big.Int) (*KeyPackage, *PublicKeyPackage, error) {
+	cs := secret.cs
+	if err := secret.checkRound1(round1); err != nil {
+		return nil, nil, err
+	}
+	if len(shares) != len(round1) {
+		return nil, nil, errMissingPackages
+	}
+
+	// s_i = Σ f_l(i), including its own share
+	s := cs.evaluate(secret.coefficients, secret.identifier)
+	for id, share := range shares {
+		p, ok := round1[id]
+		if !ok {
+			return nil, nil, errMissingPackages
+		}
+		if !VSSVerify(cs, SecretShare{Identifier: secret.identifier, Value: share}, p.Commitment) {
+			return nil, nil, fmt.Errorf("frost: invalid share from %d: %w", id, errInvalidShare)
+		}
+		s.Add(s, share)
+	}
+	s.Mod(s, cs.order())
+
+	// the commitment to the sum of the polynomials gives the public shares and the group key
+	commitment := make(VSSCommitment, len(secret.commitment))
+	copy(commitment, secret.commitment)
+	for _, p := range round1 {
+		for j := range commitment {
+			commitment[j] = cs.add(commitment[j], p.Commitment[j])
+		}
+	}
+
+	pub := &PublicKeyPackage{
+		Ciphersuite:    cs,
+		PublicShares:   make(map[Identifier]Element, len(round1)+1),
+		GroupPublicKey: commitment[0],
+		MinSigners:     len(commitment),
+	}
+	pub.PublicShares[secret.identifier] = cs.evaluateCommitment(commitment, secret.identifier)
+	for id := range round1 {
+		pub.PublicShares[id] = cs.evaluateCommitment(commitment, id)
+	}
+
+	key := &KeyPackage{
+		Ciphersuite:    cs,
+		Identifier:     secret.identifier,
+		SecretShare:    s,
+		PublicShare:    pub.PublicShares[secret.identifier],
+		GroupPublicKey: commitment[0],
+		MinSigners:     len(commitment),
+	}
+	if !cs.ScalarBaseMultiplication(s).Equal(key.PublicShare) {
+		return nil, nil, errInvalidShare
+	}
+	return key, pub, nil
+}
+
+// checkRound1 checks that there is a package of each other participant, with a commitment to a
+// polynomial of the right degree and a valid proof of knowledge
+func (secret *DKGSecret) checkRound1(round1 map[Identifier]*DKGRound1Package) error {
+	cs := secret.cs
+	if len(round1) != secret.maxSigners-1 {
+		return errMissingPackages
+	}
+	for id, p := range round1 {
+		if id == 0 || id == secret.identifier {
+			return errInvalidIdentifier
+		}
+		if len(p.Commitment) != len(secret.commitment) {
+			return fmt.Errorf("frost: invalid commitment from %d", id)
+		}
+		// R ?= μ ⋅ G - c ⋅ C_0
+		pok := p.ProofOfKnowledge
+		if pok.Z == nil || pok.Z.Sign() < 0 || pok.Z.Cmp(cs.order()) >= 0 {
+			return fmt.Errorf("frost: invalid proof of knowledge from %d", id)
+		}
+		c, err := cs.dkgChallenge(id, p.Commitment[0], pok.R)
+		if err != nil {
+			return fmt.Errorf("frost: invalid proof of knowledge from %d: %w", id, err)
+		}
+		c.Neg(c).Mod(c, cs.order())
+		r := cs.add(cs.ScalarBaseMultiplication(pok.Z), cs.scalarMul(p.Commitment[0], c))
+		if !r.Equal(pok.R) {
+			return fmt.Errorf("frost: invalid proof of knowledge from %d", id)
+		}
+	}
+	return nil
+}
+
+// dkgChallenge returns HDKG(SerializeScalar(identifier) || SerializeElement(C_0) || SerializeElement(R)),
+// or an error if C_0 or R is the identity
+func (cs *Ciphersuite) dkgChallenge(id Identifier, c0, R Element) (*big.Int, error) {
+	if cs.isIdentity(c0) || cs.isIdentity(R) {
+		return nil, errInvalidElement
+	}
+	return cs.hdkg(cs.serializeScalar(id.scalar()), c0.Bytes(), R.Bytes()), nil
+}
+
+// randomPolynomial returns the coefficients of a random polynomial of degree t-1,
+// with constant term secret if not nil
+func (cs *Ciphersuite) randomPolynomial(secret *big.Int, t int, rand io.Reader) ([]*big.Int, error) {
+	coefficients := make([]*big.Int, t)
+	var err error
+	for i := range coefficients {
+		if i == 0 && secret != nil {
+			if secret.Sign() <= 0 || secret.Cmp(cs.order()) >= 0 {
+				return nil, errInvalidScalar
+			}
+			coefficients[0] = new(big.Int).Set(secret)
+			continue
+		}
+		if coefficients[i], err = cs.randomScalar(rand); err != nil {
+			return nil, err
+		}
+	}
+	return coefficients, nil
+}
+
+// vssCommit returns C_j = a_j ⋅ G
+func (cs *Ciphersuite) vssCommit(coefficients []*big.Int) VSSCommitment {
+	commitment := make(VSSCommitment, len(coefficients))
+	for j := range coefficients {
+		commitment[j] = cs.ScalarBaseMultiplication(coefficients[j])
+	}
+	return commitment
+}
+
+// evaluate returns f(id), with Horner's method
+func (cs *Ciphersuite) evaluate(coefficients []*big.Int, id Identifier) *big.Int {
+	x := id.scalar()
+	res := new(big.Int)
+	for j := len(coefficients) - 1; j >= 0; j-- {
+		res.Mul(res, x).Add(res, coefficients[j]).Mod(res, cs.order())
+	}
+	return res
+}
+
+// evaluateCommitment returns f(id) ⋅ G = Σ C_j ⋅ idʲ, with Horner's method
+func (cs *Ciphersuite) evaluateCommitment(commitment VSSCommitment, id Identifier) Element {
+	x := id.scalar()
+	res := cs.identity()
+	for j := len(commitment) - 1; j >= 0; j-- {
+		res = cs.add(cs.scalarMul(res, x), commitment[j])
+	}
+	return res
+}
@@ -0,0 +1,100 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package frost
+
+import (
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/secp256k1"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fp"
+	"github.com/consensys/gnark-crypto/ecc/secp256k1/fr"
+)
+
+// Secp256k1 returns the FROST(secp256k1, SHA-256) ciphersuite of RFC 9591, Section 6.5.
+// Elements are serialized as 33 bytes SEC1 compressed points.
+func Secp256k1() *Ciphersuite {
+	return &Ciphersuite{
+		contextString: "FROST-secp256k1-SHA256-v1",
+		group:         secp256k1Group{},
+	}
+}
+
+type secp256k1Element struct {
+	p secp256k1.G1Affine
+}
+
+func (e *secp256k1Element) Bytes() []byte {
+	if e.p.IsInfinity() {
+		panic(errIdentity)
+	}
+	res := make([]byte, 1, 1+fp.Bytes)
+	res[0] = 2
+	y := e.p.Y.Bytes()
+	res[0] |= y[fp.Bytes-1] & 1
+	x := e.p.X.Bytes()
+	return append(res, x[:]...)
+}
+
+func (e *secp256k1Element) Equal(x Element) bool {
+	xx, ok := x.(*secp256k1Element)
+	return ok && e.p.Equal(&xx.p)
+}
+
+type secp256k1Group struct{}
+
+func (secp256k1Group) order() *big.Int {
+	return fr.Modulus()
+}
+
+func (secp256k1Group) identity() Element {
+	return &secp256k1Element{}
+}
+
+func (secp256k1Group) generator() Element {
+	_, g := secp256k1.Generators()
+	return &secp256k1Element{p: g}
+}
+
+func (secp256k1Group) add(a, b Element) Element {
+	var res secp256k1Element
+	res.p.Add(&a.(*secp256k1Element).p, &b.(*secp256k1Element).p)
+	return &res
+}
+
+func (secp256k1Group) scalarMul(a Element, s *big.Int) Element {
+	var res secp256k1Element
+	res.p.ScalarMultiplication(&a.(*secp256k1Element).p, s)
+	return &res
+}
+
+func (secp256k1Group) isIdentity(a Element) bool {
+	return a.(*secp256k1Element).p.IsInfinity()
+}
+
+func (secp256k1Group) elementSize() int {
+	return 1 + fp.Bytes
+}
+
+// deserializeElement decodes a SEC1 compressed point
+func (secp256k1Group) deserializeElement(buf []byte) (Element, error) {
+	if len(buf) != 1+fp.Bytes || (buf[0] != 2 && buf[0] != 3) {
+		return nil, errInvalidElement
+	}
+	var res secp256k1Element
+	if err := res.p.X.SetBytesCanonical(buf[1:]); err != nil {
+		return nil, errInvalidElement
+	}
+	// y² = x³ + 7
+	var y2 fp.Element
+	_, b := secp256k1.CurveCoefficients()
+	y2.Square(&res.p.X).Mul(&y2, &res.p.X).Add(&y2, &b)
+	if res.p.Y.Sqrt(&y2) == nil {
+		return nil, errInvalidElement
+	}
+	y := res.p.Y.Bytes()
+	if y[fp.Bytes-1]&1 != buf[0]&1 {
+		res.p.Y.Neg(&res.p.Y)
+	}
+	return &res, nil
+}
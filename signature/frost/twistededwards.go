@@ -0,0 +1,210 @@
+// Copyright 2020-2025 Consensys Software Inc.
+// Licensed under the Apache License, Version 2.0. See the LICENSE file for details.
+
+package frost
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/bandersnatch"
+	frbls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	frbn254 "github.com/consensys/gnark-crypto/ecc/bn254/fr"
+	tebn254 "github.com/consensys/gnark-crypto/ecc/bn254/twistededwards"
+	"github.com/consensys/gnark-crypto/ecc/twistededwards"
+)
+
+// TwistedEdwards returns a FROST ciphersuite on the prime order subgroup of a twisted Edwards
+// curve, with SHA-256. Only twistededwards.BN254 and twistededwards.BLS12_381_BANDERSNATCH are
+// supported. Elements are serialized as 32 bytes compressed points (see PointAffine.Bytes).
+func TwistedEdwards(id twistededwards.ID) (*Ciphersuite, error) {
+	switch id {
+	case twistededwards.BN254:
+		return &Ciphersuite{
+			contextString: "FROST-BN254-TWISTEDEDWARDS-SHA256-v1",
+			group:         bn254Group{},
+		}, nil
+	case twistededwards.BLS12_381_BANDERSNATCH:
+		return &Ciphersuite{
+			contextString: "FROST-BANDERSNATCH-SHA256-v1",
+			group:         bandersnatchGroup{},
+		}, nil
+	default:
+		return nil, errors.New("frost: unsupported twisted Edwards curve")
+	}
+}
+
+type bn254Element struct {
+	p tebn254.PointAffine
+}
+
+func (e *bn254Element) Bytes() []byte {
+	if e.p.IsZero() {
+		panic(errIdentity)
+	}
+	b := e.p.Bytes()
+	return b[:]
+}
+
+func (e *bn254Element) Equal(x Element) bool {
+	xx, ok := x.(*bn254Element)
+	return ok && e.p.Equal(&xx.p)
+}
+
+type bn254Group struct{}
+
+func (bn254Group) order() *big.Int {
+	curve := tebn254.GetEdwardsCurve()
+	return &curve.Order
+}
+
+func (bn254Group) identity() Element {
+	var res bn254Element
+	res.p.Y.SetOne()
+	return &res
+}
+
+func (bn254Group) generator() Element {
+	return &bn254Element{p: tebn254.GetEdwardsCurve().Base}
+}
+
+func (bn254Group) add(a, b Element) Element {
+	var res bn254Element
+	res.p.Add(&a.(*bn254Element).p, &b.(*bn254Element).p)
+	return &res
+}
+
+func (bn254Group) scalarMul(a Element, s *big.Int) Element {
+	var res bn254Element
+	res.p.ScalarMultiplication(&a.(*bn254Element).p, s)
+	return &res
+}
+
+func (bn254Group) isIdentity(a Element) bool {
+	return a.(*bn254Element).p.IsZero()
+}
+
+func (bn254Group) elementSize() int {
+	return frbn254.Bytes
+}
+
+// deserializeElement decodes a compressed point of the prime order subgroup
+func (g bn254Group) deserializeElement(buf []byte) (Element, error) {
+	var res bn254Element
+	if len(buf) != g.elementSize() {
+		return nil, errInvalidElement
+	}
+	if _, err := res.p.SetBytes(buf); err != nil || !res.p.IsOnCurve() || res.p.IsZero() {
+		return nil, errInvalidElement
+	}
+	// the encoding must be canonical, and the point in the prime order subgroup
+	if b := res.p.Bytes(); string(b[:]) != string(buf) || !bn254InSubgroup(&res.p) {
+		return nil, errInvalidElement
+	}
+	return &res, nil
+}
+
+type bandersnatchElement struct {
+	p bandersnatch.PointAffine
+}
+
+func (e *bandersnatchElement) Bytes() []byte {
+	if e.p.IsZero() {
+		panic(errIdentity)
+	}
+	b := e.p.Bytes()
+	return b[:]
+}
+
+func (e *bandersnatchElement) Equal(x Element) bool {
+	xx, ok := x.(*bandersnatchElement)
+	return ok && e.p.Equal(&xx.p)
+}
+
+type bandersnatchGroup struct{}
+
+func (bandersnatchGroup) order() *big.Int {
+	curve := bandersnatch.GetEdwardsCurve()
+	return &curve.Order
+}
+
+func (bandersnatchGroup) identity() Element {
+	var res bandersnatchElement
+	res.p.Y.SetOne()
+	return &res
+}
+
+func (bandersnatchGroup) generator() Element {
+	return &bandersnatchElement{p: bandersnatch.GetEdwardsCurve().Base}
+}
+
+func (bandersnatchGroup) add(a, b Element) Element {
+	var res bandersnatchElement
+	res.p.Add(&a.(*bandersnatchElement).p, &b.(*bandersnatchElement).p)
+	return &res
+}
+
+func (bandersnatchGroup) scalarMul(a Element, s *big.Int) Element {
+	var res bandersnatchElement
+	res.p.ScalarMultiplication(&a.(*bandersnatchElement).p, s)
+	return &res
+}
+
+func (bandersnatchGroup) isIdentity(a Element) bool {
+	return a.(*bandersnatchElement).p.IsZero()
+}
+
+func (bandersnatchGroup) elementSize() int {
+	return frbls12381.Bytes
+}
+
+// deserializeElement decodes a compressed point of the prime order subgroup
+func (g bandersnatchGroup) deserializeElement(buf []byte) (Element, error) {
+	var res bandersnatchElement
+	if len(buf) != g.elementSize() {
+		return nil, errInvalidElement
+	}
+	if _, err := res.p.SetBytes(buf); err != nil || !res.p.IsOnCurve() || res.p.IsZero() {
+		return nil, errInvalidElement
+	}
+	// the encoding must be canonical, and the point in the prime order subgroup
+	if b := res.p.Bytes(); string(b[:]) != string(buf) || !bandersnatchInSubgroup(&res.p) {
+		return nil, errInvalidElement
+	}
+	return &res, nil
+}
+
+// bn254InSubgroup returns true if [order]p is the identity. The scalar multiplication is a plain
+// double-and-add, valid outside of the prime order subgroup.
+func bn254InSubgroup(p *tebn254.PointAffine) bool {
+	order := bn254Group{}.order()
+	var acc, q tebn254.PointProj
+	acc.Y.SetOne()
+	acc.Z.SetOne()
+	q.FromAffine(p)
+	for i := order.BitLen() - 1; i >= 0; i-- {
+		acc.Double(&acc)
+		if order.Bit(i) == 1 {
+			acc.Add(&acc, &q)
+		}
+	}
+	return acc.IsZero()
+}
+
+// bandersnatchInSubgroup returns true if [order]p is the identity. The scalar multiplication is a
+// plain double-and-add: the GLV method of PointAffine.ScalarMultiplication is only valid in the
+// prime order subgroup.
+func bandersnatchInSubgroup(p *bandersnatch.PointAffine) bool {
+	order := bandersnatchGroup{}.order()
+	var acc, q bandersnatch.PointProj
+	acc.Y.SetOne()
+	acc.Z.SetOne()
+	q.FromAffine(p)
+	for i := order.BitLen() - 1; i >= 0; i-- {
+		acc.Double(&acc)
+		if order.Bit(i) == 1 {
+			acc.Add(&acc, &q)
+		}
+	}
+	return acc.IsZero()
+}
@@ -0,0 +1,9 @@
+//go:build !nopclmul && !purego
+
+package cpu
+
+import "golang.org/x/sys/cpu"
+
+var (
+	SupportPCLMULQDQ = cpu.X86.HasPCLMULQDQ
+)
@@ -0,0 +1,5 @@
+//go:build nopclmul || purego || !amd64
+
+package cpu
+
+const SupportPCLMULQDQ = false